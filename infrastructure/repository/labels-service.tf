@@ -74,6 +74,7 @@ variable "service_labels" {
     "directoryservice",
     "dlm",
     "docdb",
+    "drs",
     "dynamodb",
     "ec2-classic",
     "ec2",
@@ -101,6 +102,7 @@ variable "service_labels" {
     "glacier",
     "globalaccelerator",
     "glue",
+    "grafana",
     "greengrass",
     "groundstation",
     "guardduty",
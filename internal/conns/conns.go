@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -84,6 +86,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/directoryservice"
 	"github.com/aws/aws-sdk-go/service/dlm"
 	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/aws/aws-sdk-go/service/drs"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/dynamodbstreams"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -168,6 +171,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/macie"
 	"github.com/aws/aws-sdk-go/service/macie2"
 	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/aws/aws-sdk-go/service/managedgrafana"
 	"github.com/aws/aws-sdk-go/service/marketplacecatalog"
 	"github.com/aws/aws-sdk-go/service/marketplacecommerceanalytics"
 	"github.com/aws/aws-sdk-go/service/marketplaceentitlementservice"
@@ -363,6 +367,7 @@ const (
 	DLM                           = "dlm"
 	DMS                           = "dms"
 	DocDB                         = "docdb"
+	DRS                           = "drs"
 	DS                            = "ds"
 	DynamoDB                      = "dynamodb"
 	DynamoDBStreams               = "dynamodbstreams"
@@ -397,6 +402,7 @@ const (
 	GlobalAccelerator             = "globalaccelerator"
 	Glue                          = "glue"
 	GlueDataBrew                  = "gluedatabrew"
+	Grafana                       = "grafana"
 	Greengrass                    = "greengrass"
 	GreengrassV2                  = "greengrassv2"
 	GroundStation                 = "groundstation"
@@ -649,6 +655,7 @@ func init() {
 	serviceData[DLM] = &ServiceDatum{AWSClientName: "DLM", AWSServiceName: dlm.ServiceName, AWSEndpointsID: dlm.EndpointsID, AWSServiceID: dlm.ServiceID, ProviderNameUpper: "DLM", HCLKeys: []string{"dlm"}}
 	serviceData[DMS] = &ServiceDatum{AWSClientName: "DatabaseMigrationService", AWSServiceName: databasemigrationservice.ServiceName, AWSEndpointsID: databasemigrationservice.EndpointsID, AWSServiceID: databasemigrationservice.ServiceID, ProviderNameUpper: "DMS", HCLKeys: []string{"dms", "databasemigration", "databasemigrationservice"}}
 	serviceData[DocDB] = &ServiceDatum{AWSClientName: "DocDB", AWSServiceName: docdb.ServiceName, AWSEndpointsID: docdb.EndpointsID, AWSServiceID: docdb.ServiceID, ProviderNameUpper: "DocDB", HCLKeys: []string{"docdb"}}
+	serviceData[DRS] = &ServiceDatum{AWSClientName: "Drs", AWSServiceName: drs.ServiceName, AWSEndpointsID: drs.EndpointsID, AWSServiceID: drs.ServiceID, ProviderNameUpper: "DRS", HCLKeys: []string{"drs"}}
 	serviceData[DS] = &ServiceDatum{AWSClientName: "DirectoryService", AWSServiceName: directoryservice.ServiceName, AWSEndpointsID: directoryservice.EndpointsID, AWSServiceID: directoryservice.ServiceID, ProviderNameUpper: "DS", HCLKeys: []string{"ds"}}
 	serviceData[DynamoDB] = &ServiceDatum{AWSClientName: "DynamoDB", AWSServiceName: dynamodb.ServiceName, AWSEndpointsID: dynamodb.EndpointsID, AWSServiceID: dynamodb.ServiceID, ProviderNameUpper: "DynamoDB", HCLKeys: []string{"dynamodb"}}
 	serviceData[DynamoDBStreams] = &ServiceDatum{AWSClientName: "DynamoDBStreams", AWSServiceName: dynamodbstreams.ServiceName, AWSEndpointsID: dynamodbstreams.EndpointsID, AWSServiceID: dynamodbstreams.ServiceID, ProviderNameUpper: "DynamoDBStreams", HCLKeys: []string{"dynamodbstreams"}}
@@ -683,6 +690,7 @@ func init() {
 	serviceData[GlobalAccelerator] = &ServiceDatum{AWSClientName: "GlobalAccelerator", AWSServiceName: globalaccelerator.ServiceName, AWSEndpointsID: globalaccelerator.EndpointsID, AWSServiceID: globalaccelerator.ServiceID, ProviderNameUpper: "GlobalAccelerator", HCLKeys: []string{"globalaccelerator"}}
 	serviceData[Glue] = &ServiceDatum{AWSClientName: "Glue", AWSServiceName: glue.ServiceName, AWSEndpointsID: glue.EndpointsID, AWSServiceID: glue.ServiceID, ProviderNameUpper: "Glue", HCLKeys: []string{"glue"}}
 	serviceData[GlueDataBrew] = &ServiceDatum{AWSClientName: "GlueDataBrew", AWSServiceName: gluedatabrew.ServiceName, AWSEndpointsID: gluedatabrew.EndpointsID, AWSServiceID: gluedatabrew.ServiceID, ProviderNameUpper: "GlueDataBrew", HCLKeys: []string{"gluedatabrew"}}
+	serviceData[Grafana] = &ServiceDatum{AWSClientName: "ManagedGrafana", AWSServiceName: managedgrafana.ServiceName, AWSEndpointsID: managedgrafana.EndpointsID, AWSServiceID: managedgrafana.ServiceID, ProviderNameUpper: "Grafana", HCLKeys: []string{"grafana", "managedgrafana", "amg"}}
 	serviceData[Greengrass] = &ServiceDatum{AWSClientName: "Greengrass", AWSServiceName: greengrass.ServiceName, AWSEndpointsID: greengrass.EndpointsID, AWSServiceID: greengrass.ServiceID, ProviderNameUpper: "Greengrass", HCLKeys: []string{"greengrass"}}
 	serviceData[GreengrassV2] = &ServiceDatum{AWSClientName: "GreengrassV2", AWSServiceName: greengrassv2.ServiceName, AWSEndpointsID: greengrassv2.EndpointsID, AWSServiceID: greengrassv2.ServiceID, ProviderNameUpper: "GreengrassV2", HCLKeys: []string{"greengrassv2"}}
 	serviceData[GroundStation] = &ServiceDatum{AWSClientName: "GroundStation", AWSServiceName: groundstation.ServiceName, AWSEndpointsID: groundstation.EndpointsID, AWSServiceID: groundstation.ServiceID, ProviderNameUpper: "GroundStation", HCLKeys: []string{"groundstation"}}
@@ -853,6 +861,10 @@ type Config struct {
 	Token         string
 	Region        string
 	MaxRetries    int
+	MaxRetryDelay time.Duration
+	RetryMode     string
+
+	ServiceRetries map[string]RetryConfig
 
 	AssumeRoleARN               string
 	AssumeRoleDurationSeconds   int
@@ -863,6 +875,11 @@ type Config struct {
 	AssumeRoleTags              map[string]string
 	AssumeRoleTransitiveTagKeys []string
 
+	AssumeRoleWithWebIdentityARN             string
+	AssumeRoleWithWebIdentityDurationSeconds int
+	AssumeRoleWithWebIdentitySessionName     string
+	AssumeRoleWithWebIdentityTokenFile       string
+
 	AllowedAccountIds   []string
 	ForbiddenAccountIds []string
 
@@ -961,6 +978,7 @@ type AWSClient struct {
 	DMSConn                           *databasemigrationservice.DatabaseMigrationService
 	DNSSuffix                         string
 	DocDBConn                         *docdb.DocDB
+	DRSConn                           *drs.Drs
 	DSConn                            *directoryservice.DirectoryService
 	DynamoDBConn                      *dynamodb.DynamoDB
 	DynamoDBStreamsConn               *dynamodbstreams.DynamoDBStreams
@@ -995,6 +1013,7 @@ type AWSClient struct {
 	GlobalAcceleratorConn             *globalaccelerator.GlobalAccelerator
 	GlueConn                          *glue.Glue
 	GlueDataBrewConn                  *gluedatabrew.GlueDataBrew
+	GrafanaConn                       *managedgrafana.ManagedGrafana
 	GreengrassConn                    *greengrass.Greengrass
 	GreengrassV2Conn                  *greengrassv2.GreengrassV2
 	GroundStationConn                 *groundstation.GroundStation
@@ -1189,6 +1208,23 @@ func (c *Config) Client() (interface{}, error) {
 		}
 	}
 
+	envEndpoints, err := EndpointsFromEnvVar()
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Endpoints set in the provider configuration take precedence over the environment.
+	for k, v := range envEndpoints {
+		if c.Endpoints == nil {
+			c.Endpoints = make(map[string]string)
+		}
+
+		if c.Endpoints[k] == "" {
+			c.Endpoints[k] = v
+		}
+	}
+
 	awsbaseConfig := &awsbase.Config{
 		AccessKey:                   c.AccessKey,
 		AssumeRoleARN:               c.AssumeRoleARN,
@@ -1218,11 +1254,42 @@ func (c *Config) Client() (interface{}, error) {
 		UserAgentProducts:           StdUserAgentProducts(c.TerraformVersion),
 	}
 
+	// Web identity credentials replace any other credentials source.
+	// The initial credentials are handed to awsbase for validation and account ID lookup and
+	// the session is then switched over to the refreshing credentials.
+	var webIdentityCreds *credentials.Credentials
+
+	if c.AssumeRoleWithWebIdentityARN != "" {
+		webIdentityCreds, err = c.webIdentityCredentials()
+
+		if err != nil {
+			return nil, fmt.Errorf("error configuring web identity credentials: %w", err)
+		}
+
+		value, err := webIdentityCreds.Get()
+
+		if err != nil {
+			return nil, fmt.Errorf("error assuming role (%s) with web identity: %w", c.AssumeRoleWithWebIdentityARN, err)
+		}
+
+		awsbaseConfig.AccessKey = value.AccessKeyID
+		awsbaseConfig.CredsFilename = ""
+		awsbaseConfig.Profile = ""
+		awsbaseConfig.SecretKey = value.SecretAccessKey
+		awsbaseConfig.Token = value.SessionToken
+	}
+
 	sess, accountID, Partition, err := awsbase.GetSessionWithAccountIDAndPartition(awsbaseConfig)
 	if err != nil {
 		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
 	}
 
+	if webIdentityCreds != nil {
+		sess.Config.Credentials = webIdentityCreds
+	}
+
+	configureRetryers(sess, RetryConfig{MaxRetries: c.MaxRetries, MaxRetryDelay: c.MaxRetryDelay, RetryMode: c.RetryMode}, c.ServiceRetries)
+
 	if accountID == "" {
 		log.Printf("[WARN] AWS account ID not found for provider. See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for implications.")
 	}
@@ -1315,6 +1382,7 @@ func (c *Config) Client() (interface{}, error) {
 		DMSConn:                           databasemigrationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DMS])})),
 		DNSSuffix:                         DNSSuffix,
 		DocDBConn:                         docdb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DocDB])})),
+		DRSConn:                           drs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DRS])})),
 		DSConn:                            directoryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DS])})),
 		DynamoDBConn:                      dynamodb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DynamoDB])})),
 		DynamoDBStreamsConn:               dynamodbstreams.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DynamoDBStreams])})),
@@ -1348,6 +1416,7 @@ func (c *Config) Client() (interface{}, error) {
 		GlacierConn:                       glacier.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Glacier])})),
 		GlueConn:                          glue.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Glue])})),
 		GlueDataBrewConn:                  gluedatabrew.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[GlueDataBrew])})),
+		GrafanaConn:                       managedgrafana.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Grafana])})),
 		GreengrassConn:                    greengrass.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Greengrass])})),
 		GreengrassV2Conn:                  greengrassv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[GreengrassV2])})),
 		GroundStationConn:                 groundstation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[GroundStation])})),
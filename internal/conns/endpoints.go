@@ -0,0 +1,80 @@
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// EnvVarEndpoints configures custom service endpoints, in addition to the provider's endpoints configuration block.
+// The value is either a JSON object, e.g. {"s3":"http://localhost:4566"}, or a comma-separated list of key=value pairs,
+// e.g. s3=http://localhost:4566,sqs=http://localhost:4566, keyed by the argument names of the endpoints configuration block.
+const EnvVarEndpoints = "TF_AWS_ENDPOINTS"
+
+// EndpointsFromEnvVar returns the custom service endpoints configured in the environment, keyed by service.
+func EndpointsFromEnvVar() (map[string]string, error) {
+	v := strings.TrimSpace(os.Getenv(EnvVarEndpoints))
+
+	if v == "" {
+		return nil, nil
+	}
+
+	endpoints, err := ParseEndpoints(v)
+
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s environment variable: %w", EnvVarEndpoints, err)
+	}
+
+	return endpoints, nil
+}
+
+// ParseEndpoints parses a JSON or key=value list of custom service endpoints.
+// The returned map is keyed by service rather than by HCL key.
+func ParseEndpoints(s string) (map[string]string, error) {
+	rawEndpoints := make(map[string]string)
+
+	if strings.HasPrefix(s, "{") {
+		if err := json.Unmarshal([]byte(s), &rawEndpoints); err != nil {
+			return nil, err
+		}
+	} else {
+		for _, pair := range strings.Split(s, ",") {
+			pair = strings.TrimSpace(pair)
+
+			if pair == "" {
+				continue
+			}
+
+			parts := strings.SplitN(pair, "=", 2)
+
+			if len(parts) != 2 || parts[0] == "" {
+				return nil, fmt.Errorf("unexpected format for endpoint (%s), expected KEY=URL", pair)
+			}
+
+			rawEndpoints[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+		}
+	}
+
+	endpoints := make(map[string]string)
+
+	for hclKey, endpoint := range rawEndpoints {
+		if endpoint == "" {
+			continue
+		}
+
+		serviceKey, err := ServiceForHCLKey(hclKey)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if v, ok := endpoints[serviceKey]; ok && v != endpoint {
+			return nil, fmt.Errorf("conflicting endpoints configured for service %s: %s, %s", serviceKey, v, endpoint)
+		}
+
+		endpoints[serviceKey] = endpoint
+	}
+
+	return endpoints, nil
+}
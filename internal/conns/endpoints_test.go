@@ -0,0 +1,77 @@
+package conns
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseEndpoints(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Input       string
+		Expected    map[string]string
+		ExpectError bool
+	}{
+		{
+			Name:  "JSON",
+			Input: `{"dynamodb":"http://localhost:4566","s3":"http://localhost:4566"}`,
+			Expected: map[string]string{
+				DynamoDB: "http://localhost:4566",
+				S3:       "http://localhost:4566",
+			},
+		},
+		{
+			Name:  "key value pairs",
+			Input: "dynamodb=http://localhost:4566, s3=http://localhost:4566?a=b,",
+			Expected: map[string]string{
+				DynamoDB: "http://localhost:4566",
+				S3:       "http://localhost:4566?a=b",
+			},
+		},
+		{
+			Name:  "alternate keys",
+			Input: "dms=http://localhost:4566,databasemigration=http://localhost:4566",
+			Expected: map[string]string{
+				DMS: "http://localhost:4566",
+			},
+		},
+		{
+			Name:        "conflicting alternate keys",
+			Input:       "dms=http://localhost:4566,databasemigration=http://localhost:4567",
+			ExpectError: true,
+		},
+		{
+			Name:        "unknown key",
+			Input:       "notaservice=http://localhost:4566",
+			ExpectError: true,
+		},
+		{
+			Name:        "invalid pair",
+			Input:       "http://localhost:4566",
+			ExpectError: true,
+		},
+		{
+			Name:        "invalid JSON",
+			Input:       `{"s3":`,
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := ParseEndpoints(testCase.Input)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatal("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !testCase.ExpectError && !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package conns
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+const (
+	RetryModeAdaptive = "adaptive"
+	RetryModeLegacy   = "legacy"
+	RetryModeStandard = "standard"
+)
+
+func RetryMode_Values() []string {
+	return []string{
+		RetryModeAdaptive,
+		RetryModeLegacy,
+		RetryModeStandard,
+	}
+}
+
+// RetryConfig describes how failed AWS API requests are retried.
+// Zero values are inherited from the provider-level configuration.
+type RetryConfig struct {
+	MaxRetries    int
+	MaxRetryDelay time.Duration
+	RetryMode     string
+}
+
+// merge returns the configuration with any unset values taken from defaults.
+func (c RetryConfig) merge(defaults RetryConfig) RetryConfig {
+	if c.MaxRetries == 0 {
+		c.MaxRetries = defaults.MaxRetries
+	}
+
+	if c.MaxRetryDelay == 0 {
+		c.MaxRetryDelay = defaults.MaxRetryDelay
+	}
+
+	if c.RetryMode == "" {
+		c.RetryMode = defaults.RetryMode
+	}
+
+	return c
+}
+
+// retryer returns the retryer for the configuration.
+// aws-sdk-go v1 only has one retry strategy, so the legacy and standard modes both use the SDK's default retryer.
+func (c RetryConfig) retryer() request.Retryer {
+	retryer := client.DefaultRetryer{
+		NumMaxRetries:    c.MaxRetries,
+		MaxRetryDelay:    c.MaxRetryDelay,
+		MaxThrottleDelay: c.MaxRetryDelay,
+	}
+
+	if c.RetryMode == RetryModeAdaptive {
+		return &adaptiveRetryer{DefaultRetryer: retryer}
+	}
+
+	return retryer
+}
+
+// adaptiveRetryer adds client-side rate limiting to the default retryer.
+// After a throttling error all requests to the service are held back until the retry delay elapses,
+// so that many resources being applied in parallel don't keep the service throttled.
+type adaptiveRetryer struct {
+	client.DefaultRetryer
+
+	mu             sync.Mutex
+	throttledUntil time.Time
+}
+
+func (r *adaptiveRetryer) RetryRules(req *request.Request) time.Duration {
+	delay := r.DefaultRetryer.RetryRules(req)
+
+	if req.IsErrorThrottle() {
+		r.mu.Lock()
+		if until := time.Now().Add(delay); until.After(r.throttledUntil) {
+			r.throttledUntil = until
+		}
+		r.mu.Unlock()
+	}
+
+	return delay
+}
+
+// wait blocks until the service is no longer considered throttled or the context is cancelled.
+// A cancelled context fails the request when it is sent, so the error is not reported here.
+func (r *adaptiveRetryer) wait(ctx aws.Context) {
+	r.mu.Lock()
+	delay := time.Until(r.throttledUntil)
+	r.mu.Unlock()
+
+	if delay > 0 {
+		_ = aws.SleepWithContext(ctx, delay)
+	}
+}
+
+// retryers hands out one retryer per AWS service so that adaptive rate limiting state is shared
+// between all requests to a service.
+type retryers struct {
+	defaults RetryConfig
+	services map[string]RetryConfig
+
+	mu       sync.Mutex
+	retryers map[string]request.Retryer
+}
+
+func newRetryers(defaults RetryConfig, services map[string]RetryConfig) *retryers {
+	r := &retryers{
+		defaults: defaults,
+		services: make(map[string]RetryConfig),
+		retryers: make(map[string]request.Retryer),
+	}
+
+	// Requests identify their service by the SDK service ID.
+	for k, v := range services {
+		if datum, ok := serviceData[k]; ok {
+			r.services[datum.AWSServiceID] = v.merge(defaults)
+		}
+	}
+
+	return r
+}
+
+func (r *retryers) get(serviceID string) request.Retryer {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if v, ok := r.retryers[serviceID]; ok {
+		return v
+	}
+
+	config, ok := r.services[serviceID]
+
+	if !ok {
+		config = r.defaults
+	}
+
+	v := config.retryer()
+	r.retryers[serviceID] = v
+
+	return v
+}
+
+// configureRetryers installs session handlers that apply the retry configuration to every request.
+// The session is left untouched unless the configuration differs from the SDK's default retryer.
+func configureRetryers(sess *session.Session, defaults RetryConfig, services map[string]RetryConfig) {
+	if defaults.RetryMode != RetryModeAdaptive && defaults.MaxRetryDelay == 0 && len(services) == 0 {
+		return
+	}
+
+	retryers := newRetryers(defaults, services)
+
+	sess.Handlers.Validate.PushFrontNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.Retryer",
+		Fn: func(r *request.Request) {
+			r.Retryer = retryers.get(r.ClientInfo.ServiceID)
+		},
+	})
+
+	sess.Handlers.Send.PushFrontNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.AdaptiveRetryerWait",
+		Fn: func(r *request.Request) {
+			if v, ok := r.Retryer.(*adaptiveRetryer); ok {
+				v.wait(r.Context())
+			}
+		},
+	})
+}
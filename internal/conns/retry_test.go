@@ -0,0 +1,100 @@
+package conns
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+func TestRetryers(t *testing.T) {
+	defaults := RetryConfig{
+		MaxRetries: 25,
+		RetryMode:  RetryModeStandard,
+	}
+	services := map[string]RetryConfig{
+		EC2: {
+			RetryMode: RetryModeAdaptive,
+		},
+		ECS: {
+			MaxRetries:    50,
+			MaxRetryDelay: 30 * time.Second,
+		},
+	}
+
+	retryers := newRetryers(defaults, services)
+
+	ec2Retryer, ok := retryers.get(ec2.ServiceID).(*adaptiveRetryer)
+
+	if !ok {
+		t.Fatalf("expected adaptive retryer for %s", ec2.ServiceID)
+	}
+
+	if got, expected := ec2Retryer.MaxRetries(), 25; got != expected {
+		t.Errorf("got %d max retries for %s, expected %d", got, ec2.ServiceID, expected)
+	}
+
+	if retryers.get(ec2.ServiceID) != ec2Retryer {
+		t.Errorf("expected the %s retryer to be reused", ec2.ServiceID)
+	}
+
+	ecsRetryer, ok := retryers.get(ecs.ServiceID).(client.DefaultRetryer)
+
+	if !ok {
+		t.Fatalf("expected standard retryer for %s", ecs.ServiceID)
+	}
+
+	if got, expected := ecsRetryer.NumMaxRetries, 50; got != expected {
+		t.Errorf("got %d max retries for %s, expected %d", got, ecs.ServiceID, expected)
+	}
+
+	if got, expected := ecsRetryer.MaxThrottleDelay, 30*time.Second; got != expected {
+		t.Errorf("got %s max throttle delay for %s, expected %s", got, ecs.ServiceID, expected)
+	}
+
+	s3Retryer, ok := retryers.get(s3.ServiceID).(client.DefaultRetryer)
+
+	if !ok {
+		t.Fatalf("expected standard retryer for %s", s3.ServiceID)
+	}
+
+	if got, expected := s3Retryer.NumMaxRetries, 25; got != expected {
+		t.Errorf("got %d max retries for %s, expected %d", got, s3.ServiceID, expected)
+	}
+}
+
+func TestRetryConfigRetryer(t *testing.T) {
+	testCases := []struct {
+		RetryMode        string
+		ExpectedAdaptive bool
+	}{
+		{
+			RetryMode: "",
+		},
+		{
+			RetryMode:        RetryModeAdaptive,
+			ExpectedAdaptive: true,
+		},
+		{
+			RetryMode: RetryModeLegacy,
+		},
+		{
+			RetryMode: RetryModeStandard,
+		},
+	}
+
+	for _, testCase := range testCases {
+		retryer := RetryConfig{MaxRetries: 5, RetryMode: testCase.RetryMode}.retryer()
+
+		if _, ok := retryer.(*adaptiveRetryer); ok != testCase.ExpectedAdaptive {
+			t.Errorf("retry mode %q: got adaptive retryer %t, expected %t", testCase.RetryMode, ok, testCase.ExpectedAdaptive)
+		}
+
+		if got, expected := retryer.MaxRetries(), 5; got != expected {
+			t.Errorf("retry mode %q: got %d max retries, expected %d", testCase.RetryMode, got, expected)
+		}
+	}
+}
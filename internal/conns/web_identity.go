@@ -0,0 +1,84 @@
+package conns
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+// webIdentityCredentials returns credentials for the role assumed with the configured web identity token.
+// The token file is re-read whenever the credentials are refreshed, so tokens rotated by the
+// CI system (e.g. GitHub Actions or GitLab CI OIDC tokens) are picked up during long applies.
+func (c *Config) webIdentityCredentials() (*credentials.Credentials, error) {
+	options, err := c.webIdentitySessionOptions()
+
+	if err != nil {
+		return nil, err
+	}
+
+	sess, err := session.NewSessionWithOptions(*options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	provider := stscreds.NewWebIdentityRoleProvider(
+		sts.New(sess),
+		c.AssumeRoleWithWebIdentityARN,
+		c.AssumeRoleWithWebIdentitySessionName,
+		c.AssumeRoleWithWebIdentityTokenFile,
+	)
+
+	if c.AssumeRoleWithWebIdentityDurationSeconds > 0 {
+		provider.Duration = time.Duration(c.AssumeRoleWithWebIdentityDurationSeconds) * time.Second
+	}
+
+	return credentials.NewCredentials(provider), nil
+}
+
+// webIdentitySessionOptions returns the options of the session used to call STS.
+// They mirror those aws-sdk-go-base uses for the provider's own session: the same HTTP
+// client settings (proxy, TLS verification) and shared configuration, so that a CA bundle
+// set with AWS_CA_BUNDLE or in the profile applies as well.
+func (c *Config) webIdentitySessionOptions() (*session.Options, error) {
+	httpClient := cleanhttp.DefaultClient()
+	transport := httpClient.Transport.(*http.Transport)
+
+	if c.Insecure {
+		transport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: true,
+		}
+	}
+
+	if c.HTTPProxy != "" {
+		proxyURL, err := url.Parse(c.HTTPProxy)
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing HTTP proxy URL: %w", err)
+		}
+
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	return &session.Options{
+		Config: aws.Config{
+			// AssumeRoleWithWebIdentity requests are not signed.
+			Credentials: credentials.AnonymousCredentials,
+			Endpoint:    aws.String(c.Endpoints[STS]),
+			HTTPClient:  httpClient,
+			MaxRetries:  aws.Int(c.MaxRetries),
+			Region:      aws.String(c.Region),
+		},
+		Profile:           c.Profile,
+		SharedConfigState: session.SharedConfigEnable,
+	}, nil
+}
@@ -0,0 +1,269 @@
+package conns
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+const testAssumeRoleWithWebIdentityResponse = `<AssumeRoleWithWebIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleWithWebIdentityResult>
+    <Credentials>
+      <AccessKeyId>AKIAWEBIDENTITY</AccessKeyId>
+      <SecretAccessKey>secret</SecretAccessKey>
+      <SessionToken>session-token</SessionToken>
+      <Expiration>2099-01-01T00:00:00Z</Expiration>
+    </Credentials>
+  </AssumeRoleWithWebIdentityResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</AssumeRoleWithWebIdentityResponse>`
+
+// testWebIdentitySTS answers AssumeRoleWithWebIdentity requests that carry the expected token
+// and records the host of every request.
+type testWebIdentitySTS struct {
+	t *testing.T
+
+	mu    sync.Mutex
+	hosts []string
+	token string
+}
+
+func (s *testWebIdentitySTS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.hosts = append(s.hosts, r.Host)
+
+	if err := r.ParseForm(); err != nil {
+		s.t.Errorf("error parsing request: %s", err)
+	}
+
+	if got, expected := r.PostForm.Get("Action"), "AssumeRoleWithWebIdentity"; got != expected {
+		s.t.Errorf("got action %q, expected %q", got, expected)
+	}
+
+	if got := r.PostForm.Get("WebIdentityToken"); got != s.token {
+		s.t.Errorf("got web identity token %q, expected %q", got, s.token)
+	}
+
+	if got := r.Header.Get("Authorization"); got != "" {
+		s.t.Errorf("expected an unsigned request, got Authorization header %q", got)
+	}
+
+	w.Header().Set("Content-Type", "text/xml")
+	fmt.Fprint(w, testAssumeRoleWithWebIdentityResponse)
+}
+
+func (s *testWebIdentitySTS) setToken(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.token = token
+}
+
+func (s *testWebIdentitySTS) requestHosts() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string(nil), s.hosts...)
+}
+
+func testWebIdentityConfig(t *testing.T, token string) *Config {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+
+	if err := os.WriteFile(tokenFile, []byte(token), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return &Config{
+		AssumeRoleWithWebIdentityARN:         "arn:aws:iam::123456789012:role/test",
+		AssumeRoleWithWebIdentitySessionName: "test",
+		AssumeRoleWithWebIdentityTokenFile:   tokenFile,
+		Endpoints:                            map[string]string{},
+		Region:                               "us-west-2",
+	}
+}
+
+func TestWebIdentityCredentials(t *testing.T) {
+	sts := &testWebIdentitySTS{t: t, token: "token-1"}
+	server := httptest.NewServer(sts)
+	defer server.Close()
+
+	c := testWebIdentityConfig(t, "token-1")
+	c.Endpoints[STS] = server.URL
+
+	creds, err := c.webIdentityCredentials()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	value, err := creds.Get()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := value.AccessKeyID, "AKIAWEBIDENTITY"; got != expected {
+		t.Errorf("got access key %q, expected %q", got, expected)
+	}
+
+	if got, expected := value.SessionToken, "session-token"; got != expected {
+		t.Errorf("got session token %q, expected %q", got, expected)
+	}
+
+	if hosts := sts.requestHosts(); len(hosts) != 1 {
+		t.Errorf("got %d STS requests, expected 1", len(hosts))
+	}
+}
+
+func TestWebIdentityCredentials_httpProxy(t *testing.T) {
+	sts := &testWebIdentitySTS{t: t, token: "token-1"}
+	proxy := httptest.NewServer(sts)
+	defer proxy.Close()
+
+	c := testWebIdentityConfig(t, "token-1")
+	c.Endpoints[STS] = "http://sts.example.com"
+	c.HTTPProxy = proxy.URL
+
+	creds, err := c.webIdentityCredentials()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := creds.Get(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if hosts := sts.requestHosts(); len(hosts) != 1 || hosts[0] != "sts.example.com" {
+		t.Errorf("expected one request for sts.example.com through the proxy, got %q", hosts)
+	}
+}
+
+func TestWebIdentityCredentials_insecure(t *testing.T) {
+	sts := &testWebIdentitySTS{t: t, token: "token-1"}
+	server := httptest.NewTLSServer(sts)
+	defer server.Close()
+
+	c := testWebIdentityConfig(t, "token-1")
+	c.Endpoints[STS] = server.URL
+
+	creds, err := c.webIdentityCredentials()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := creds.Get(); err == nil {
+		t.Fatal("expected an error for the server's self-signed certificate")
+	}
+
+	c.Insecure = true
+
+	creds, err = c.webIdentityCredentials()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := creds.Get(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if hosts := sts.requestHosts(); len(hosts) != 1 {
+		t.Errorf("got %d STS requests, expected 1", len(hosts))
+	}
+}
+
+func TestWebIdentityCredentials_tokenRotation(t *testing.T) {
+	sts := &testWebIdentitySTS{t: t, token: "token-1"}
+	server := httptest.NewServer(sts)
+	defer server.Close()
+
+	c := testWebIdentityConfig(t, "token-1")
+	c.Endpoints[STS] = server.URL
+
+	creds, err := c.webIdentityCredentials()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := creds.Get(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	sts.setToken("token-2")
+
+	if err := os.WriteFile(c.AssumeRoleWithWebIdentityTokenFile, []byte("token-2"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	creds.Expire()
+
+	if _, err := creds.Get(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if hosts := sts.requestHosts(); len(hosts) != 2 {
+		t.Errorf("got %d STS requests, expected 2", len(hosts))
+	}
+}
+
+func TestWebIdentitySessionOptions(t *testing.T) {
+	c := &Config{
+		Endpoints: map[string]string{STS: "https://sts.example.com"},
+		HTTPProxy: "http://proxy.example.com:3128",
+		Insecure:  true,
+		Profile:   "example",
+		Region:    "eu-west-1",
+	}
+
+	options, err := c.webIdentitySessionOptions()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := *options.Config.Endpoint, "https://sts.example.com"; got != expected {
+		t.Errorf("got endpoint %q, expected %q", got, expected)
+	}
+
+	if got, expected := *options.Config.Region, "eu-west-1"; got != expected {
+		t.Errorf("got region %q, expected %q", got, expected)
+	}
+
+	if got, expected := options.Profile, "example"; got != expected {
+		t.Errorf("got profile %q, expected %q", got, expected)
+	}
+
+	transport := options.Config.HTTPClient.Transport.(*http.Transport)
+
+	if transport.TLSClientConfig == nil || !transport.TLSClientConfig.InsecureSkipVerify {
+		t.Error("expected TLS certificate verification to be disabled")
+	}
+
+	request, _ := http.NewRequest(http.MethodPost, "https://sts.example.com", nil)
+	proxyURL, err := transport.Proxy(request)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := proxyURL.String(), "http://proxy.example.com:3128"; got != expected {
+		t.Errorf("got proxy %q, expected %q", got, expected)
+	}
+
+	c.HTTPProxy = "://invalid"
+
+	if _, err := c.webIdentitySessionOptions(); err == nil {
+		t.Error("expected an error for an invalid proxy URL")
+	}
+}
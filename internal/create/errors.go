@@ -0,0 +1,69 @@
+package create
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+const (
+	ErrActionChecking           = "checking"
+	ErrActionCheckingDestroyed  = "checking destroyed"
+	ErrActionCheckingExistence  = "checking existence"
+	ErrActionCreating           = "creating"
+	ErrActionDeleting           = "deleting"
+	ErrActionReading            = "reading"
+	ErrActionSetting            = "setting"
+	ErrActionUpdating           = "updating"
+	ErrActionUpdatingTags       = "updating tags for"
+	ErrActionWaitingForCreation = "waiting for creation"
+	ErrActionWaitingForDeletion = "waiting for delete"
+	ErrActionWaitingForUpdate   = "waiting for update"
+)
+
+// ProblemStandardMessage returns the standard message for a failed operation on a resource,
+// e.g. "error creating ECS Cluster (example)". The AWS request ID is appended when the error
+// came from an AWS API call so that the failure can be found in CloudTrail.
+func ProblemStandardMessage(resourceType, action, id string, err error) string {
+	msg := fmt.Sprintf("error %s %s", action, resourceType)
+
+	if id != "" {
+		msg = fmt.Sprintf("%s (%s)", msg, id)
+	}
+
+	if requestID := RequestID(err); requestID != "" {
+		msg = fmt.Sprintf("%s [request ID: %s]", msg, requestID)
+	}
+
+	return msg
+}
+
+// Error returns an error wrapping err with the resource type, ID and operation that failed.
+func Error(resourceType, action, id string, err error) error {
+	return fmt.Errorf("%s: %w", ProblemStandardMessage(resourceType, action, id, err), err)
+}
+
+// DiagError returns an error diagnostic for the resource type, ID and operation that failed.
+// The summary identifies the resource and the detail carries the underlying error.
+func DiagError(resourceType, action, id string, err error) diag.Diagnostics {
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  ProblemStandardMessage(resourceType, action, id, err),
+			Detail:   err.Error(),
+		},
+	}
+}
+
+// RequestID returns the AWS request ID of the API call that produced err, if any.
+func RequestID(err error) string {
+	var requestFailure awserr.RequestFailure
+
+	if errors.As(err, &requestFailure) {
+		return requestFailure.RequestID()
+	}
+
+	return ""
+}
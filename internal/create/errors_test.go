@@ -0,0 +1,82 @@
+package create
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+func TestError(t *testing.T) {
+	testCases := []struct {
+		TestName        string
+		ResourceType    string
+		Action          string
+		ID              string
+		Err             error
+		ExpectedMessage string
+	}{
+		{
+			TestName:        "no ID",
+			ResourceType:    "EC2 Capacity Reservation",
+			Action:          ErrActionCreating,
+			Err:             errors.New("boom"),
+			ExpectedMessage: "error creating EC2 Capacity Reservation: boom",
+		},
+		{
+			TestName:        "ID",
+			ResourceType:    "ECS Cluster",
+			Action:          ErrActionDeleting,
+			ID:              "arn:aws:ecs:us-west-2:123456789012:cluster/test",
+			Err:             errors.New("boom"),
+			ExpectedMessage: "error deleting ECS Cluster (arn:aws:ecs:us-west-2:123456789012:cluster/test): boom",
+		},
+		{
+			TestName:        "tags",
+			ResourceType:    "ECS Service",
+			Action:          ErrActionUpdatingTags,
+			ID:              "test",
+			Err:             errors.New("boom"),
+			ExpectedMessage: "error updating tags for ECS Service (test): boom",
+		},
+		{
+			TestName:        "request ID",
+			ResourceType:    "ECS Cluster",
+			Action:          ErrActionReading,
+			ID:              "test",
+			Err:             awserr.NewRequestFailure(awserr.New("AccessDeniedException", "denied", nil), 400, "b25f48e8-84fd-11e6-80d9-574e0c4664cb"),
+			ExpectedMessage: "error reading ECS Cluster (test) [request ID: b25f48e8-84fd-11e6-80d9-574e0c4664cb]: AccessDeniedException: denied\n\tstatus code: 400, request id: b25f48e8-84fd-11e6-80d9-574e0c4664cb",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			err := Error(testCase.ResourceType, testCase.Action, testCase.ID, testCase.Err)
+
+			if got := err.Error(); got != testCase.ExpectedMessage {
+				t.Errorf("got %q, expected %q", got, testCase.ExpectedMessage)
+			}
+
+			if !errors.Is(err, testCase.Err) {
+				t.Errorf("expected error to wrap %q", testCase.Err)
+			}
+		})
+	}
+}
+
+func TestDiagError(t *testing.T) {
+	err := awserr.NewRequestFailure(awserr.New("ClusterNotFoundException", "not found", nil), 400, "request-1")
+	diags := DiagError("ECS Cluster", ErrActionUpdating, "test", err)
+
+	if got, expected := len(diags), 1; got != expected {
+		t.Fatalf("got %d diagnostics, expected %d", got, expected)
+	}
+
+	if got, expected := diags[0].Summary, "error updating ECS Cluster (test) [request ID: request-1]"; got != expected {
+		t.Errorf("got summary %q, expected %q", got, expected)
+	}
+
+	if got, expected := diags[0].Detail, err.Error(); got != expected {
+		t.Errorf("got detail %q, expected %q", got, expected)
+	}
+}
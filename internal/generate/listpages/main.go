@@ -362,8 +362,8 @@ func init() {
 	awsServiceNames["apigatewaymanagement"] = "APIGatewayManagement"
 	awsServiceNames["apigatewayv2"] = "APIGatewayV2"
 	awsServiceNames["appconfig"] = "AppConfig"
-	awsServiceNames["appflow"] = "AppFlow"
-	awsServiceNames["appintegrations"] = "AppIntegrations"
+	awsServiceNames["appflow"] = "Appflow"
+	awsServiceNames["appintegrationsservice"] = "AppIntegrationsService"
 	awsServiceNames["applicationautoscaling"] = "ApplicationAutoScaling"
 	awsServiceNames["applicationcostprofiler"] = "ApplicationCostProfiler"
 	awsServiceNames["applicationdiscovery"] = "ApplicationDiscovery"
@@ -430,6 +430,7 @@ func init() {
 	awsServiceNames["directoryservice"] = "DirectoryService"
 	awsServiceNames["dlm"] = "DLM"
 	awsServiceNames["docdb"] = "DocDB"
+	awsServiceNames["drs"] = "Drs"
 	awsServiceNames["dynamodb"] = "DynamoDB"
 	awsServiceNames["dynamodbattribute"] = "DynamoDBAttribute"
 	awsServiceNames["dynamodbstreams"] = "DynamoDBStreams"
@@ -451,7 +452,7 @@ func init() {
 	awsServiceNames["emrcontainers"] = "EMRContainers"
 	awsServiceNames["eventbridge"] = "EventBridge"
 	awsServiceNames["expression"] = "Expression"
-	awsServiceNames["finspace"] = "FinSpace"
+	awsServiceNames["finspace"] = "Finspace"
 	awsServiceNames["finspacedata"] = "FinSpaceData"
 	awsServiceNames["firehose"] = "Firehose"
 	awsServiceNames["fis"] = "FIS"
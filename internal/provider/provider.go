@@ -3,6 +3,8 @@ package provider
 import (
 	"fmt"
 	"log"
+	"regexp"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -17,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/apigatewayv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appautoscaling"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appconfig"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/appflow"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/appintegrations"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appmesh"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/apprunner"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appstream"
@@ -45,6 +49,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/codestarnotifications"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/cognitoidentity"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/cognitoidp"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/comprehend"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/configservice"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/connect"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/cur"
@@ -56,6 +61,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dlm"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dms"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/docdb"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/drs"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ds"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
@@ -72,6 +78,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/elbv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/emr"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/events"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/finspace"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/firehose"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/fms"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/fsx"
@@ -79,13 +86,18 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/glacier"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/globalaccelerator"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/glue"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/grafana"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/greengrassv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/groundstation"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/guardduty"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/healthlake"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/identitystore"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/imagebuilder"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/inspector"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/iot"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kafka"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kinesis"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kinesisanalytics"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kinesisanalyticsv2"
@@ -98,6 +110,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/macie"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/macie2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/managedblockchain"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediapackage"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediastore"
@@ -111,11 +124,13 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/outposts"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pricing"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/proton"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/qldb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ram"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/rds"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/rekognition"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroups"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53"
@@ -138,6 +153,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/shield"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/signer"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/simpledb"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/snowball"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sns"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
@@ -189,6 +205,8 @@ func Provider() *schema.Provider {
 
 			"assume_role": assumeRoleSchema(),
 
+			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+
 			"shared_credentials_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -221,6 +239,23 @@ func Provider() *schema.Provider {
 				Description: descriptions["max_retries"],
 			},
 
+			"max_retry_delay_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  descriptions["max_retry_delay_seconds"],
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"retry_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("AWS_RETRY_MODE", conns.RetryModeStandard),
+				Description:  descriptions["retry_mode"],
+				ValidateFunc: validation.StringInSlice(conns.RetryMode_Values(), false),
+			},
+
+			"service_retry": serviceRetrySchema(),
+
 			"allowed_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -283,6 +318,16 @@ func Provider() *schema.Provider {
 							Set:         schema.HashString,
 							Description: "Resource tag key prefixes to ignore across all resources.",
 						},
+						"key_regexes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+							Set:         schema.HashString,
+							Description: "Regular expressions matching resource tag keys to ignore across all resources.",
+						},
 					},
 				},
 			},
@@ -443,6 +488,8 @@ func Provider() *schema.Provider {
 			"aws_ec2_managed_prefix_list":                    ec2.DataSourceManagedPrefixList(),
 			"aws_ec2_spot_price":                             ec2.DataSourceSpotPrice(),
 			"aws_ec2_transit_gateway":                        ec2.DataSourceTransitGateway(),
+			"aws_ec2_transit_gateway_connect":                ec2.DataSourceTransitGatewayConnect(),
+			"aws_ec2_transit_gateway_connect_peer":           ec2.DataSourceTransitGatewayConnectPeer(),
 			"aws_ec2_transit_gateway_dx_gateway_attachment":  ec2.DataSourceTransitGatewayDxGatewayAttachment(),
 			"aws_ec2_transit_gateway_peering_attachment":     ec2.DataSourceTransitGatewayPeeringAttachment(),
 			"aws_ec2_transit_gateway_route_table":            ec2.DataSourceTransitGatewayRouteTable(),
@@ -474,6 +521,7 @@ func Provider() *schema.Provider {
 			"aws_vpc_peering_connection":                     ec2.DataSourceVPCPeeringConnection(),
 			"aws_vpc_peering_connections":                    ec2.DataSourceVPCPeeringConnections(),
 			"aws_vpc":                                        ec2.DataSourceVPC(),
+			"aws_vpc_cidr_block_associations":                ec2.DataSourceVPCCIDRBlockAssociations(),
 			"aws_vpcs":                                       ec2.DataSourceVPCs(),
 			"aws_vpn_gateway":                                ec2.DataSourceVPNGateway(),
 
@@ -481,10 +529,12 @@ func Provider() *schema.Provider {
 			"aws_ecr_image":               ecr.DataSourceImage(),
 			"aws_ecr_repository":          ecr.DataSourceRepository(),
 
-			"aws_ecs_cluster":              ecs.DataSourceCluster(),
-			"aws_ecs_container_definition": ecs.DataSourceContainerDefinition(),
-			"aws_ecs_service":              ecs.DataSourceService(),
-			"aws_ecs_task_definition":      ecs.DataSourceTaskDefinition(),
+			"aws_ecs_cluster":                              ecs.DataSourceCluster(),
+			"aws_ecs_container_definition":                 ecs.DataSourceContainerDefinition(),
+			"aws_ecs_service":                              ecs.DataSourceService(),
+			"aws_ecs_task_definition":                      ecs.DataSourceTaskDefinition(),
+			"aws_ecs_task_definition_container_definition": ecs.DataSourceTaskDefinitionContainerDefinition(),
+			"aws_ecs_task_execution":                       ecs.DataSourceTaskExecution(),
 
 			"aws_efs_access_point":  efs.DataSourceAccessPoint(),
 			"aws_efs_access_points": efs.DataSourceAccessPoints(),
@@ -781,6 +831,10 @@ func Provider() *schema.Provider {
 			"aws_appconfig_environment":                  appconfig.ResourceEnvironment(),
 			"aws_appconfig_hosted_configuration_version": appconfig.ResourceHostedConfigurationVersion(),
 
+			"aws_appflow_flow": appflow.ResourceFlow(),
+
+			"aws_appintegrations_event_integration": appintegrations.ResourceEventIntegration(),
+
 			"aws_appautoscaling_policy":           appautoscaling.ResourcePolicy(),
 			"aws_appautoscaling_scheduled_action": appautoscaling.ResourceScheduledAction(),
 			"aws_appautoscaling_target":           appautoscaling.ResourceTarget(),
@@ -843,6 +897,8 @@ func Provider() *schema.Provider {
 			"aws_budgets_budget":        budgets.ResourceBudget(),
 			"aws_budgets_budget_action": budgets.ResourceBudgetAction(),
 
+			"aws_chime_sip_media_application":                   chime.ResourceSipMediaApplication(),
+			"aws_chime_sip_rule":                                chime.ResourceSipRule(),
 			"aws_chime_voice_connector":                         chime.ResourceVoiceConnector(),
 			"aws_chime_voice_connector_group":                   chime.ResourceVoiceConnectorGroup(),
 			"aws_chime_voice_connector_logging":                 chime.ResourceVoiceConnectorLogging(),
@@ -937,6 +993,9 @@ func Provider() *schema.Provider {
 			"aws_cognito_user_pool_domain":           cognitoidp.ResourceUserPoolDomain(),
 			"aws_cognito_user_pool_ui_customization": cognitoidp.ResourceUserPoolUICustomization(),
 
+			"aws_comprehend_document_classifier": comprehend.ResourceDocumentClassifier(),
+			"aws_comprehend_endpoint":            comprehend.ResourceEndpoint(),
+
 			"aws_config_aggregate_authorization":       configservice.ResourceAggregateAuthorization(),
 			"aws_config_config_rule":                   configservice.ResourceConfigRule(),
 			"aws_config_configuration_aggregator":      configservice.ResourceConfigurationAggregator(),
@@ -959,6 +1018,7 @@ func Provider() *schema.Provider {
 			"aws_datasync_agent":                            datasync.ResourceAgent(),
 			"aws_datasync_location_efs":                     datasync.ResourceLocationEFS(),
 			"aws_datasync_location_fsx_windows_file_system": datasync.ResourceLocationFSxWindowsFileSystem(),
+			"aws_datasync_location_hdfs":                    datasync.ResourceLocationHDFS(),
 			"aws_datasync_location_nfs":                     datasync.ResourceLocationNFS(),
 			"aws_datasync_location_s3":                      datasync.ResourceLocationS3(),
 			"aws_datasync_location_smb":                     datasync.ResourceLocationSMB(),
@@ -1005,6 +1065,8 @@ func Provider() *schema.Provider {
 			"aws_docdb_global_cluster":          docdb.ResourceGlobalCluster(),
 			"aws_docdb_subnet_group":            docdb.ResourceSubnetGroup(),
 
+			"aws_drs_replication_configuration_template": drs.ResourceReplicationConfigurationTemplate(),
+
 			"aws_directory_service_conditional_forwarder": ds.ResourceConditionalForwarder(),
 			"aws_directory_service_directory":             ds.ResourceDirectory(),
 			"aws_directory_service_log_subscription":      ds.ResourceLogSubscription(),
@@ -1034,6 +1096,7 @@ func Provider() *schema.Provider {
 			"aws_ebs_volume":                                      ec2.ResourceEBSVolume(),
 			"aws_ec2_availability_zone_group":                     ec2.ResourceAvailabilityZoneGroup(),
 			"aws_ec2_capacity_reservation":                        ec2.ResourceCapacityReservation(),
+			"aws_ec2_capacity_reservation_fleet":                  ec2.ResourceCapacityReservationFleet(),
 			"aws_ec2_carrier_gateway":                             ec2.ResourceCarrierGateway(),
 			"aws_ec2_client_vpn_authorization_rule":               ec2.ResourceClientVPNAuthorizationRule(),
 			"aws_ec2_client_vpn_endpoint":                         ec2.ResourceClientVPNEndpoint(),
@@ -1044,13 +1107,18 @@ func Provider() *schema.Provider {
 			"aws_ec2_local_gateway_route":                         ec2.ResourceLocalGatewayRoute(),
 			"aws_ec2_local_gateway_route_table_vpc_association":   ec2.ResourceLocalGatewayRouteTableVPCAssociation(),
 			"aws_ec2_managed_prefix_list":                         ec2.ResourceManagedPrefixList(),
+			"aws_ec2_managed_prefix_list_entries":                 ec2.ResourceManagedPrefixListEntries(),
 			"aws_ec2_managed_prefix_list_entry":                   ec2.ResourceManagedPrefixListEntry(),
+			"aws_ec2_network_insights_analysis":                   ec2.ResourceNetworkInsightsAnalysis(),
+			"aws_ec2_network_insights_path":                       ec2.ResourceNetworkInsightsPath(),
+			"aws_ec2_subnet_cidr_reservation":                     ec2.ResourceSubnetCIDRReservation(),
 			"aws_ec2_tag":                                         ec2.ResourceTag(),
 			"aws_ec2_traffic_mirror_filter":                       ec2.ResourceTrafficMirrorFilter(),
 			"aws_ec2_traffic_mirror_filter_rule":                  ec2.ResourceTrafficMirrorFilterRule(),
 			"aws_ec2_traffic_mirror_session":                      ec2.ResourceTrafficMirrorSession(),
 			"aws_ec2_traffic_mirror_target":                       ec2.ResourceTrafficMirrorTarget(),
 			"aws_ec2_transit_gateway":                             ec2.ResourceTransitGateway(),
+			"aws_ec2_transit_gateway_connect":                     ec2.ResourceTransitGatewayConnect(),
 			"aws_ec2_transit_gateway_peering_attachment":          ec2.ResourceTransitGatewayPeeringAttachment(),
 			"aws_ec2_transit_gateway_peering_attachment_accepter": ec2.ResourceTransitGatewayPeeringAttachmentAccepter(),
 			"aws_ec2_transit_gateway_prefix_list_reference":       ec2.ResourceTransitGatewayPrefixListReference(),
@@ -1058,11 +1126,13 @@ func Provider() *schema.Provider {
 			"aws_ec2_transit_gateway_route_table":                 ec2.ResourceTransitGatewayRouteTable(),
 			"aws_ec2_transit_gateway_route_table_association":     ec2.ResourceTransitGatewayRouteTableAssociation(),
 			"aws_ec2_transit_gateway_route_table_propagation":     ec2.ResourceTransitGatewayRouteTablePropagation(),
+			"aws_ec2_transit_gateway_route_table_routes":          ec2.ResourceTransitGatewayRouteTableRoutes(),
 			"aws_ec2_transit_gateway_vpc_attachment":              ec2.ResourceTransitGatewayVPCAttachment(),
 			"aws_ec2_transit_gateway_vpc_attachment_accepter":     ec2.ResourceTransitGatewayVPCAttachmentAccepter(),
 			"aws_egress_only_internet_gateway":                    ec2.ResourceEgressOnlyInternetGateway(),
 			"aws_eip":                                             ec2.ResourceEIP(),
 			"aws_eip_association":                                 ec2.ResourceEIPAssociation(),
+			"aws_eip_domain_name":                                 ec2.ResourceEIPDomainName(),
 			"aws_flow_log":                                        ec2.ResourceFlowLog(),
 			"aws_instance":                                        ec2.ResourceInstance(),
 			"aws_internet_gateway":                                ec2.ResourceInternetGateway(),
@@ -1079,6 +1149,7 @@ func Provider() *schema.Provider {
 			"aws_route":                                           ec2.ResourceRoute(),
 			"aws_route_table":                                     ec2.ResourceRouteTable(),
 			"aws_route_table_association":                         ec2.ResourceRouteTableAssociation(),
+			"aws_route_table_routes_exclusive":                    ec2.ResourceRouteTableRoutesExclusive(),
 			"aws_security_group":                                  ec2.ResourceSecurityGroup(),
 			"aws_security_group_rule":                             ec2.ResourceSecurityGroupRule(),
 			"aws_snapshot_create_volume_permission":               ec2.ResourceSnapshotCreateVolumePermission(),
@@ -1097,6 +1168,7 @@ func Provider() *schema.Provider {
 			"aws_vpc_endpoint_service_allowed_principal":          ec2.ResourceVPCEndpointServiceAllowedPrincipal(),
 			"aws_vpc_endpoint_subnet_association":                 ec2.ResourceVPCEndpointSubnetAssociation(),
 			"aws_vpc_ipv4_cidr_block_association":                 ec2.ResourceVPCIPv4CIDRBlockAssociation(),
+			"aws_vpc_ipv6_cidr_block_association":                 ec2.ResourceVPCIPv6CIDRBlockAssociation(),
 			"aws_vpc_peering_connection":                          ec2.ResourceVPCPeeringConnection(),
 			"aws_vpc_peering_connection_accepter":                 ec2.ResourceVPCPeeringConnectionAccepter(),
 			"aws_vpc_peering_connection_options":                  ec2.ResourceVPCPeeringConnectionOptions(),
@@ -1114,12 +1186,14 @@ func Provider() *schema.Provider {
 
 			"aws_ecrpublic_repository": ecrpublic.ResourceRepository(),
 
-			"aws_ecs_account_setting_default": ecs.ResourceAccountSettingDefault(),
-			"aws_ecs_capacity_provider":       ecs.ResourceCapacityProvider(),
-			"aws_ecs_cluster":                 ecs.ResourceCluster(),
-			"aws_ecs_service":                 ecs.ResourceService(),
-			"aws_ecs_tag":                     ecs.ResourceTag(),
-			"aws_ecs_task_definition":         ecs.ResourceTaskDefinition(),
+			"aws_ecs_account_setting_default":    ecs.ResourceAccountSettingDefault(),
+			"aws_ecs_capacity_provider":          ecs.ResourceCapacityProvider(),
+			"aws_ecs_cluster":                    ecs.ResourceCluster(),
+			"aws_ecs_cluster_capacity_providers": ecs.ResourceClusterCapacityProviders(),
+			"aws_ecs_service":                    ecs.ResourceService(),
+			"aws_ecs_tag":                        ecs.ResourceTag(),
+			"aws_ecs_task_definition":            ecs.ResourceTaskDefinition(),
+			"aws_ecs_task_set":                   ecs.ResourceTaskSet(),
 
 			"aws_efs_access_point":       efs.ResourceAccessPoint(),
 			"aws_efs_backup_policy":      efs.ResourceBackupPolicy(),
@@ -1147,9 +1221,11 @@ func Provider() *schema.Provider {
 			"aws_elastic_beanstalk_configuration_template": elasticbeanstalk.ResourceConfigurationTemplate(),
 			"aws_elastic_beanstalk_environment":            elasticbeanstalk.ResourceEnvironment(),
 
-			"aws_elasticsearch_domain":              elasticsearch.ResourceDomain(),
-			"aws_elasticsearch_domain_policy":       elasticsearch.ResourceDomainPolicy(),
-			"aws_elasticsearch_domain_saml_options": elasticsearch.ResourceDomainSAMLOptions(),
+			"aws_elasticsearch_domain":                      elasticsearch.ResourceDomain(),
+			"aws_elasticsearch_domain_policy":               elasticsearch.ResourceDomainPolicy(),
+			"aws_elasticsearch_domain_saml_options":         elasticsearch.ResourceDomainSAMLOptions(),
+			"aws_elasticsearch_inbound_connection_accepter": elasticsearch.ResourceInboundConnectionAccepter(),
+			"aws_elasticsearch_outbound_connection":         elasticsearch.ResourceOutboundConnection(),
 
 			"aws_elastictranscoder_pipeline": elastictranscoder.ResourcePipeline(),
 			"aws_elastictranscoder_preset":   elastictranscoder.ResourcePreset(),
@@ -1183,6 +1259,8 @@ func Provider() *schema.Provider {
 			"aws_emr_managed_scaling_policy": emr.ResourceManagedScalingPolicy(),
 			"aws_emr_security_configuration": emr.ResourceSecurityConfiguration(),
 
+			"aws_finspace_environment": finspace.ResourceEnvironment(),
+
 			"aws_kinesis_firehose_delivery_stream": firehose.ResourceDeliveryStream(),
 
 			"aws_fms_admin_account": fms.ResourceAdminAccount(),
@@ -1198,6 +1276,7 @@ func Provider() *schema.Provider {
 			"aws_gamelift_alias":              gamelift.ResourceAlias(),
 			"aws_gamelift_build":              gamelift.ResourceBuild(),
 			"aws_gamelift_fleet":              gamelift.ResourceFleet(),
+			"aws_gamelift_game_server_group":  gamelift.ResourceGameServerGroup(),
 			"aws_gamelift_game_session_queue": gamelift.ResourceGameSessionQueue(),
 
 			"aws_glacier_vault":      glacier.ResourceVault(),
@@ -1226,6 +1305,16 @@ func Provider() *schema.Provider {
 			"aws_glue_user_defined_function":            glue.ResourceUserDefinedFunction(),
 			"aws_glue_workflow":                         glue.ResourceWorkflow(),
 
+			"aws_grafana_workspace":                    grafana.ResourceWorkspace(),
+			"aws_grafana_workspace_saml_configuration": grafana.ResourceWorkspaceSAMLConfiguration(),
+
+			"aws_greengrassv2_component_version": greengrassv2.ResourceComponentVersion(),
+			"aws_greengrassv2_deployment":        greengrassv2.ResourceDeployment(),
+
+			"aws_groundstation_config":                  groundstation.ResourceConfig(),
+			"aws_groundstation_dataflow_endpoint_group": groundstation.ResourceDataflowEndpointGroup(),
+			"aws_groundstation_mission_profile":         groundstation.ResourceMissionProfile(),
+
 			"aws_guardduty_detector":                   guardduty.ResourceDetector(),
 			"aws_guardduty_filter":                     guardduty.ResourceFilter(),
 			"aws_guardduty_invite_accepter":            guardduty.ResourceInviteAccepter(),
@@ -1236,6 +1325,8 @@ func Provider() *schema.Provider {
 			"aws_guardduty_publishing_destination":     guardduty.ResourcePublishingDestination(),
 			"aws_guardduty_threatintelset":             guardduty.ResourceThreatintelset(),
 
+			"aws_healthlake_fhir_datastore": healthlake.ResourceFHIRDatastore(),
+
 			"aws_iam_access_key":              iam.ResourceAccessKey(),
 			"aws_iam_account_alias":           iam.ResourceAccountAlias(),
 			"aws_iam_account_password_policy": iam.ResourceAccountPasswordPolicy(),
@@ -1273,6 +1364,9 @@ func Provider() *schema.Provider {
 
 			"aws_iot_authorizer":                 iot.ResourceAuthorizer(),
 			"aws_iot_certificate":                iot.ResourceCertificate(),
+			"aws_iot_domain_configuration":       iot.ResourceDomainConfiguration(),
+			"aws_iot_dynamic_thing_group":        iot.ResourceDynamicThingGroup(),
+			"aws_iot_indexing_configuration":     iot.ResourceIndexingConfiguration(),
 			"aws_iot_policy":                     iot.ResourcePolicy(),
 			"aws_iot_policy_attachment":          iot.ResourcePolicyAttachment(),
 			"aws_iot_role_alias":                 iot.ResourceRoleAlias(),
@@ -1287,6 +1381,9 @@ func Provider() *schema.Provider {
 			"aws_msk_configuration":            kafka.ResourceConfiguration(),
 			"aws_msk_scram_secret_association": kafka.ResourceScramSecretAssociation(),
 
+			"aws_kendra_faq":       kendra.ResourceFaq(),
+			"aws_kendra_thesaurus": kendra.ResourceThesaurus(),
+
 			"aws_kinesis_stream":          kinesis.ResourceStream(),
 			"aws_kinesis_stream_consumer": kinesis.ResourceStreamConsumer(),
 
@@ -1326,12 +1423,17 @@ func Provider() *schema.Provider {
 			"aws_licensemanager_association":           licensemanager.ResourceAssociation(),
 			"aws_licensemanager_license_configuration": licensemanager.ResourceLicenseConfiguration(),
 
-			"aws_lightsail_domain":                lightsail.ResourceDomain(),
-			"aws_lightsail_instance":              lightsail.ResourceInstance(),
-			"aws_lightsail_instance_public_ports": lightsail.ResourceInstancePublicPorts(),
-			"aws_lightsail_key_pair":              lightsail.ResourceKeyPair(),
-			"aws_lightsail_static_ip":             lightsail.ResourceStaticIP(),
-			"aws_lightsail_static_ip_attachment":  lightsail.ResourceStaticIPAttachment(),
+			"aws_lightsail_bucket":                               lightsail.ResourceBucket(),
+			"aws_lightsail_bucket_resource_access":               lightsail.ResourceBucketResourceAccess(),
+			"aws_lightsail_container_service":                    lightsail.ResourceContainerService(),
+			"aws_lightsail_container_service_deployment_version": lightsail.ResourceContainerServiceDeploymentVersion(),
+			"aws_lightsail_distribution":                         lightsail.ResourceDistribution(),
+			"aws_lightsail_domain":                               lightsail.ResourceDomain(),
+			"aws_lightsail_instance":                             lightsail.ResourceInstance(),
+			"aws_lightsail_instance_public_ports":                lightsail.ResourceInstancePublicPorts(),
+			"aws_lightsail_key_pair":                             lightsail.ResourceKeyPair(),
+			"aws_lightsail_static_ip":                            lightsail.ResourceStaticIP(),
+			"aws_lightsail_static_ip_attachment":                 lightsail.ResourceStaticIPAttachment(),
 
 			"aws_macie_member_account_association": macie.ResourceMemberAccountAssociation(),
 			"aws_macie_s3_bucket_association":      macie.ResourceS3BucketAssociation(),
@@ -1344,6 +1446,9 @@ func Provider() *schema.Provider {
 			"aws_macie2_member":                     macie2.ResourceMember(),
 			"aws_macie2_organization_admin_account": macie2.ResourceOrganizationAdminAccount(),
 
+			"aws_managedblockchain_proposal":      managedblockchain.ResourceProposal(),
+			"aws_managedblockchain_proposal_vote": managedblockchain.ResourceProposalVote(),
+
 			"aws_media_convert_queue": mediaconvert.ResourceQueue(),
 
 			"aws_media_package_channel": mediapackage.ResourceChannel(),
@@ -1403,16 +1508,30 @@ func Provider() *schema.Provider {
 			"aws_pinpoint_app":                       pinpoint.ResourceApp(),
 			"aws_pinpoint_baidu_channel":             pinpoint.ResourceBaiduChannel(),
 			"aws_pinpoint_email_channel":             pinpoint.ResourceEmailChannel(),
+			"aws_pinpoint_email_template":            pinpoint.ResourceEmailTemplate(),
 			"aws_pinpoint_event_stream":              pinpoint.ResourceEventStream(),
 			"aws_pinpoint_gcm_channel":               pinpoint.ResourceGCMChannel(),
+			"aws_pinpoint_journey":                   pinpoint.ResourceJourney(),
+			"aws_pinpoint_push_template":             pinpoint.ResourcePushTemplate(),
 			"aws_pinpoint_sms_channel":               pinpoint.ResourceSMSChannel(),
+			"aws_pinpoint_sms_template":              pinpoint.ResourceSMSTemplate(),
+
+			"aws_proton_environment":                  proton.ResourceEnvironment(),
+			"aws_proton_environment_template":         proton.ResourceEnvironmentTemplate(),
+			"aws_proton_environment_template_version": proton.ResourceEnvironmentTemplateVersion(),
+			"aws_proton_service":                      proton.ResourceService(),
+			"aws_proton_service_template":             proton.ResourceServiceTemplate(),
+			"aws_proton_service_template_version":     proton.ResourceServiceTemplateVersion(),
 
 			"aws_qldb_ledger": qldb.ResourceLedger(),
 
-			"aws_quicksight_data_source":      quicksight.ResourceDataSource(),
-			"aws_quicksight_group":            quicksight.ResourceGroup(),
-			"aws_quicksight_group_membership": quicksight.ResourceGroupMembership(),
-			"aws_quicksight_user":             quicksight.ResourceUser(),
+			"aws_quicksight_data_set":          quicksight.ResourceDataSet(),
+			"aws_quicksight_data_source":       quicksight.ResourceDataSource(),
+			"aws_quicksight_folder":            quicksight.ResourceFolder(),
+			"aws_quicksight_folder_membership": quicksight.ResourceFolderMembership(),
+			"aws_quicksight_group":             quicksight.ResourceGroup(),
+			"aws_quicksight_group_membership":  quicksight.ResourceGroupMembership(),
+			"aws_quicksight_user":              quicksight.ResourceUser(),
 
 			"aws_ram_principal_association":   ram.ResourcePrincipalAssociation(),
 			"aws_ram_resource_association":    ram.ResourceResourceAssociation(),
@@ -1449,6 +1568,9 @@ func Provider() *schema.Provider {
 			"aws_redshift_snapshot_schedule_association": redshift.ResourceSnapshotScheduleAssociation(),
 			"aws_redshift_subnet_group":                  redshift.ResourceSubnetGroup(),
 
+			"aws_rekognition_collection":       rekognition.ResourceCollection(),
+			"aws_rekognition_stream_processor": rekognition.ResourceStreamProcessor(),
+
 			"aws_resourcegroups_group": resourcegroups.ResourceGroup(),
 
 			"aws_route53_delegation_set":                route53.ResourceDelegationSet(),
@@ -1603,14 +1725,19 @@ func Provider() *schema.Provider {
 
 			"aws_simpledb_domain": simpledb.ResourceDomain(),
 
+			"aws_snowball_address": snowball.ResourceAddress(),
+			"aws_snowball_job":     snowball.ResourceJob(),
+
 			"aws_sns_platform_application": sns.ResourcePlatformApplication(),
 			"aws_sns_sms_preferences":      sns.ResourceSMSPreferences(),
 			"aws_sns_topic":                sns.ResourceTopic(),
 			"aws_sns_topic_policy":         sns.ResourceTopicPolicy(),
 			"aws_sns_topic_subscription":   sns.ResourceTopicSubscription(),
 
-			"aws_sqs_queue":        sqs.ResourceQueue(),
-			"aws_sqs_queue_policy": sqs.ResourceQueuePolicy(),
+			"aws_sqs_queue":                      sqs.ResourceQueue(),
+			"aws_sqs_queue_policy":               sqs.ResourceQueuePolicy(),
+			"aws_sqs_queue_redrive_allow_policy": sqs.ResourceQueueRedriveAllowPolicy(),
+			"aws_sqs_queue_redrive_policy":       sqs.ResourceQueueRedrivePolicy(),
 
 			"aws_ssm_activation":                ssm.ResourceActivation(),
 			"aws_ssm_association":               ssm.ResourceAssociation(),
@@ -1737,6 +1864,13 @@ func init() {
 			"being executed. If the API request still fails, an error is\n" +
 			"thrown.",
 
+		"max_retry_delay_seconds": "The maximum number of seconds to wait between retries of an AWS API request.\n" +
+			"Caps the exponential backoff applied to throttled and failed requests.",
+
+		"retry_mode": "Specifies how retries are attempted. Valid values are `standard`, `legacy` and `adaptive`.\n" +
+			"In `adaptive` mode requests to a service are delayed after it throttles a request.\n" +
+			"Can also be configured using the `AWS_RETRY_MODE` environment variable.",
+
 		"http_proxy": "The address of an HTTP proxy to use when accessing the AWS API. " +
 			"Can also be configured using the `HTTP_PROXY` or `HTTPS_PROXY` environment variables.",
 
@@ -1778,6 +1912,8 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		DefaultTagsConfig:       expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
 		Endpoints:               make(map[string]string),
 		MaxRetries:              d.Get("max_retries").(int),
+		MaxRetryDelay:           time.Duration(d.Get("max_retry_delay_seconds").(int)) * time.Second,
+		RetryMode:               d.Get("retry_mode").(string),
 		IgnoreTagsConfig:        expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
 		Insecure:                d.Get("insecure").(bool),
 		HTTPProxy:               d.Get("http_proxy").(string),
@@ -1854,6 +1990,28 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID)
 	}
 
+	if l, ok := d.Get("assume_role_with_web_identity").([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		m := l[0].(map[string]interface{})
+
+		if v, ok := m["duration_seconds"].(int); ok && v != 0 {
+			config.AssumeRoleWithWebIdentityDurationSeconds = v
+		}
+
+		if v, ok := m["role_arn"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityARN = v
+		}
+
+		if v, ok := m["session_name"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentitySessionName = v
+		}
+
+		if v, ok := m["web_identity_token_file"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityTokenFile = v
+		}
+
+		log.Printf("[INFO] assume_role_with_web_identity configuration set: (ARN: %q, SessionID: %q, TokenFile: %q)", config.AssumeRoleWithWebIdentityARN, config.AssumeRoleWithWebIdentitySessionName, config.AssumeRoleWithWebIdentityTokenFile)
+	}
+
 	endpointsSet := d.Get("endpoints").(*schema.Set)
 
 	for _, endpointsSetI := range endpointsSet.List() {
@@ -1872,6 +2030,16 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		}
 	}
 
+	if v, ok := d.GetOk("service_retry"); ok {
+		serviceRetries, err := expandProviderServiceRetries(v.(*schema.Set).List())
+
+		if err != nil {
+			return nil, err
+		}
+
+		config.ServiceRetries = serviceRetries
+	}
+
 	if v, ok := d.GetOk("allowed_account_ids"); ok {
 		for _, accountIDRaw := range v.(*schema.Set).List() {
 			config.AllowedAccountIds = append(config.AllowedAccountIds, accountIDRaw.(string))
@@ -1947,6 +2115,42 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func assumeRoleWithWebIdentitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"assume_role"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"duration_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "Seconds to restrict the assume role session duration.",
+					ValidateFunc: validation.IntBetween(900, 43200),
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Amazon Resource Name of an IAM Role to assume with the web identity token.",
+					ValidateFunc: verify.ValidARN,
+				},
+				"session_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Identifier for the assumed role session.",
+				},
+				"web_identity_token_file": {
+					Type:        schema.TypeString,
+					Required:    true,
+					DefaultFunc: schema.EnvDefaultFunc("AWS_WEB_IDENTITY_TOKEN_FILE", nil),
+					Description: "File containing an OAuth 2.0 access token or OpenID Connect ID token issued by the identity provider.",
+				},
+			},
+		},
+	}
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -1968,6 +2172,42 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func serviceRetrySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "Configuration block with retry settings that override the provider-level settings for a service.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_retries": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  descriptions["max_retries"],
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"max_retry_delay_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  descriptions["max_retry_delay_seconds"],
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"retry_mode": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  descriptions["retry_mode"],
+					ValidateFunc: validation.StringInSlice(conns.RetryMode_Values(), false),
+				},
+				"service": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The service to which the retry settings apply, using the same names as the `endpoints` configuration block.",
+					ValidateFunc: validation.StringInSlice(conns.HCLKeys(), false),
+				},
+			},
+		},
+	}
+}
+
 func expandProviderDefaultTags(l []interface{}) *tftags.DefaultConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -1982,6 +2222,37 @@ func expandProviderDefaultTags(l []interface{}) *tftags.DefaultConfig {
 	return defaultConfig
 }
 
+func expandProviderServiceRetries(l []interface{}) (map[string]conns.RetryConfig, error) {
+	serviceRetries := make(map[string]conns.RetryConfig)
+
+	for _, tfMapRaw := range l {
+		m, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		hclKey := m["service"].(string)
+		serviceKey, err := conns.ServiceForHCLKey(hclKey)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure service retry (%s): %w", hclKey, err)
+		}
+
+		if _, ok := serviceRetries[serviceKey]; ok {
+			return nil, fmt.Errorf("duplicate service retry configuration for %s", hclKey)
+		}
+
+		serviceRetries[serviceKey] = conns.RetryConfig{
+			MaxRetries:    m["max_retries"].(int),
+			MaxRetryDelay: time.Duration(m["max_retry_delay_seconds"].(int)) * time.Second,
+			RetryMode:     m["retry_mode"].(string),
+		}
+	}
+
+	return serviceRetries, nil
+}
+
 func expandProviderIgnoreTags(l []interface{}) *tftags.IgnoreConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -1998,5 +2269,11 @@ func expandProviderIgnoreTags(l []interface{}) *tftags.IgnoreConfig {
 		ignoreConfig.KeyPrefixes = tftags.New(v.List())
 	}
 
+	if v, ok := m["key_regexes"].(*schema.Set); ok {
+		for _, keyRegexRaw := range v.List() {
+			ignoreConfig.KeyRegexes = append(ignoreConfig.KeyRegexes, regexp.MustCompile(keyRegexRaw.(string)))
+		}
+	}
+
 	return ignoreConfig
 }
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestProvider_retryMode(t *testing.T) {
+	const envVar = "AWS_RETRY_MODE"
+
+	testCases := []struct {
+		Name          string
+		Config        map[string]interface{}
+		EnvValue      string
+		ExpectedError string
+	}{
+		{
+			Name:   "default",
+			Config: map[string]interface{}{},
+		},
+		{
+			Name: "adaptive",
+			Config: map[string]interface{}{
+				"retry_mode": conns.RetryModeAdaptive,
+			},
+		},
+		{
+			Name: "legacy",
+			Config: map[string]interface{}{
+				"retry_mode": conns.RetryModeLegacy,
+			},
+		},
+		{
+			Name: "standard",
+			Config: map[string]interface{}{
+				"retry_mode": conns.RetryModeStandard,
+			},
+		},
+		{
+			Name: "invalid",
+			Config: map[string]interface{}{
+				"retry_mode": "fast",
+			},
+			ExpectedError: `expected retry_mode to be one of`,
+		},
+		{
+			Name:     "environment variable legacy",
+			Config:   map[string]interface{}{},
+			EnvValue: conns.RetryModeLegacy,
+		},
+		{
+			Name:          "environment variable invalid",
+			Config:        map[string]interface{}{},
+			EnvValue:      "fast",
+			ExpectedError: `expected retry_mode to be one of`,
+		},
+		{
+			Name: "environment variable overridden by configuration",
+			Config: map[string]interface{}{
+				"retry_mode": conns.RetryModeStandard,
+			},
+			EnvValue: "fast",
+		},
+		{
+			Name: "service_retry legacy",
+			Config: map[string]interface{}{
+				"service_retry": []interface{}{
+					map[string]interface{}{
+						"retry_mode": conns.RetryModeLegacy,
+						"service":    "ec2",
+					},
+				},
+			},
+		},
+		{
+			Name: "service_retry invalid",
+			Config: map[string]interface{}{
+				"service_retry": []interface{}{
+					map[string]interface{}{
+						"retry_mode": "fast",
+						"service":    "ec2",
+					},
+				},
+			},
+			ExpectedError: `expected service_retry.0.retry_mode to be one of`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if testCase.EnvValue != "" {
+				os.Setenv(envVar, testCase.EnvValue)
+				defer os.Unsetenv(envVar)
+			} else {
+				os.Unsetenv(envVar)
+			}
+
+			config := map[string]interface{}{
+				"region": "us-west-2", // lintignore:AWSAT003
+			}
+
+			for k, v := range testCase.Config {
+				config[k] = v
+			}
+
+			diags := Provider().Validate(terraform.NewResourceConfigRaw(config))
+
+			if testCase.ExpectedError == "" {
+				if diags.HasError() {
+					t.Fatalf("unexpected error: %v", diags)
+				}
+
+				return
+			}
+
+			if !diags.HasError() {
+				t.Fatalf("expected error containing %q, got none", testCase.ExpectedError)
+			}
+
+			var found bool
+			for _, d := range diags {
+				if strings.Contains(d.Summary, testCase.ExpectedError) || strings.Contains(d.Detail, testCase.ExpectedError) {
+					found = true
+					break
+				}
+			}
+
+			if !found {
+				t.Fatalf("expected error containing %q, got %v", testCase.ExpectedError, diags)
+			}
+		})
+	}
+}
+
+func TestProvider_retryModeDefault(t *testing.T) {
+	const envVar = "AWS_RETRY_MODE"
+
+	t.Run("unset", func(t *testing.T) {
+		os.Unsetenv(envVar)
+
+		got, err := Provider().Schema["retry_mode"].DefaultValue()
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if want := conns.RetryModeStandard; got != want {
+			t.Fatalf("expected %s, got %v", want, got)
+		}
+	})
+
+	t.Run("environment variable", func(t *testing.T) {
+		os.Setenv(envVar, conns.RetryModeLegacy)
+		defer os.Unsetenv(envVar)
+
+		got, err := Provider().Schema["retry_mode"].DefaultValue()
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if want := conns.RetryModeLegacy; got != want {
+			t.Fatalf("expected %s, got %v", want, got)
+		}
+	})
+}
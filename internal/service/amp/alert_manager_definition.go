@@ -26,8 +26,9 @@ func ResourceAlertManagerDefinition() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"definition": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validAlertManagerDefinition,
 			},
 			"workspace_id": {
 				Type:     schema.TypeString,
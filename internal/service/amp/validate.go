@@ -0,0 +1,48 @@
+package amp
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+)
+
+// alertManagerDefinition is the top-level structure of an alert manager definition.
+// See https://docs.aws.amazon.com/prometheus/latest/userguide/AMP-alertmanager-config.html.
+type alertManagerDefinition struct {
+	AlertmanagerConfig string            `yaml:"alertmanager_config"`
+	TemplateFiles      map[string]string `yaml:"template_files"`
+}
+
+// validAlertManagerDefinition checks at plan time that the definition is well-formed YAML
+// with a non-empty alertmanager_config containing a route, instead of waiting for the
+// asynchronous CREATION_FAILED status after apply.
+func validAlertManagerDefinition(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	var definition alertManagerDefinition
+	if err := yaml.UnmarshalStrict([]byte(value), &definition); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid alert manager definition: %w", k, err))
+		return
+	}
+
+	if definition.AlertmanagerConfig == "" {
+		errors = append(errors, fmt.Errorf("%q must contain a non-empty alertmanager_config", k))
+		return
+	}
+
+	var config map[string]interface{}
+	if err := yaml.Unmarshal([]byte(definition.AlertmanagerConfig), &config); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid alertmanager_config: %w", k, err))
+		return
+	}
+
+	if _, ok := config["route"]; !ok {
+		errors = append(errors, fmt.Errorf("%q alertmanager_config must contain a route", k))
+	}
+
+	return
+}
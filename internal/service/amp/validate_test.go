@@ -0,0 +1,65 @@
+package amp
+
+import (
+	"testing"
+)
+
+func TestValidAlertManagerDefinition(t *testing.T) {
+	validDefinitions := []string{
+		`
+alertmanager_config: |
+  route:
+    receiver: 'default'
+  receivers:
+    - name: 'default'
+`,
+		`
+template_files:
+  default_template: |
+    {{ define "sns.default.subject" }}[{{ .Status | toUpper }}{{ end }}
+alertmanager_config: |
+  templates:
+    - 'default_template'
+  route:
+    receiver: 'default'
+  receivers:
+    - name: 'default'
+`,
+	}
+	for _, v := range validDefinitions {
+		_, errors := validAlertManagerDefinition(v, "definition")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid alert manager definition: %q", v, errors)
+		}
+	}
+
+	invalidDefinitions := []string{
+		``,
+		`alertmanager_config: ""`,
+		`
+route:
+  receiver: 'default'
+`,
+		`
+alertmanager_config: |
+  receivers:
+    - name: 'default'
+`,
+		`
+alertmanager_config: |
+  route: [
+`,
+		`
+alertmanager_config: |
+  route:
+    receiver: 'default'
+unknown_key: true
+`,
+	}
+	for _, v := range invalidDefinitions {
+		_, errors := validAlertManagerDefinition(v, "definition")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid alert manager definition", v)
+		}
+	}
+}
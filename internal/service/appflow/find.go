@@ -0,0 +1,81 @@
+package appflow
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appflow"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindFlowByName(conn *appflow.Appflow, name string) (*appflow.DescribeFlowOutput, error) {
+	input := &appflow.DescribeFlowInput{
+		FlowName: aws.String(name),
+	}
+
+	output, err := conn.DescribeFlow(input)
+
+	if tfawserr.ErrCodeEquals(err, appflow.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.FlowStatus); status == appflow.FlowStatusDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindFlowExecutionByID(conn *appflow.Appflow, flowName, id string) (*appflow.ExecutionRecord, error) {
+	input := &appflow.DescribeFlowExecutionRecordsInput{
+		FlowName: aws.String(flowName),
+	}
+	var output *appflow.ExecutionRecord
+
+	err := conn.DescribeFlowExecutionRecordsPages(input, func(page *appflow.DescribeFlowExecutionRecordsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.FlowExecutions {
+			if v != nil && aws.StringValue(v.ExecutionId) == id {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, appflow.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
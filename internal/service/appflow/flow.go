@@ -0,0 +1,1047 @@
+package appflow
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appflow"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceFlow() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFlowCreate,
+		Read:   resourceFlowRead,
+		Update: resourceFlowUpdate,
+		Delete: resourceFlowDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(flowExecutionTimeout),
+			Update: schema.DefaultTimeout(flowExecutionTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"activate": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"destination_flow_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connector_profile_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+						"connector_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{appflow.ConnectorTypeS3}, false),
+						},
+						"destination_connector_properties": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"s3": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(3, 63),
+												},
+												"bucket_prefix": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(0, 512),
+												},
+												"s3_output_format_config": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Computed: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"aggregation_config": {
+																Type:     schema.TypeList,
+																Optional: true,
+																Computed: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"aggregation_type": {
+																			Type:         schema.TypeString,
+																			Optional:     true,
+																			Computed:     true,
+																			ValidateFunc: validation.StringInSlice(appflow.AggregationType_Values(), false),
+																		},
+																	},
+																},
+															},
+															"file_type": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																Computed:     true,
+																ValidateFunc: validation.StringInSlice(appflow.FileType_Values(), false),
+															},
+															"prefix_config": {
+																Type:     schema.TypeList,
+																Optional: true,
+																Computed: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"prefix_format": {
+																			Type:         schema.TypeString,
+																			Optional:     true,
+																			Computed:     true,
+																			ValidateFunc: validation.StringInSlice(appflow.PrefixFormat_Values(), false),
+																		},
+																		"prefix_type": {
+																			Type:         schema.TypeString,
+																			Optional:     true,
+																			Computed:     true,
+																			ValidateFunc: validation.StringInSlice(appflow.PrefixType_Values(), false),
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"flow_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"last_run_execution_details": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"most_recent_execution_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"most_recent_execution_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"most_recent_execution_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9][\w!@#.-]+$`), "must start with an alphanumeric character and contain only alphanumeric characters and !@#.-_"),
+				),
+			},
+			"source_flow_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connector_profile_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+						"connector_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{appflow.ConnectorTypeS3, appflow.ConnectorTypeSalesforce, appflow.ConnectorTypeSapodata}, false),
+						},
+						"incremental_pull_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"datetime_type_field_name": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(0, 256),
+									},
+								},
+							},
+						},
+						"source_connector_properties": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"s3": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(3, 63),
+												},
+												"bucket_prefix": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(0, 512),
+												},
+											},
+										},
+									},
+									"salesforce": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"enable_dynamic_field_update": {
+													Type:     schema.TypeBool,
+													Optional: true,
+												},
+												"include_deleted_records": {
+													Type:     schema.TypeBool,
+													Optional: true,
+												},
+												"object": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+											},
+										},
+									},
+									"sapo_data": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"object_path": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"task": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connector_operator": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"s3": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(appflow.S3ConnectorOperator_Values(), false),
+									},
+									"salesforce": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(appflow.SalesforceConnectorOperator_Values(), false),
+									},
+									"sapo_data": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(appflow.SAPODataConnectorOperator_Values(), false),
+									},
+								},
+							},
+						},
+						"destination_field": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 256),
+						},
+						"source_fields": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"task_properties": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"task_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(appflow.TaskType_Values(), false),
+						},
+					},
+				},
+			},
+			"trigger_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"trigger_properties": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"scheduled": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"data_pull_mode": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.StringInSlice(appflow.DataPullMode_Values(), false),
+												},
+												"schedule_end_time": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.IsRFC3339Time,
+												},
+												"schedule_expression": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 256),
+												},
+												"schedule_offset": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(0, 36000),
+												},
+												"schedule_start_time": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IsRFC3339Time,
+												},
+												"timezone": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.StringLenBetween(0, 256),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"trigger_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(appflow.TriggerType_Values(), false),
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceFlowCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppFlowConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &appflow.CreateFlowInput{
+		DestinationFlowConfigList: expandDestinationFlowConfigs(d.Get("destination_flow_config").([]interface{})),
+		FlowName:                  aws.String(name),
+		SourceFlowConfig:          expandSourceFlowConfig(d.Get("source_flow_config").([]interface{})),
+		Tasks:                     expandTasks(d.Get("task").(*schema.Set).List()),
+		TriggerConfig:             expandTriggerConfig(d.Get("trigger_config").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_arn"); ok {
+		input.KmsArn = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating AppFlow Flow: %s", input)
+	_, err := conn.CreateFlow(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating AppFlow Flow (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if d.Get("activate").(bool) {
+		if err := startFlow(conn, d.Id(), d.Get("trigger_config.0.trigger_type").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceFlowRead(d, meta)
+}
+
+func resourceFlowRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppFlowConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindFlowByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppFlow Flow (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading AppFlow Flow (%s): %w", d.Id(), err)
+	}
+
+	status := aws.StringValue(output.FlowStatus)
+	triggerType := ""
+	if output.TriggerConfig != nil {
+		triggerType = aws.StringValue(output.TriggerConfig.TriggerType)
+	}
+
+	// An on-demand flow is never activated or suspended; activate only asks for a run.
+	if triggerType != appflow.TriggerTypeOnDemand {
+		d.Set("activate", status == appflow.FlowStatusActive)
+	}
+	d.Set("arn", output.FlowArn)
+	d.Set("description", output.Description)
+	if err := d.Set("destination_flow_config", flattenDestinationFlowConfigs(output.DestinationFlowConfigList)); err != nil {
+		return fmt.Errorf("error setting destination_flow_config: %w", err)
+	}
+	d.Set("flow_status", status)
+	d.Set("kms_arn", output.KmsArn)
+	if err := d.Set("last_run_execution_details", flattenExecutionDetails(output.LastRunExecutionDetails)); err != nil {
+		return fmt.Errorf("error setting last_run_execution_details: %w", err)
+	}
+	d.Set("name", output.FlowName)
+	if err := d.Set("source_flow_config", flattenSourceFlowConfig(output.SourceFlowConfig)); err != nil {
+		return fmt.Errorf("error setting source_flow_config: %w", err)
+	}
+	if err := d.Set("task", flattenTasks(output.Tasks)); err != nil {
+		return fmt.Errorf("error setting task: %w", err)
+	}
+	if err := d.Set("trigger_config", flattenTriggerConfig(output.TriggerConfig)); err != nil {
+		return fmt.Errorf("error setting trigger_config: %w", err)
+	}
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceFlowUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppFlowConn
+
+	if d.HasChangesExcept("activate", "tags", "tags_all") {
+		input := &appflow.UpdateFlowInput{
+			DestinationFlowConfigList: expandDestinationFlowConfigs(d.Get("destination_flow_config").([]interface{})),
+			FlowName:                  aws.String(d.Id()),
+			SourceFlowConfig:          expandSourceFlowConfig(d.Get("source_flow_config").([]interface{})),
+			Tasks:                     expandTasks(d.Get("task").(*schema.Set).List()),
+			TriggerConfig:             expandTriggerConfig(d.Get("trigger_config").([]interface{})),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating AppFlow Flow: %s", input)
+		_, err := conn.UpdateFlow(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating AppFlow Flow (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("activate") {
+		triggerType := d.Get("trigger_config.0.trigger_type").(string)
+
+		if d.Get("activate").(bool) {
+			if err := startFlow(conn, d.Id(), triggerType, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		} else if triggerType != appflow.TriggerTypeOnDemand {
+			log.Printf("[DEBUG] Stopping AppFlow Flow: %s", d.Id())
+			_, err := conn.StopFlow(&appflow.StopFlowInput{
+				FlowName: aws.String(d.Id()),
+			})
+
+			if err != nil {
+				return fmt.Errorf("error stopping AppFlow Flow (%s): %w", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating AppFlow Flow (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceFlowRead(d, meta)
+}
+
+func resourceFlowDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppFlowConn
+
+	log.Printf("[DEBUG] Deleting AppFlow Flow: %s", d.Id())
+	_, err := conn.DeleteFlow(&appflow.DeleteFlowInput{
+		FlowName:    aws.String(d.Id()),
+		ForceDelete: aws.Bool(true),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appflow.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting AppFlow Flow (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// startFlow activates a scheduled or event-triggered flow.
+// An on-demand flow is run once instead, and startFlow waits for the run to succeed.
+func startFlow(conn *appflow.Appflow, name, triggerType string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Starting AppFlow Flow: %s", name)
+	output, err := conn.StartFlow(&appflow.StartFlowInput{
+		FlowName: aws.String(name),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error starting AppFlow Flow (%s): %w", name, err)
+	}
+
+	if triggerType != appflow.TriggerTypeOnDemand {
+		return nil
+	}
+
+	executionID := aws.StringValue(output.ExecutionId)
+
+	if _, err := waitFlowExecutionSucceeded(conn, name, executionID, timeout); err != nil {
+		return fmt.Errorf("error waiting for AppFlow Flow (%s) run (%s): %w", name, executionID, err)
+	}
+
+	return nil
+}
+
+func expandSourceFlowConfig(tfList []interface{}) *appflow.SourceFlowConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &appflow.SourceFlowConfig{
+		ConnectorType: aws.String(tfMap["connector_type"].(string)),
+	}
+
+	if v, ok := tfMap["connector_profile_name"].(string); ok && v != "" {
+		apiObject.ConnectorProfileName = aws.String(v)
+	}
+
+	if v, ok := tfMap["incremental_pull_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.IncrementalPullConfig = &appflow.IncrementalPullConfig{}
+
+		if v, ok := tfMap["datetime_type_field_name"].(string); ok && v != "" {
+			apiObject.IncrementalPullConfig.DatetimeTypeFieldName = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["source_connector_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SourceConnectorProperties = expandSourceConnectorProperties(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandSourceConnectorProperties(tfMap map[string]interface{}) *appflow.SourceConnectorProperties {
+	apiObject := &appflow.SourceConnectorProperties{}
+
+	if v, ok := tfMap["s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.S3 = &appflow.S3SourceProperties{
+			BucketName: aws.String(tfMap["bucket_name"].(string)),
+		}
+
+		if v, ok := tfMap["bucket_prefix"].(string); ok && v != "" {
+			apiObject.S3.BucketPrefix = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["salesforce"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Salesforce = &appflow.SalesforceSourceProperties{
+			EnableDynamicFieldUpdate: aws.Bool(tfMap["enable_dynamic_field_update"].(bool)),
+			IncludeDeletedRecords:    aws.Bool(tfMap["include_deleted_records"].(bool)),
+			Object:                   aws.String(tfMap["object"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["sapo_data"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.SAPOData = &appflow.SAPODataSourceProperties{
+			ObjectPath: aws.String(tfMap["object_path"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandDestinationFlowConfigs(tfList []interface{}) []*appflow.DestinationFlowConfig {
+	var apiObjects []*appflow.DestinationFlowConfig
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &appflow.DestinationFlowConfig{
+			ConnectorType:                  aws.String(tfMap["connector_type"].(string)),
+			DestinationConnectorProperties: &appflow.DestinationConnectorProperties{},
+		}
+
+		if v, ok := tfMap["connector_profile_name"].(string); ok && v != "" {
+			apiObject.ConnectorProfileName = aws.String(v)
+		}
+
+		if v, ok := tfMap["destination_connector_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			if v, ok := tfMap["s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				apiObject.DestinationConnectorProperties.S3 = expandS3DestinationProperties(v[0].(map[string]interface{}))
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandS3DestinationProperties(tfMap map[string]interface{}) *appflow.S3DestinationProperties {
+	apiObject := &appflow.S3DestinationProperties{
+		BucketName: aws.String(tfMap["bucket_name"].(string)),
+	}
+
+	if v, ok := tfMap["bucket_prefix"].(string); ok && v != "" {
+		apiObject.BucketPrefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3_output_format_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.S3OutputFormatConfig = &appflow.S3OutputFormatConfig{}
+
+		if v, ok := tfMap["aggregation_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.S3OutputFormatConfig.AggregationConfig = &appflow.AggregationConfig{}
+
+			if v, ok := tfMap["aggregation_type"].(string); ok && v != "" {
+				apiObject.S3OutputFormatConfig.AggregationConfig.AggregationType = aws.String(v)
+			}
+		}
+
+		if v, ok := tfMap["file_type"].(string); ok && v != "" {
+			apiObject.S3OutputFormatConfig.FileType = aws.String(v)
+		}
+
+		if v, ok := tfMap["prefix_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.S3OutputFormatConfig.PrefixConfig = &appflow.PrefixConfig{}
+
+			if v, ok := tfMap["prefix_format"].(string); ok && v != "" {
+				apiObject.S3OutputFormatConfig.PrefixConfig.PrefixFormat = aws.String(v)
+			}
+
+			if v, ok := tfMap["prefix_type"].(string); ok && v != "" {
+				apiObject.S3OutputFormatConfig.PrefixConfig.PrefixType = aws.String(v)
+			}
+		}
+	}
+
+	return apiObject
+}
+
+func expandTasks(tfList []interface{}) []*appflow.Task {
+	var apiObjects []*appflow.Task
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &appflow.Task{
+			SourceFields: flex.ExpandStringList(tfMap["source_fields"].([]interface{})),
+			TaskType:     aws.String(tfMap["task_type"].(string)),
+		}
+
+		if v, ok := tfMap["connector_operator"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.ConnectorOperator = &appflow.ConnectorOperator{}
+
+			if v, ok := tfMap["s3"].(string); ok && v != "" {
+				apiObject.ConnectorOperator.S3 = aws.String(v)
+			}
+
+			if v, ok := tfMap["salesforce"].(string); ok && v != "" {
+				apiObject.ConnectorOperator.Salesforce = aws.String(v)
+			}
+
+			if v, ok := tfMap["sapo_data"].(string); ok && v != "" {
+				apiObject.ConnectorOperator.SAPOData = aws.String(v)
+			}
+		}
+
+		if v, ok := tfMap["destination_field"].(string); ok && v != "" {
+			apiObject.DestinationField = aws.String(v)
+		}
+
+		if v, ok := tfMap["task_properties"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.TaskProperties = flex.ExpandStringMap(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandTriggerConfig(tfList []interface{}) *appflow.TriggerConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &appflow.TriggerConfig{
+		TriggerType: aws.String(tfMap["trigger_type"].(string)),
+	}
+
+	if v, ok := tfMap["trigger_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.TriggerProperties = &appflow.TriggerProperties{}
+
+		if v, ok := tfMap["scheduled"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.TriggerProperties.Scheduled = expandScheduledTriggerProperties(v[0].(map[string]interface{}))
+		}
+	}
+
+	return apiObject
+}
+
+func expandScheduledTriggerProperties(tfMap map[string]interface{}) *appflow.ScheduledTriggerProperties {
+	apiObject := &appflow.ScheduledTriggerProperties{
+		ScheduleExpression: aws.String(tfMap["schedule_expression"].(string)),
+	}
+
+	if v, ok := tfMap["data_pull_mode"].(string); ok && v != "" {
+		apiObject.DataPullMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["schedule_end_time"].(string); ok && v != "" {
+		t, _ := time.Parse(time.RFC3339, v)
+		apiObject.ScheduleEndTime = aws.Time(t)
+	}
+
+	if v, ok := tfMap["schedule_offset"].(int); ok && v != 0 {
+		apiObject.ScheduleOffset = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["schedule_start_time"].(string); ok && v != "" {
+		t, _ := time.Parse(time.RFC3339, v)
+		apiObject.ScheduleStartTime = aws.Time(t)
+	}
+
+	if v, ok := tfMap["timezone"].(string); ok && v != "" {
+		apiObject.Timezone = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSourceFlowConfig(apiObject *appflow.SourceFlowConfig) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"connector_profile_name": aws.StringValue(apiObject.ConnectorProfileName),
+		"connector_type":         aws.StringValue(apiObject.ConnectorType),
+	}
+
+	if v := apiObject.IncrementalPullConfig; v != nil {
+		tfMap["incremental_pull_config"] = []interface{}{map[string]interface{}{
+			"datetime_type_field_name": aws.StringValue(v.DatetimeTypeFieldName),
+		}}
+	}
+
+	if v := apiObject.SourceConnectorProperties; v != nil {
+		tfMap["source_connector_properties"] = []interface{}{flattenSourceConnectorProperties(v)}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSourceConnectorProperties(apiObject *appflow.SourceConnectorProperties) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.S3; v != nil {
+		tfMap["s3"] = []interface{}{map[string]interface{}{
+			"bucket_name":   aws.StringValue(v.BucketName),
+			"bucket_prefix": aws.StringValue(v.BucketPrefix),
+		}}
+	}
+
+	if v := apiObject.Salesforce; v != nil {
+		tfMap["salesforce"] = []interface{}{map[string]interface{}{
+			"enable_dynamic_field_update": aws.BoolValue(v.EnableDynamicFieldUpdate),
+			"include_deleted_records":     aws.BoolValue(v.IncludeDeletedRecords),
+			"object":                      aws.StringValue(v.Object),
+		}}
+	}
+
+	if v := apiObject.SAPOData; v != nil {
+		tfMap["sapo_data"] = []interface{}{map[string]interface{}{
+			"object_path": aws.StringValue(v.ObjectPath),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenDestinationFlowConfigs(apiObjects []*appflow.DestinationFlowConfig) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"connector_profile_name": aws.StringValue(apiObject.ConnectorProfileName),
+			"connector_type":         aws.StringValue(apiObject.ConnectorType),
+		}
+
+		if v := apiObject.DestinationConnectorProperties; v != nil {
+			properties := map[string]interface{}{}
+
+			if v := v.S3; v != nil {
+				properties["s3"] = []interface{}{flattenS3DestinationProperties(v)}
+			}
+
+			tfMap["destination_connector_properties"] = []interface{}{properties}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenS3DestinationProperties(apiObject *appflow.S3DestinationProperties) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"bucket_name":   aws.StringValue(apiObject.BucketName),
+		"bucket_prefix": aws.StringValue(apiObject.BucketPrefix),
+	}
+
+	if v := apiObject.S3OutputFormatConfig; v != nil {
+		config := map[string]interface{}{
+			"file_type": aws.StringValue(v.FileType),
+		}
+
+		if v := v.AggregationConfig; v != nil {
+			config["aggregation_config"] = []interface{}{map[string]interface{}{
+				"aggregation_type": aws.StringValue(v.AggregationType),
+			}}
+		}
+
+		if v := v.PrefixConfig; v != nil {
+			config["prefix_config"] = []interface{}{map[string]interface{}{
+				"prefix_format": aws.StringValue(v.PrefixFormat),
+				"prefix_type":   aws.StringValue(v.PrefixType),
+			}}
+		}
+
+		tfMap["s3_output_format_config"] = []interface{}{config}
+	}
+
+	return tfMap
+}
+
+func flattenTasks(apiObjects []*appflow.Task) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"destination_field": aws.StringValue(apiObject.DestinationField),
+			"source_fields":     aws.StringValueSlice(apiObject.SourceFields),
+			"task_properties":   aws.StringValueMap(apiObject.TaskProperties),
+			"task_type":         aws.StringValue(apiObject.TaskType),
+		}
+
+		if v := apiObject.ConnectorOperator; v != nil {
+			tfMap["connector_operator"] = []interface{}{map[string]interface{}{
+				"s3":         aws.StringValue(v.S3),
+				"salesforce": aws.StringValue(v.Salesforce),
+				"sapo_data":  aws.StringValue(v.SAPOData),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenTriggerConfig(apiObject *appflow.TriggerConfig) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"trigger_type": aws.StringValue(apiObject.TriggerType),
+	}
+
+	if v := apiObject.TriggerProperties; v != nil && v.Scheduled != nil {
+		tfMap["trigger_properties"] = []interface{}{map[string]interface{}{
+			"scheduled": []interface{}{flattenScheduledTriggerProperties(v.Scheduled)},
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenScheduledTriggerProperties(apiObject *appflow.ScheduledTriggerProperties) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"data_pull_mode":      aws.StringValue(apiObject.DataPullMode),
+		"schedule_expression": aws.StringValue(apiObject.ScheduleExpression),
+		"schedule_offset":     aws.Int64Value(apiObject.ScheduleOffset),
+		"timezone":            aws.StringValue(apiObject.Timezone),
+	}
+
+	if v := apiObject.ScheduleEndTime; v != nil {
+		tfMap["schedule_end_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.ScheduleStartTime; v != nil {
+		tfMap["schedule_start_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
+
+func flattenExecutionDetails(apiObject *appflow.ExecutionDetails) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"most_recent_execution_message": aws.StringValue(apiObject.MostRecentExecutionMessage),
+		"most_recent_execution_status":  aws.StringValue(apiObject.MostRecentExecutionStatus),
+	}
+
+	if v := apiObject.MostRecentExecutionTime; v != nil {
+		tfMap["most_recent_execution_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return []interface{}{tfMap}
+}
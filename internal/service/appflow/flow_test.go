@@ -0,0 +1,634 @@
+package appflow_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appflow"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappflow "github.com/hashicorp/terraform-provider-aws/internal/service/appflow"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccAppFlowFlow_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appflow_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appflow.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, appflow.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "activate", "false"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "appflow", fmt.Sprintf("flow/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "destination_flow_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "destination_flow_config.0.connector_type", appflow.ConnectorTypeS3),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_flow_config.0.destination_connector_properties.0.s3.0.bucket_name", "aws_s3_bucket.destination", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "source_flow_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source_flow_config.0.connector_type", appflow.ConnectorTypeS3),
+					resource.TestCheckResourceAttrPair(resourceName, "source_flow_config.0.source_connector_properties.0.s3.0.bucket_name", "aws_s3_bucket.source", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "source_flow_config.0.source_connector_properties.0.s3.0.bucket_prefix", "source"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "task.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger_config.0.trigger_type", appflow.TriggerTypeOnDemand),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAppFlowFlow_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appflow_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appflow.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, appflow.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfappflow.ResourceFlow(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAppFlowFlow_activateOnDemand(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appflow_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appflow.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, appflow.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "activate", "true"),
+					resource.TestCheckResourceAttr(resourceName, "last_run_execution_details.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "last_run_execution_details.0.most_recent_execution_status", appflow.ExecutionStatusSuccessful),
+					resource.TestCheckResourceAttrSet(resourceName, "last_run_execution_details.0.most_recent_execution_time"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activate"},
+			},
+		},
+	})
+}
+
+func TestAccAppFlowFlow_scheduled(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appflow_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appflow.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, appflow.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowScheduledConfig(rName, true, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "activate", "true"),
+					resource.TestCheckResourceAttr(resourceName, "flow_status", appflow.FlowStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "trigger_config.0.trigger_type", appflow.TriggerTypeScheduled),
+					resource.TestCheckResourceAttr(resourceName, "trigger_config.0.trigger_properties.0.scheduled.0.data_pull_mode", appflow.DataPullModeComplete),
+					resource.TestCheckResourceAttr(resourceName, "trigger_config.0.trigger_properties.0.scheduled.0.schedule_expression", "rate(1hours)"),
+					resource.TestCheckResourceAttr(resourceName, "trigger_config.0.trigger_properties.0.scheduled.0.schedule_offset", "60"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFlowScheduledConfig(rName, false, 120),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "activate", "false"),
+					resource.TestCheckResourceAttr(resourceName, "flow_status", appflow.FlowStatusSuspended),
+					resource.TestCheckResourceAttr(resourceName, "trigger_config.0.trigger_properties.0.scheduled.0.schedule_offset", "120"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppFlowFlow_sapoDataIncrementalPull(t *testing.T) {
+	connectorProfileKey := "AWS_APPFLOW_SAPODATA_CONNECTOR_PROFILE"
+	connectorProfile := os.Getenv(connectorProfileKey)
+	if connectorProfile == "" {
+		t.Skipf("Environment variable %s is not set", connectorProfileKey)
+	}
+
+	objectPathKey := "AWS_APPFLOW_SAPODATA_OBJECT_PATH"
+	objectPath := os.Getenv(objectPathKey)
+	if objectPath == "" {
+		t.Skipf("Environment variable %s is not set", objectPathKey)
+	}
+
+	datetimeFieldKey := "AWS_APPFLOW_SAPODATA_DATETIME_FIELD"
+	datetimeField := os.Getenv(datetimeFieldKey)
+	if datetimeField == "" {
+		t.Skipf("Environment variable %s is not set", datetimeFieldKey)
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appflow_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appflow.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, appflow.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowSAPODataIncrementalPullConfig(rName, connectorProfile, objectPath, datetimeField),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "source_flow_config.0.connector_type", appflow.ConnectorTypeSapodata),
+					resource.TestCheckResourceAttr(resourceName, "source_flow_config.0.connector_profile_name", connectorProfile),
+					resource.TestCheckResourceAttr(resourceName, "source_flow_config.0.incremental_pull_config.0.datetime_type_field_name", datetimeField),
+					resource.TestCheckResourceAttr(resourceName, "source_flow_config.0.source_connector_properties.0.sapo_data.0.object_path", objectPath),
+					resource.TestCheckResourceAttr(resourceName, "trigger_config.0.trigger_properties.0.scheduled.0.data_pull_mode", appflow.DataPullModeIncremental),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAppFlowFlow_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appflow_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appflow.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, appflow.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFlowTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccFlowTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFlowExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AppFlow Flow ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppFlowConn
+
+		_, err := tfappflow.FindFlowByName(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckFlowDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AppFlowConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appflow_flow" {
+			continue
+		}
+
+		_, err := tfappflow.FindFlowByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("AppFlow Flow %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccFlowConfigDestinationBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "destination" {
+  bucket        = "%[1]s-destination"
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_policy" "destination" {
+  bucket = aws_s3_bucket.destination.id
+
+  policy = <<EOF
+{
+  "Version": "2008-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"Service": "appflow.${data.aws_partition.current.dns_suffix}"},
+      "Action": [
+        "s3:PutObject",
+        "s3:AbortMultipartUpload",
+        "s3:ListMultipartUploadParts",
+        "s3:ListBucketMultipartUploads",
+        "s3:GetBucketAcl",
+        "s3:PutObjectAcl"
+      ],
+      "Resource": [
+        "${aws_s3_bucket.destination.arn}",
+        "${aws_s3_bucket.destination.arn}/*"
+      ]
+    }
+  ]
+}
+EOF
+}
+`, rName)
+}
+
+func testAccFlowConfigBase(rName string) string {
+	return acctest.ConfigCompose(testAccFlowConfigDestinationBase(rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "source" {
+  bucket        = "%[1]s-source"
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "source" {
+  bucket  = aws_s3_bucket.source.id
+  key     = "source/data.csv"
+  content = "testField\nvalue1\nvalue2\n"
+}
+
+resource "aws_s3_bucket_policy" "source" {
+  bucket = aws_s3_bucket.source.id
+
+  policy = <<EOF
+{
+  "Version": "2008-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"Service": "appflow.${data.aws_partition.current.dns_suffix}"},
+      "Action": [
+        "s3:ListBucket",
+        "s3:GetObject"
+      ],
+      "Resource": [
+        "${aws_s3_bucket.source.arn}",
+        "${aws_s3_bucket.source.arn}/*"
+      ]
+    }
+  ]
+}
+EOF
+}
+`, rName))
+}
+
+func testAccFlowConfig(rName string, activate bool) string {
+	return acctest.ConfigCompose(testAccFlowConfigBase(rName), fmt.Sprintf(`
+resource "aws_appflow_flow" "test" {
+  name     = %[1]q
+  activate = %[2]t
+
+  source_flow_config {
+    connector_type = "S3"
+
+    source_connector_properties {
+      s3 {
+        bucket_name   = aws_s3_bucket.source.bucket
+        bucket_prefix = "source"
+      }
+    }
+  }
+
+  destination_flow_config {
+    connector_type = "S3"
+
+    destination_connector_properties {
+      s3 {
+        bucket_name = aws_s3_bucket.destination.bucket
+      }
+    }
+  }
+
+  task {
+    source_fields     = ["testField"]
+    destination_field = "testField"
+    task_type         = "Map"
+
+    connector_operator {
+      s3 = "NO_OP"
+    }
+  }
+
+  trigger_config {
+    trigger_type = "OnDemand"
+  }
+
+  depends_on = [
+    aws_s3_bucket_object.source,
+    aws_s3_bucket_policy.destination,
+    aws_s3_bucket_policy.source,
+  ]
+}
+`, rName, activate))
+}
+
+func testAccFlowScheduledConfig(rName string, activate bool, scheduleOffset int) string {
+	return acctest.ConfigCompose(testAccFlowConfigBase(rName), fmt.Sprintf(`
+resource "aws_appflow_flow" "test" {
+  name     = %[1]q
+  activate = %[2]t
+
+  source_flow_config {
+    connector_type = "S3"
+
+    source_connector_properties {
+      s3 {
+        bucket_name   = aws_s3_bucket.source.bucket
+        bucket_prefix = "source"
+      }
+    }
+  }
+
+  destination_flow_config {
+    connector_type = "S3"
+
+    destination_connector_properties {
+      s3 {
+        bucket_name = aws_s3_bucket.destination.bucket
+      }
+    }
+  }
+
+  task {
+    source_fields     = ["testField"]
+    destination_field = "testField"
+    task_type         = "Map"
+
+    connector_operator {
+      s3 = "NO_OP"
+    }
+  }
+
+  trigger_config {
+    trigger_type = "Scheduled"
+
+    trigger_properties {
+      scheduled {
+        data_pull_mode      = "Complete"
+        schedule_expression = "rate(1hours)"
+        schedule_offset     = %[3]d
+      }
+    }
+  }
+
+  depends_on = [
+    aws_s3_bucket_object.source,
+    aws_s3_bucket_policy.destination,
+    aws_s3_bucket_policy.source,
+  ]
+}
+`, rName, activate, scheduleOffset))
+}
+
+func testAccFlowSAPODataIncrementalPullConfig(rName, connectorProfile, objectPath, datetimeField string) string {
+	return acctest.ConfigCompose(testAccFlowConfigDestinationBase(rName), fmt.Sprintf(`
+resource "aws_appflow_flow" "test" {
+  name = %[1]q
+
+  source_flow_config {
+    connector_type         = "SAPOData"
+    connector_profile_name = %[2]q
+
+    incremental_pull_config {
+      datetime_type_field_name = %[4]q
+    }
+
+    source_connector_properties {
+      sapo_data {
+        object_path = %[3]q
+      }
+    }
+  }
+
+  destination_flow_config {
+    connector_type = "S3"
+
+    destination_connector_properties {
+      s3 {
+        bucket_name = aws_s3_bucket.destination.bucket
+      }
+    }
+  }
+
+  task {
+    source_fields = []
+    task_type     = "Map_all"
+
+    connector_operator {
+      sapo_data = "NO_OP"
+    }
+
+    task_properties = {
+      "EXCLUDE_SOURCE_FIELDS_LIST" = "[]"
+    }
+  }
+
+  trigger_config {
+    trigger_type = "Scheduled"
+
+    trigger_properties {
+      scheduled {
+        data_pull_mode      = "Incremental"
+        schedule_expression = "rate(1hours)"
+      }
+    }
+  }
+
+  depends_on = [aws_s3_bucket_policy.destination]
+}
+`, rName, connectorProfile, objectPath, datetimeField))
+}
+
+func testAccFlowTags1Config(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccFlowConfigBase(rName), fmt.Sprintf(`
+resource "aws_appflow_flow" "test" {
+  name = %[1]q
+
+  source_flow_config {
+    connector_type = "S3"
+
+    source_connector_properties {
+      s3 {
+        bucket_name   = aws_s3_bucket.source.bucket
+        bucket_prefix = "source"
+      }
+    }
+  }
+
+  destination_flow_config {
+    connector_type = "S3"
+
+    destination_connector_properties {
+      s3 {
+        bucket_name = aws_s3_bucket.destination.bucket
+      }
+    }
+  }
+
+  task {
+    source_fields     = ["testField"]
+    destination_field = "testField"
+    task_type         = "Map"
+
+    connector_operator {
+      s3 = "NO_OP"
+    }
+  }
+
+  trigger_config {
+    trigger_type = "OnDemand"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [
+    aws_s3_bucket_object.source,
+    aws_s3_bucket_policy.destination,
+    aws_s3_bucket_policy.source,
+  ]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccFlowTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccFlowConfigBase(rName), fmt.Sprintf(`
+resource "aws_appflow_flow" "test" {
+  name = %[1]q
+
+  source_flow_config {
+    connector_type = "S3"
+
+    source_connector_properties {
+      s3 {
+        bucket_name   = aws_s3_bucket.source.bucket
+        bucket_prefix = "source"
+      }
+    }
+  }
+
+  destination_flow_config {
+    connector_type = "S3"
+
+    destination_connector_properties {
+      s3 {
+        bucket_name = aws_s3_bucket.destination.bucket
+      }
+    }
+  }
+
+  task {
+    source_fields     = ["testField"]
+    destination_field = "testField"
+    task_type         = "Map"
+
+    connector_operator {
+      s3 = "NO_OP"
+    }
+  }
+
+  trigger_config {
+    trigger_type = "OnDemand"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [
+    aws_s3_bucket_object.source,
+    aws_s3_bucket_policy.destination,
+    aws_s3_bucket_policy.source,
+  ]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,24 @@
+package appflow
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appflow"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusFlowExecution(conn *appflow.Appflow, flowName, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindFlowExecutionByID(conn, flowName, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ExecutionStatus), nil
+	}
+}
@@ -0,0 +1,40 @@
+package appflow
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appflow"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	flowExecutionTimeout = 30 * time.Minute
+)
+
+// waitFlowExecutionSucceeded waits for an on-demand run of a flow to finish.
+// A run is not listed in the execution records until shortly after StartFlow returns.
+func waitFlowExecutionSucceeded(conn *appflow.Appflow, flowName, id string, timeout time.Duration) (*appflow.ExecutionRecord, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{appflow.ExecutionStatusInProgress},
+		Target:  []string{appflow.ExecutionStatusSuccessful},
+		Refresh: statusFlowExecution(conn, flowName, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*appflow.ExecutionRecord); ok {
+		if status := aws.StringValue(output.ExecutionStatus); status == appflow.ExecutionStatusError {
+			if v := output.ExecutionResult; v != nil && v.ErrorInfo != nil {
+				tfresource.SetLastError(err, errors.New(aws.StringValue(v.ErrorInfo.ExecutionMessage)))
+			}
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,234 @@
+package appintegrations_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appintegrationsservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappintegrations "github.com/hashicorp/terraform-provider-aws/internal/service/appintegrations"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// testAccEventIntegrationSource returns the partner event source to filter on.
+// Set AWS_APPINTEGRATIONS_SOURCE to use a partner event source that exists in the test account.
+func testAccEventIntegrationSource() string {
+	if v := os.Getenv("AWS_APPINTEGRATIONS_SOURCE"); v != "" {
+		return v
+	}
+
+	return "aws.partner/examplepartner.com"
+}
+
+func TestAccAppIntegrationsEventIntegration_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appintegrations_event_integration.test"
+	source := testAccEventIntegrationSource()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(appintegrationsservice.EndpointsID, t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, appintegrationsservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEventIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventIntegrationConfig(rName, source, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventIntegrationExists(resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "app-integrations", fmt.Sprintf("event-integration/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "event_filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "event_filter.0.source", source),
+					resource.TestCheckResourceAttr(resourceName, "eventbridge_bus", "default"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEventIntegrationConfig(rName, source, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventIntegrationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppIntegrationsEventIntegration_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appintegrations_event_integration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(appintegrationsservice.EndpointsID, t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, appintegrationsservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEventIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventIntegrationConfig(rName, testAccEventIntegrationSource(), "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventIntegrationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfappintegrations.ResourceEventIntegration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAppIntegrationsEventIntegration_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appintegrations_event_integration.test"
+	source := testAccEventIntegrationSource()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(appintegrationsservice.EndpointsID, t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, appintegrationsservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEventIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventIntegrationTags1Config(rName, source, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventIntegrationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEventIntegrationTags2Config(rName, source, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventIntegrationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccEventIntegrationTags1Config(rName, source, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventIntegrationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEventIntegrationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AppIntegrations Event Integration ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppIntegrationsConn
+
+		_, err := tfappintegrations.FindEventIntegrationByName(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckEventIntegrationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AppIntegrationsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appintegrations_event_integration" {
+			continue
+		}
+
+		_, err := tfappintegrations.FindEventIntegrationByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("AppIntegrations Event Integration %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccEventIntegrationConfig(rName, source, description string) string {
+	return fmt.Sprintf(`
+resource "aws_appintegrations_event_integration" "test" {
+  name            = %[1]q
+  description     = %[3]q
+  eventbridge_bus = "default"
+
+  event_filter {
+    source = %[2]q
+  }
+}
+`, rName, source, description)
+}
+
+func testAccEventIntegrationTags1Config(rName, source, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_appintegrations_event_integration" "test" {
+  name            = %[1]q
+  eventbridge_bus = "default"
+
+  event_filter {
+    source = %[2]q
+  }
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, source, tagKey1, tagValue1)
+}
+
+func testAccEventIntegrationTags2Config(rName, source, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_appintegrations_event_integration" "test" {
+  name            = %[1]q
+  eventbridge_bus = "default"
+
+  event_filter {
+    source = %[2]q
+  }
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, source, tagKey1, tagValue1, tagKey2, tagValue2)
+}
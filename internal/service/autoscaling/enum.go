@@ -3,3 +3,31 @@ package autoscaling
 const (
 	TagResourceTypeGroup = `auto-scaling-group`
 )
+
+const (
+	OnDemandAllocationStrategyLowestPrice = "lowest-price"
+	OnDemandAllocationStrategyPrioritized = "prioritized"
+)
+
+func OnDemandAllocationStrategy_Values() []string {
+	return []string{
+		OnDemandAllocationStrategyLowestPrice,
+		OnDemandAllocationStrategyPrioritized,
+	}
+}
+
+const (
+	SpotAllocationStrategyCapacityOptimized            = "capacity-optimized"
+	SpotAllocationStrategyCapacityOptimizedPrioritized = "capacity-optimized-prioritized"
+	SpotAllocationStrategyLowestPrice                  = "lowest-price"
+	SpotAllocationStrategyPriceCapacityOptimized       = "price-capacity-optimized"
+)
+
+func SpotAllocationStrategy_Values() []string {
+	return []string{
+		SpotAllocationStrategyCapacityOptimized,
+		SpotAllocationStrategyCapacityOptimizedPrioritized,
+		SpotAllocationStrategyLowestPrice,
+		SpotAllocationStrategyPriceCapacityOptimized,
+	}
+}
@@ -121,9 +121,10 @@ func ResourceGroup() *schema.Resource {
 									// thus, to prevent non-empty plans, we set these
 									// to Computed and remove Defaults
 									"on_demand_allocation_strategy": {
-										Type:     schema.TypeString,
-										Optional: true,
-										Computed: true,
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(OnDemandAllocationStrategy_Values(), false),
 									},
 									"on_demand_base_capacity": {
 										Type:         schema.TypeInt,
@@ -138,9 +139,10 @@ func ResourceGroup() *schema.Resource {
 										ValidateFunc: validation.IntBetween(0, 100),
 									},
 									"spot_allocation_strategy": {
-										Type:     schema.TypeString,
-										Optional: true,
-										Computed: true,
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(SpotAllocationStrategy_Values(), false),
 									},
 									"spot_instance_pools": {
 										Type:         schema.TypeInt,
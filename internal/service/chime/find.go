@@ -0,0 +1,61 @@
+package chime
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/chime"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindSipMediaApplicationByID(ctx context.Context, conn *chime.Chime, id string) (*chime.SipMediaApplication, error) {
+	input := &chime.GetSipMediaApplicationInput{
+		SipMediaApplicationId: aws.String(id),
+	}
+
+	output, err := conn.GetSipMediaApplicationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, chime.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.SipMediaApplication == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.SipMediaApplication, nil
+}
+
+func FindSipRuleByID(ctx context.Context, conn *chime.Chime, id string) (*chime.SipRule, error) {
+	input := &chime.GetSipRuleInput{
+		SipRuleId: aws.String(id),
+	}
+
+	output, err := conn.GetSipRuleWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, chime.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.SipRule == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.SipRule, nil
+}
@@ -0,0 +1,174 @@
+package chime
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/chime"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceSipMediaApplication() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSipMediaApplicationCreate,
+		ReadContext:   resourceSipMediaApplicationRead,
+		UpdateContext: resourceSipMediaApplicationUpdate,
+		DeleteContext: resourceSipMediaApplicationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"aws_region": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"endpoints": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"lambda_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+		},
+	}
+}
+
+func resourceSipMediaApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ChimeConn
+
+	input := &chime.CreateSipMediaApplicationInput{
+		AwsRegion: aws.String(d.Get("aws_region").(string)),
+		Endpoints: expandSipMediaApplicationEndpoints(d.Get("endpoints").([]interface{})),
+		Name:      aws.String(d.Get("name").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating Chime SIP Media Application: %s", input)
+	output, err := conn.CreateSipMediaApplicationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Chime SIP Media Application: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.SipMediaApplication.SipMediaApplicationId))
+
+	return resourceSipMediaApplicationRead(ctx, d, meta)
+}
+
+func resourceSipMediaApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ChimeConn
+
+	application, err := FindSipMediaApplicationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Chime SIP Media Application (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Chime SIP Media Application (%s): %s", d.Id(), err)
+	}
+
+	d.Set("aws_region", application.AwsRegion)
+	if err := d.Set("endpoints", flattenSipMediaApplicationEndpoints(application.Endpoints)); err != nil {
+		return diag.Errorf("error setting endpoints: %s", err)
+	}
+	d.Set("name", application.Name)
+
+	return nil
+}
+
+func resourceSipMediaApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ChimeConn
+
+	if d.HasChanges("endpoints", "name") {
+		input := &chime.UpdateSipMediaApplicationInput{
+			Endpoints:             expandSipMediaApplicationEndpoints(d.Get("endpoints").([]interface{})),
+			Name:                  aws.String(d.Get("name").(string)),
+			SipMediaApplicationId: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Chime SIP Media Application: %s", input)
+		if _, err := conn.UpdateSipMediaApplicationWithContext(ctx, input); err != nil {
+			return diag.Errorf("error updating Chime SIP Media Application (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceSipMediaApplicationRead(ctx, d, meta)
+}
+
+func resourceSipMediaApplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ChimeConn
+
+	log.Printf("[DEBUG] Deleting Chime SIP Media Application: %s", d.Id())
+	_, err := conn.DeleteSipMediaApplicationWithContext(ctx, &chime.DeleteSipMediaApplicationInput{
+		SipMediaApplicationId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, chime.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Chime SIP Media Application (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSipMediaApplicationEndpoints(tfList []interface{}) []*chime.SipMediaApplicationEndpoint {
+	var apiObjects []*chime.SipMediaApplicationEndpoint
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &chime.SipMediaApplicationEndpoint{
+			LambdaArn: aws.String(tfMap["lambda_arn"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenSipMediaApplicationEndpoints(apiObjects []*chime.SipMediaApplicationEndpoint) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"lambda_arn": aws.StringValue(apiObject.LambdaArn),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,177 @@
+package chime_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/chime"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfchime "github.com/hashicorp/terraform-provider-aws/internal/service/chime"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccChimeSipMediaApplication_basic(t *testing.T) {
+	var v chime.SipMediaApplication
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chime_sip_media_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, chime.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSipMediaApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSipMediaApplicationConfig(rName, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSipMediaApplicationExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "aws_region", "data.aws_region.current", "name"),
+					resource.TestCheckResourceAttr(resourceName, "endpoints.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoints.0.lambda_arn", "aws_lambda_function.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSipMediaApplicationConfig(rName, fmt.Sprintf("%s-updated", rName)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSipMediaApplicationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("%s-updated", rName)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccChimeSipMediaApplication_disappears(t *testing.T) {
+	var v chime.SipMediaApplication
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chime_sip_media_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, chime.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSipMediaApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSipMediaApplicationConfig(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSipMediaApplicationExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfchime.ResourceSipMediaApplication(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSipMediaApplicationExists(n string, v *chime.SipMediaApplication) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Chime SIP Media Application ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ChimeConn
+
+		output, err := tfchime.FindSipMediaApplicationByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckSipMediaApplicationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ChimeConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_chime_sip_media_application" {
+			continue
+		}
+
+		_, err := tfchime.FindSipMediaApplicationByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Chime SIP Media Application %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccSipMediaApplicationBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "lambda.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.test.arn
+  handler       = "exports.example"
+  runtime       = "nodejs12.x"
+}
+
+resource "aws_lambda_permission" "test" {
+  action        = "lambda:InvokeFunction"
+  function_name = aws_lambda_function.test.function_name
+  principal     = "voiceconnector.chime.${data.aws_partition.current.dns_suffix}"
+}
+`, rName)
+}
+
+func testAccSipMediaApplicationConfig(rName, name string) string {
+	return acctest.ConfigCompose(testAccSipMediaApplicationBaseConfig(rName), fmt.Sprintf(`
+resource "aws_chime_sip_media_application" "test" {
+  aws_region = data.aws_region.current.name
+  name       = %[1]q
+
+  endpoints {
+    lambda_arn = aws_lambda_function.test.arn
+  }
+
+  depends_on = [aws_lambda_permission.test]
+}
+`, name))
+}
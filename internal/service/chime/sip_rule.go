@@ -0,0 +1,224 @@
+package chime
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/chime"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceSipRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSipRuleCreate,
+		ReadContext:   resourceSipRuleRead,
+		UpdateContext: resourceSipRuleUpdate,
+		DeleteContext: resourceSipRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"disabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"target_applications": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 25,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aws_region": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"priority": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"sip_media_application_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+			"trigger_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(chime.SipRuleTriggerType_Values(), false),
+			},
+			"trigger_value": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceSipRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ChimeConn
+
+	input := &chime.CreateSipRuleInput{
+		Disabled:           aws.Bool(d.Get("disabled").(bool)),
+		Name:               aws.String(d.Get("name").(string)),
+		TargetApplications: expandSipRuleTargetApplications(d.Get("target_applications").(*schema.Set).List()),
+		TriggerType:        aws.String(d.Get("trigger_type").(string)),
+		TriggerValue:       aws.String(d.Get("trigger_value").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating Chime SIP Rule: %s", input)
+	output, err := conn.CreateSipRuleWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Chime SIP Rule: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.SipRule.SipRuleId))
+
+	return resourceSipRuleRead(ctx, d, meta)
+}
+
+func resourceSipRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ChimeConn
+
+	rule, err := FindSipRuleByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Chime SIP Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Chime SIP Rule (%s): %s", d.Id(), err)
+	}
+
+	d.Set("disabled", rule.Disabled)
+	d.Set("name", rule.Name)
+	if err := d.Set("target_applications", flattenSipRuleTargetApplications(rule.TargetApplications)); err != nil {
+		return diag.Errorf("error setting target_applications: %s", err)
+	}
+	d.Set("trigger_type", rule.TriggerType)
+	d.Set("trigger_value", rule.TriggerValue)
+
+	return nil
+}
+
+func resourceSipRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ChimeConn
+
+	if d.HasChanges("disabled", "name", "target_applications") {
+		input := &chime.UpdateSipRuleInput{
+			Disabled:           aws.Bool(d.Get("disabled").(bool)),
+			Name:               aws.String(d.Get("name").(string)),
+			SipRuleId:          aws.String(d.Id()),
+			TargetApplications: expandSipRuleTargetApplications(d.Get("target_applications").(*schema.Set).List()),
+		}
+
+		log.Printf("[DEBUG] Updating Chime SIP Rule: %s", input)
+		if _, err := conn.UpdateSipRuleWithContext(ctx, input); err != nil {
+			return diag.Errorf("error updating Chime SIP Rule (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceSipRuleRead(ctx, d, meta)
+}
+
+func resourceSipRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ChimeConn
+
+	// A SIP rule must be disabled before it can be deleted.
+	if !d.Get("disabled").(bool) {
+		input := &chime.UpdateSipRuleInput{
+			Disabled:  aws.Bool(true),
+			Name:      aws.String(d.Get("name").(string)),
+			SipRuleId: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Disabling Chime SIP Rule: %s", input)
+		_, err := conn.UpdateSipRuleWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, chime.ErrCodeNotFoundException) {
+			return nil
+		}
+
+		if err != nil {
+			return diag.Errorf("error disabling Chime SIP Rule (%s): %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Chime SIP Rule: %s", d.Id())
+	_, err := conn.DeleteSipRuleWithContext(ctx, &chime.DeleteSipRuleInput{
+		SipRuleId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, chime.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Chime SIP Rule (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSipRuleTargetApplications(tfList []interface{}) []*chime.SipRuleTargetApplication {
+	var apiObjects []*chime.SipRuleTargetApplication
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &chime.SipRuleTargetApplication{
+			AwsRegion:             aws.String(tfMap["aws_region"].(string)),
+			Priority:              aws.Int64(int64(tfMap["priority"].(int))),
+			SipMediaApplicationId: aws.String(tfMap["sip_media_application_id"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenSipRuleTargetApplications(apiObjects []*chime.SipRuleTargetApplication) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"aws_region":               aws.StringValue(apiObject.AwsRegion),
+			"priority":                 int(aws.Int64Value(apiObject.Priority)),
+			"sip_media_application_id": aws.StringValue(apiObject.SipMediaApplicationId),
+		})
+	}
+
+	return tfList
+}
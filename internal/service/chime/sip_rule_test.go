@@ -0,0 +1,152 @@
+package chime_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/chime"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfchime "github.com/hashicorp/terraform-provider-aws/internal/service/chime"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccChimeSipRule_basic(t *testing.T) {
+	var v chime.SipRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chime_sip_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, chime.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSipRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSipRuleConfig(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSipRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "target_applications.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "target_applications.*", map[string]string{
+						"priority": "1",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "target_applications.*.sip_media_application_id", "aws_chime_sip_media_application.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "trigger_type", chime.SipRuleTriggerTypeRequestUriHostname),
+					resource.TestCheckResourceAttrPair(resourceName, "trigger_value", "aws_chime_voice_connector.test", "outbound_host_name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSipRuleConfig(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSipRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccChimeSipRule_disappears(t *testing.T) {
+	var v chime.SipRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chime_sip_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, chime.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSipRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSipRuleConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSipRuleExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfchime.ResourceSipRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSipRuleExists(n string, v *chime.SipRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Chime SIP Rule ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ChimeConn
+
+		output, err := tfchime.FindSipRuleByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckSipRuleDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ChimeConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_chime_sip_rule" {
+			continue
+		}
+
+		_, err := tfchime.FindSipRuleByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Chime SIP Rule %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccSipRuleConfig(rName string, disabled bool) string {
+	return acctest.ConfigCompose(testAccSipMediaApplicationConfig(rName, rName), fmt.Sprintf(`
+resource "aws_chime_voice_connector" "test" {
+  name               = %[1]q
+  require_encryption = true
+}
+
+resource "aws_chime_sip_rule" "test" {
+  disabled      = %[2]t
+  name          = %[1]q
+  trigger_type  = "RequestUriHostname"
+  trigger_value = aws_chime_voice_connector.test.outbound_host_name
+
+  target_applications {
+    aws_region               = data.aws_region.current.name
+    priority                 = 1
+    sip_media_application_id = aws_chime_sip_media_application.test.id
+  }
+}
+`, rName, disabled))
+}
@@ -3,6 +3,8 @@ package cloudhsmv2
 import (
 	"fmt"
 	"log"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -32,6 +34,28 @@ func ResourceCluster() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"backup_retention_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      cloudhsmv2.BackupRetentionTypeDays,
+							ValidateFunc: validation.StringInSlice(cloudhsmv2.BackupRetentionType_Values(), false),
+						},
+						"value": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(7, 379),
+						},
+					},
+				},
+			},
+
 			"source_backup_identifier": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -102,6 +126,12 @@ func ResourceCluster() *schema.Resource {
 				Computed: true,
 			},
 
+			"hsm_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 28),
+			},
+
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
@@ -124,6 +154,10 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 		input.TagList = Tags(tags.IgnoreAWS())
 	}
 
+	if v, ok := d.GetOk("backup_retention_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.BackupRetentionPolicy = expandBackupRetentionPolicy(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("source_backup_identifier"); ok {
 		input.SourceBackupId = aws.String(v.(string))
 	}
@@ -150,6 +184,12 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if v, ok := d.GetOk("hsm_count"); ok {
+		if err := reconcileClusterHSMs(conn, d.Id(), v.(int), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error creating CloudHSMv2 Cluster (%s) HSMs: %w", d.Id(), err)
+		}
+	}
+
 	return resourceClusterRead(d, meta)
 }
 
@@ -192,6 +232,17 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("vpc_id", cluster.VpcId)
 	d.Set("source_backup_identifier", cluster.SourceBackupId)
 	d.Set("hsm_type", cluster.HsmType)
+
+	// HSMs are only counted when hsm_count is configured, so clusters whose HSMs are managed
+	// with aws_cloudhsm_v2_hsm (or outside Terraform) never show a difference.
+	if d.Get("hsm_count").(int) > 0 {
+		d.Set("hsm_count", len(healthyClusterHSMs(cluster)))
+	}
+
+	if err := d.Set("backup_retention_policy", flattenBackupRetentionPolicy(cluster.BackupRetentionPolicy)); err != nil {
+		return fmt.Errorf("error setting backup_retention_policy: %w", err)
+	}
+
 	if err := d.Set("cluster_certificates", readCloudHsmV2ClusterCertificates(cluster)); err != nil {
 		return fmt.Errorf("error setting cluster_certificates: %s", err)
 	}
@@ -221,6 +272,29 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).CloudHSMV2Conn
 
+	if d.HasChange("backup_retention_policy") {
+		if v, ok := d.GetOk("backup_retention_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input := &cloudhsmv2.ModifyClusterInput{
+				BackupRetentionPolicy: expandBackupRetentionPolicy(v.([]interface{})[0].(map[string]interface{})),
+				ClusterId:             aws.String(d.Id()),
+			}
+
+			log.Printf("[DEBUG] Modifying CloudHSMv2 Cluster: %s", input)
+			_, err := conn.ModifyCluster(input)
+
+			if err != nil {
+				return fmt.Errorf("error modifying CloudHSMv2 Cluster (%s): %w", d.Id(), err)
+			}
+		}
+	}
+
+	// Removing hsm_count stops managing the cluster's HSMs and leaves them in place.
+	if v := d.Get("hsm_count").(int); d.HasChange("hsm_count") && v > 0 {
+		if err := reconcileClusterHSMs(conn, d.Id(), v, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error updating CloudHSMv2 Cluster (%s) HSMs: %w", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
@@ -233,6 +307,15 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 
 func resourceClusterDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).CloudHSMV2Conn
+
+	// A cluster cannot be deleted while it still contains HSMs. Only HSMs managed
+	// through hsm_count are removed; any others must be deleted first.
+	if d.Get("hsm_count").(int) > 0 {
+		if err := reconcileClusterHSMs(conn, d.Id(), 0, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return fmt.Errorf("error deleting CloudHSMv2 Cluster (%s) HSMs: %w", d.Id(), err)
+		}
+	}
+
 	input := &cloudhsmv2.DeleteClusterInput{
 		ClusterId: aws.String(d.Id()),
 	}
@@ -267,3 +350,182 @@ func readCloudHsmV2ClusterCertificates(cluster *cloudhsmv2.Cluster) []map[string
 	}
 	return []map[string]interface{}{}
 }
+
+// healthyClusterHSMs returns the cluster's HSMs that are active or being created.
+// HSMs that have failed (DEGRADED) or are being deleted are not counted.
+func healthyClusterHSMs(cluster *cloudhsmv2.Cluster) []*cloudhsmv2.Hsm {
+	var hsms []*cloudhsmv2.Hsm
+
+	for _, hsm := range cluster.Hsms {
+		if hsm == nil {
+			continue
+		}
+
+		switch aws.StringValue(hsm.State) {
+		case cloudhsmv2.HsmStateDegraded, cloudhsmv2.HsmStateDeleteInProgress, cloudhsmv2.HsmStateDeleted:
+			continue
+		}
+
+		hsms = append(hsms, hsm)
+	}
+
+	return hsms
+}
+
+// reconcileClusterHSMs replaces failed HSMs and adds or removes HSMs until the
+// cluster has the requested number of healthy HSMs. New HSMs are spread across
+// the cluster's Availability Zones.
+func reconcileClusterHSMs(conn *cloudhsmv2.CloudHSMV2, id string, count int, timeout time.Duration) error {
+	cluster, err := FindCluster(conn, id)
+
+	if err != nil {
+		return err
+	}
+
+	if cluster == nil {
+		return fmt.Errorf("CloudHSMv2 Cluster (%s) not found", id)
+	}
+
+	for _, hsm := range cluster.Hsms {
+		if aws.StringValue(hsm.State) != cloudhsmv2.HsmStateDegraded {
+			continue
+		}
+
+		if err := deleteClusterHSM(conn, id, aws.StringValue(hsm.HsmId), timeout); err != nil {
+			return err
+		}
+	}
+
+	hsms := healthyClusterHSMs(cluster)
+
+	hsmsPerAZ := make(map[string]int)
+	for az := range cluster.SubnetMapping {
+		hsmsPerAZ[az] = 0
+	}
+	for _, hsm := range hsms {
+		hsmsPerAZ[aws.StringValue(hsm.AvailabilityZone)]++
+	}
+
+	for n := len(hsms); n < count; n++ {
+		az := clusterAvailabilityZoneByHSMCount(hsmsPerAZ, false)
+
+		input := &cloudhsmv2.CreateHsmInput{
+			AvailabilityZone: aws.String(az),
+			ClusterId:        aws.String(id),
+		}
+
+		log.Printf("[DEBUG] Creating CloudHSMv2 HSM: %s", input)
+		output, err := conn.CreateHsm(input)
+
+		if err != nil {
+			return fmt.Errorf("error creating CloudHSMv2 HSM in %s: %w", az, err)
+		}
+
+		hsmID := aws.StringValue(output.Hsm.HsmId)
+
+		if _, err := waitHSMActive(conn, hsmID, timeout); err != nil {
+			return fmt.Errorf("error waiting for CloudHSMv2 HSM (%s) creation: %w", hsmID, err)
+		}
+
+		hsmsPerAZ[az]++
+	}
+
+	for n := len(hsms); n > count; n-- {
+		az := clusterAvailabilityZoneByHSMCount(hsmsPerAZ, true)
+
+		var hsmID string
+		for i, hsm := range hsms {
+			if hsm != nil && aws.StringValue(hsm.AvailabilityZone) == az {
+				hsmID = aws.StringValue(hsm.HsmId)
+				hsms[i] = nil
+				break
+			}
+		}
+
+		if err := deleteClusterHSM(conn, id, hsmID, timeout); err != nil {
+			return err
+		}
+
+		hsmsPerAZ[az]--
+	}
+
+	return nil
+}
+
+// clusterAvailabilityZoneByHSMCount returns the Availability Zone with the fewest
+// (or, if most is true, the most) HSMs. Ties are broken by Availability Zone name.
+func clusterAvailabilityZoneByHSMCount(hsmsPerAZ map[string]int, most bool) string {
+	azs := make([]string, 0, len(hsmsPerAZ))
+	for az := range hsmsPerAZ {
+		azs = append(azs, az)
+	}
+	sort.Strings(azs)
+
+	var result string
+	for _, az := range azs {
+		if result == "" {
+			result = az
+			continue
+		}
+
+		if most && hsmsPerAZ[az] > hsmsPerAZ[result] {
+			result = az
+		} else if !most && hsmsPerAZ[az] < hsmsPerAZ[result] {
+			result = az
+		}
+	}
+
+	return result
+}
+
+func deleteClusterHSM(conn *cloudhsmv2.CloudHSMV2, clusterID, hsmID string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Deleting CloudHSMv2 HSM: %s", hsmID)
+	_, err := conn.DeleteHsm(&cloudhsmv2.DeleteHsmInput{
+		ClusterId: aws.String(clusterID),
+		HsmId:     aws.String(hsmID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudHSMv2 HSM (%s): %w", hsmID, err)
+	}
+
+	if _, err := waitHSMDeleted(conn, hsmID, timeout); err != nil {
+		return fmt.Errorf("error waiting for CloudHSMv2 HSM (%s) deletion: %w", hsmID, err)
+	}
+
+	return nil
+}
+
+func expandBackupRetentionPolicy(tfMap map[string]interface{}) *cloudhsmv2.BackupRetentionPolicy {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudhsmv2.BackupRetentionPolicy{}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	if v, ok := tfMap["value"].(int); ok && v != 0 {
+		apiObject.Value = aws.String(strconv.Itoa(v))
+	}
+
+	return apiObject
+}
+
+func flattenBackupRetentionPolicy(apiObject *cloudhsmv2.BackupRetentionPolicy) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"type": aws.StringValue(apiObject.Type),
+	}
+
+	if v, err := strconv.Atoi(aws.StringValue(apiObject.Value)); err == nil {
+		tfMap["value"] = v
+	}
+
+	return []interface{}{tfMap}
+}
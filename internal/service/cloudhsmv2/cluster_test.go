@@ -117,6 +117,83 @@ func testAccCluster_Tags(t *testing.T) {
 	})
 }
 
+func testAccCluster_backupRetentionPolicy(t *testing.T) {
+	resourceName := "aws_cloudhsm_v2_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cloudhsmv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterBackupRetentionPolicyConfig(7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_policy.0.type", cloudhsmv2.BackupRetentionTypeDays),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_policy.0.value", "7"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cluster_certificates"},
+			},
+			{
+				Config: testAccClusterBackupRetentionPolicyConfig(30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_policy.0.type", cloudhsmv2.BackupRetentionTypeDays),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_policy.0.value", "30"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCluster_hsmCount(t *testing.T) {
+	resourceName := "aws_cloudhsm_v2_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cloudhsmv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterHSMCountConfig(1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "hsm_count", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cluster_certificates", "hsm_count"},
+			},
+			{
+				Config: testAccClusterHSMCountConfig(2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "hsm_count", "2"),
+				),
+			},
+			{
+				Config: testAccClusterHSMCountConfig(1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "hsm_count", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccClusterBaseConfig() string {
 	return `
 data "aws_availability_zones" "available" {
@@ -178,6 +255,29 @@ resource "aws_cloudhsm_v2_cluster" "test" {
 `, tagKey1, tagValue1, tagKey2, tagValue2))
 }
 
+func testAccClusterBackupRetentionPolicyConfig(days int) string {
+	return acctest.ConfigCompose(testAccClusterBaseConfig(), fmt.Sprintf(`
+resource "aws_cloudhsm_v2_cluster" "test" {
+  hsm_type   = "hsm1.medium"
+  subnet_ids = aws_subnet.test[*].id
+
+  backup_retention_policy {
+    value = %[1]d
+  }
+}
+`, days))
+}
+
+func testAccClusterHSMCountConfig(count int) string {
+	return acctest.ConfigCompose(testAccClusterBaseConfig(), fmt.Sprintf(`
+resource "aws_cloudhsm_v2_cluster" "test" {
+  hsm_type   = "hsm1.medium"
+  subnet_ids = aws_subnet.test[*].id
+  hsm_count  = %[1]d
+}
+`, count))
+}
+
 func testAccCheckClusterDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudHSMV2Conn
 
@@ -166,10 +166,10 @@ func resourceRepositoryUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if d.HasChange("upstream") {
-		if v, ok := d.GetOk("upstream"); ok {
-			params.Upstreams = expandCodeArtifactUpstreams(v.([]interface{}))
-			needsUpdate = true
-		}
+		// Upstreams are replaced wholesale and in configuration order, which is also
+		// the order CodeArtifact searches them. An empty list removes all upstreams.
+		params.Upstreams = expandCodeArtifactUpstreams(d.Get("upstream").([]interface{}))
+		needsUpdate = true
 	}
 
 	if needsUpdate {
@@ -180,32 +180,37 @@ func resourceRepositoryUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if d.HasChange("external_connections") {
-		if v, ok := d.GetOk("external_connections"); ok {
-			externalConnection := v.([]interface{})[0].(map[string]interface{})
-			input := &codeartifact.AssociateExternalConnectionInput{
+		// A repository supports a single external connection, so the old one must be
+		// removed before a replacement can be associated.
+		o, n := d.GetChange("external_connections")
+
+		if oldConns := o.([]interface{}); len(oldConns) > 0 && oldConns[0] != nil {
+			externalConnection := oldConns[0].(map[string]interface{})
+			input := &codeartifact.DisassociateExternalConnectionInput{
 				Repository:         aws.String(d.Get("repository").(string)),
 				Domain:             aws.String(d.Get("domain").(string)),
 				DomainOwner:        aws.String(d.Get("domain_owner").(string)),
 				ExternalConnection: aws.String(externalConnection["external_connection_name"].(string)),
 			}
 
-			_, err := conn.AssociateExternalConnection(input)
+			_, err := conn.DisassociateExternalConnection(input)
 			if err != nil {
-				return fmt.Errorf("error associating external connection to CodeArtifact repository: %w", err)
+				return fmt.Errorf("error disassociating external connection to CodeArtifact repository: %w", err)
 			}
-		} else {
-			oldConn, _ := d.GetChange("external_connections")
-			externalConnection := oldConn.([]interface{})[0].(map[string]interface{})
-			input := &codeartifact.DisassociateExternalConnectionInput{
+		}
+
+		if newConns := n.([]interface{}); len(newConns) > 0 && newConns[0] != nil {
+			externalConnection := newConns[0].(map[string]interface{})
+			input := &codeartifact.AssociateExternalConnectionInput{
 				Repository:         aws.String(d.Get("repository").(string)),
 				Domain:             aws.String(d.Get("domain").(string)),
 				DomainOwner:        aws.String(d.Get("domain_owner").(string)),
 				ExternalConnection: aws.String(externalConnection["external_connection_name"].(string)),
 			}
 
-			_, err := conn.DisassociateExternalConnection(input)
+			_, err := conn.AssociateExternalConnection(input)
 			if err != nil {
-				return fmt.Errorf("error disassociating external connection to CodeArtifact repository: %w", err)
+				return fmt.Errorf("error associating external connection to CodeArtifact repository: %w", err)
 			}
 		}
 	}
@@ -253,16 +258,12 @@ func resourceRepositoryRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("administrator_account", sm.Repository.AdministratorAccount)
 	d.Set("description", sm.Repository.Description)
 
-	if sm.Repository.Upstreams != nil {
-		if err := d.Set("upstream", flattenCodeArtifactUpstreams(sm.Repository.Upstreams)); err != nil {
-			return fmt.Errorf("[WARN] Error setting upstream: %w", err)
-		}
+	if err := d.Set("upstream", flattenCodeArtifactUpstreams(sm.Repository.Upstreams)); err != nil {
+		return fmt.Errorf("error setting upstream: %w", err)
 	}
 
-	if sm.Repository.ExternalConnections != nil {
-		if err := d.Set("external_connections", flattenCodeArtifactExternalConnections(sm.Repository.ExternalConnections)); err != nil {
-			return fmt.Errorf("[WARN] Error setting external_connections: %w", err)
-		}
+	if err := d.Set("external_connections", flattenCodeArtifactExternalConnections(sm.Repository.ExternalConnections)); err != nil {
+		return fmt.Errorf("error setting external_connections: %w", err)
 	}
 
 	tags, err := ListTags(conn, arn)
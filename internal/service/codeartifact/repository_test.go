@@ -191,6 +191,15 @@ func testAccCodeArtifactRepository_upstreams(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "upstream.1.repository_name", fmt.Sprintf("%s-upstream2", rName)),
 				),
 			},
+			{
+				Config: testAccRepositoryUpstreams2ReversedConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRepositoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "upstream.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "upstream.0.repository_name", fmt.Sprintf("%s-upstream2", rName)),
+					resource.TestCheckResourceAttr(resourceName, "upstream.1.repository_name", fmt.Sprintf("%s-upstream1", rName)),
+				),
+			},
 			{
 				Config: testAccRepositoryUpstreams1Config(rName),
 				Check: resource.ComposeTestCheckFunc(
@@ -199,6 +208,13 @@ func testAccCodeArtifactRepository_upstreams(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "upstream.0.repository_name", fmt.Sprintf("%s-upstream1", rName)),
 				),
 			},
+			{
+				Config: testAccRepositoryBasicConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRepositoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "upstream.#", "0"),
+				),
+			},
 		},
 	})
 }
@@ -214,7 +230,7 @@ func testAccCodeArtifactRepository_externalConnection(t *testing.T) {
 		CheckDestroy: testAccCheckRepositoryDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccRepositoryExternalConnectionConfig(rName),
+				Config: testAccRepositoryExternalConnectionConfig(rName, "public:npmjs"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckRepositoryExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "external_connections.#", "1"),
@@ -236,7 +252,7 @@ func testAccCodeArtifactRepository_externalConnection(t *testing.T) {
 				),
 			},
 			{
-				Config: testAccRepositoryExternalConnectionConfig(rName),
+				Config: testAccRepositoryExternalConnectionConfig(rName, "public:npmjs"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckRepositoryExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "external_connections.#", "1"),
@@ -245,6 +261,16 @@ func testAccCodeArtifactRepository_externalConnection(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "external_connections.0.status", "AVAILABLE"),
 				),
 			},
+			{
+				Config: testAccRepositoryExternalConnectionConfig(rName, "public:pypi"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRepositoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "external_connections.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "external_connections.0.external_connection_name", "public:pypi"),
+					resource.TestCheckResourceAttr(resourceName, "external_connections.0.package_format", "pypi"),
+					resource.TestCheckResourceAttr(resourceName, "external_connections.0.status", "AVAILABLE"),
+				),
+			},
 		},
 	})
 }
@@ -420,19 +446,46 @@ resource "aws_codeartifact_repository" "test" {
 `, rName)
 }
 
-func testAccRepositoryExternalConnectionConfig(rName string) string {
+func testAccRepositoryUpstreams2ReversedConfig(rName string) string {
 	return testAccRepositoryBaseConfig(rName) + fmt.Sprintf(`
+resource "aws_codeartifact_repository" "upstream1" {
+  repository = "%[1]s-upstream1"
+  domain     = aws_codeartifact_domain.test.domain
+}
+
+resource "aws_codeartifact_repository" "upstream2" {
+  repository = "%[1]s-upstream2"
+  domain     = aws_codeartifact_domain.test.domain
+}
+
 resource "aws_codeartifact_repository" "test" {
   repository = %[1]q
   domain     = aws_codeartifact_domain.test.domain
 
-  external_connections {
-    external_connection_name = "public:npmjs"
+  upstream {
+    repository_name = aws_codeartifact_repository.upstream2.repository
+  }
+
+  upstream {
+    repository_name = aws_codeartifact_repository.upstream1.repository
   }
 }
 `, rName)
 }
 
+func testAccRepositoryExternalConnectionConfig(rName, externalConnectionName string) string {
+	return testAccRepositoryBaseConfig(rName) + fmt.Sprintf(`
+resource "aws_codeartifact_repository" "test" {
+  repository = %[1]q
+  domain     = aws_codeartifact_domain.test.domain
+
+  external_connections {
+    external_connection_name = %[2]q
+  }
+}
+`, rName, externalConnectionName)
+}
+
 func testAccRepositoryTags1Config(rName, tagKey1, tagValue1 string) string {
 	return testAccRepositoryBaseConfig(rName) + fmt.Sprintf(`
 resource "aws_codeartifact_repository" "test" {
@@ -2,6 +2,7 @@ package codedeploy
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"sort"
@@ -12,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/codedeploy"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -131,8 +133,9 @@ func ResourceDeploymentGroup() *schema.Resource {
 										ValidateFunc: validation.StringInSlice(codedeploy.DeploymentReadyAction_Values(), false),
 									},
 									"wait_time_in_minutes": {
-										Type:     schema.TypeInt,
-										Optional: true,
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(0, 2880),
 									},
 								},
 							},
@@ -480,8 +483,29 @@ func ResourceDeploymentGroup() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceDeploymentGroupCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+func resourceDeploymentGroupCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// The wait time only applies when rerouting must be started manually.
+	if v, ok := diff.GetOk("blue_green_deployment_config.0.deployment_ready_option.0.action_on_timeout"); ok && v.(string) == codedeploy.DeploymentReadyActionContinueDeployment {
+		if v := diff.Get("blue_green_deployment_config.0.deployment_ready_option.0.wait_time_in_minutes").(int); v != 0 {
+			return fmt.Errorf("'wait_time_in_minutes' must not be set when 'action_on_timeout' is '%s'", codedeploy.DeploymentReadyActionContinueDeployment)
+		}
+	}
+
+	// ECS blue/green deployments shift traffic between exactly two target groups.
+	if v, ok := diff.GetOk("ecs_service"); ok && len(v.([]interface{})) > 0 {
+		if v, ok := diff.GetOk("load_balancer_info.0.target_group_pair_info.0.target_group"); ok && len(v.([]interface{})) != 2 {
+			return fmt.Errorf("'target_group_pair_info' must contain two 'target_group' blocks when 'ecs_service' is set")
+		}
 	}
+
+	return nil
 }
 
 func resourceDeploymentGroupCreate(d *schema.ResourceData, meta interface{}) error {
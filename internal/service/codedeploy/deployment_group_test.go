@@ -1755,6 +1755,27 @@ func TestAccCodeDeployDeploymentGroup_ECS_blueGreen(t *testing.T) {
 	})
 }
 
+func TestAccCodeDeployDeploymentGroup_ECS_blueGreenDeploymentReadyOption(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, codedeploy.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDeploymentGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDeploymentGroupECSBlueGreenDeploymentReadyOptionConfig(rName, codedeploy.DeploymentReadyActionContinueDeployment, 30),
+				ExpectError: regexp.MustCompile(`'wait_time_in_minutes' must not be set`),
+			},
+			{
+				Config:      testAccDeploymentGroupECSBlueGreenDeploymentReadyOptionConfig(rName, codedeploy.DeploymentReadyActionStopDeployment, 2881),
+				ExpectError: regexp.MustCompile(`expected blue_green_deployment_config.0.deployment_ready_option.0.wait_time_in_minutes to be in the range`),
+			},
+		},
+	})
+}
+
 func TestDeploymentGroup_buildTriggerConfigs(t *testing.T) {
 	input := []interface{}{
 		map[string]interface{}{
@@ -3626,6 +3647,54 @@ resource "aws_codedeploy_deployment_group" "test" {
 `, rName)
 }
 
+func testAccDeploymentGroupECSBlueGreenDeploymentReadyOptionConfig(rName, actionOnTimeout string, waitTimeInMinutes int) string {
+	return testAccDeploymentGroupECSBaseConfig(rName) + fmt.Sprintf(`
+resource "aws_codedeploy_deployment_group" "test" {
+  app_name               = aws_codedeploy_app.test.name
+  deployment_config_name = "CodeDeployDefault.ECSAllAtOnce"
+  deployment_group_name  = %[1]q
+  service_role_arn       = aws_iam_role.test.arn
+
+  blue_green_deployment_config {
+    deployment_ready_option {
+      action_on_timeout    = %[2]q
+      wait_time_in_minutes = %[3]d
+    }
+
+    terminate_blue_instances_on_deployment_success {
+      action = "TERMINATE"
+    }
+  }
+
+  deployment_style {
+    deployment_option = "WITH_TRAFFIC_CONTROL"
+    deployment_type   = "BLUE_GREEN"
+  }
+
+  ecs_service {
+    cluster_name = aws_ecs_cluster.test.name
+    service_name = aws_ecs_service.test.name
+  }
+
+  load_balancer_info {
+    target_group_pair_info {
+      prod_traffic_route {
+        listener_arns = [aws_lb_listener.test.arn]
+      }
+
+      target_group {
+        name = aws_lb_target_group.blue.name
+      }
+
+      target_group {
+        name = aws_lb_target_group.green.name
+      }
+    }
+  }
+}
+`, rName, actionOnTimeout, waitTimeInMinutes)
+}
+
 func testAccDeploymentGroupTags1Config(rName, tagKey1, tagValue1 string) string {
 	return testAccDeploymentGroupBaseConfig(rName) + fmt.Sprintf(`
 resource "aws_codedeploy_deployment_group" "test" {
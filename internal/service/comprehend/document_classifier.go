@@ -0,0 +1,536 @@
+package comprehend
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/comprehend"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDocumentClassifier() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDocumentClassifierCreate,
+		Read:   resourceDocumentClassifierRead,
+		Update: resourceDocumentClassifierUpdate,
+		Delete: resourceDocumentClassifierDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_access_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"input_data_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"augmented_manifests": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attribute_names": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"s3_uri": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+							ExactlyOneOf: []string{"input_data_config.0.augmented_manifests", "input_data_config.0.s3_uri"},
+						},
+						"data_format": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      comprehend.DocumentClassifierDataFormatComprehendCsv,
+							ValidateFunc: validation.StringInSlice(comprehend.DocumentClassifierDataFormat_Values(), false),
+						},
+						"label_delimiter": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(documentClassifierLabelDelimiters(), false),
+						},
+						"s3_uri": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ExactlyOneOf: []string{"input_data_config.0.augmented_manifests", "input_data_config.0.s3_uri"},
+						},
+					},
+				},
+			},
+			"language_code": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(comprehend.LanguageCode_Values(), false),
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      comprehend.DocumentClassifierModeMultiClass,
+				ValidateFunc: validation.StringInSlice(comprehend.DocumentClassifierMode_Values(), false),
+			},
+			"model_kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 63),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9](-*[a-zA-Z0-9])*$`), "must contain only alphanumeric characters and hyphens, and must start and end with an alphanumeric character"),
+				),
+			},
+			"output_data_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"output_s3_uri": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"s3_uri": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"volume_kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"vpc_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnets": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDocumentClassifierCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ComprehendConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &comprehend.CreateDocumentClassifierInput{
+		DataAccessRoleArn:      aws.String(d.Get("data_access_role_arn").(string)),
+		DocumentClassifierName: aws.String(name),
+		InputDataConfig:        expandDocumentClassifierInputDataConfig(d.Get("input_data_config").([]interface{})),
+		LanguageCode:           aws.String(d.Get("language_code").(string)),
+		Mode:                   aws.String(d.Get("mode").(string)),
+	}
+
+	if v, ok := d.GetOk("model_kms_key_id"); ok {
+		input.ModelKmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("output_data_config"); ok {
+		input.OutputDataConfig = expandDocumentClassifierOutputDataConfig(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("volume_kms_key_id"); ok {
+		input.VolumeKmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("vpc_config"); ok {
+		input.VpcConfig = expandVPCConfig(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Comprehend Document Classifier: %s", input)
+	output, err := conn.CreateDocumentClassifier(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Comprehend Document Classifier (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.DocumentClassifierArn))
+
+	if _, err := waitDocumentClassifierTrained(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Comprehend Document Classifier (%s) training: %w", d.Id(), err)
+	}
+
+	return resourceDocumentClassifierRead(d, meta)
+}
+
+func resourceDocumentClassifierRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ComprehendConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindDocumentClassifierByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Comprehend Document Classifier (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Comprehend Document Classifier (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.DocumentClassifierArn)
+	d.Set("arn", arn)
+
+	name, err := documentClassifierNameFromARN(arn)
+
+	if err != nil {
+		return fmt.Errorf("error reading Comprehend Document Classifier (%s): %w", d.Id(), err)
+	}
+
+	d.Set("data_access_role_arn", output.DataAccessRoleArn)
+
+	if err := d.Set("input_data_config", flattenDocumentClassifierInputDataConfig(output.InputDataConfig)); err != nil {
+		return fmt.Errorf("error setting input_data_config: %w", err)
+	}
+
+	d.Set("language_code", output.LanguageCode)
+	d.Set("mode", output.Mode)
+	d.Set("model_kms_key_id", output.ModelKmsKeyId)
+	d.Set("name", name)
+
+	if err := d.Set("output_data_config", flattenDocumentClassifierOutputDataConfig(output.OutputDataConfig, d.Get("output_data_config").([]interface{}))); err != nil {
+		return fmt.Errorf("error setting output_data_config: %w", err)
+	}
+
+	d.Set("status", output.Status)
+	d.Set("volume_kms_key_id", output.VolumeKmsKeyId)
+
+	if err := d.Set("vpc_config", flattenVPCConfig(output.VpcConfig)); err != nil {
+		return fmt.Errorf("error setting vpc_config: %w", err)
+	}
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Comprehend Document Classifier (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDocumentClassifierUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ComprehendConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating Comprehend Document Classifier (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceDocumentClassifierRead(d, meta)
+}
+
+func resourceDocumentClassifierDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ComprehendConn
+
+	// Training must be stopped before a classifier can be deleted.
+	if status := d.Get("status").(string); status == comprehend.ModelStatusSubmitted || status == comprehend.ModelStatusTraining {
+		log.Printf("[DEBUG] Stopping Comprehend Document Classifier training: %s", d.Id())
+		_, err := conn.StopTrainingDocumentClassifier(&comprehend.StopTrainingDocumentClassifierInput{
+			DocumentClassifierArn: aws.String(d.Id()),
+		})
+
+		if tfawserr.ErrCodeEquals(err, comprehend.ErrCodeResourceNotFoundException) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error stopping Comprehend Document Classifier (%s) training: %w", d.Id(), err)
+		}
+
+		if _, err := waitDocumentClassifierStopped(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for Comprehend Document Classifier (%s) training to stop: %w", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Comprehend Document Classifier: %s", d.Id())
+	_, err := conn.DeleteDocumentClassifier(&comprehend.DeleteDocumentClassifierInput{
+		DocumentClassifierArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, comprehend.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Comprehend Document Classifier (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitDocumentClassifierDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Comprehend Document Classifier (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// documentClassifierLabelDelimiters returns the characters that can separate labels in MULTI_LABEL training data.
+func documentClassifierLabelDelimiters() []string {
+	return []string{"|", "~", "!", "@", "#", "$", "%", "^", "*", "-", "_", "+", "=", "\\", ":", ";", ">", "?", "/", " ", "\t"}
+}
+
+// documentClassifierNameFromARN returns the name from an ARN of the form arn:PARTITION:comprehend:REGION:ACCOUNT:document-classifier/NAME.
+func documentClassifierNameFromARN(v string) (string, error) {
+	parsedARN, err := arn.Parse(v)
+
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimPrefix(parsedARN.Resource, "document-classifier/"), nil
+}
+
+// documentClassifierOutputS3URIRegexp matches the location of the training output, which Comprehend
+// writes below the configured S3 URI.
+var documentClassifierOutputS3URIRegexp = regexp.MustCompile(`^(.*?)/?[0-9]{12}-CLR-[0-9a-f]+/output/output\.tar\.gz$`)
+
+func expandDocumentClassifierInputDataConfig(tfList []interface{}) *comprehend.DocumentClassifierInputDataConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &comprehend.DocumentClassifierInputDataConfig{}
+
+	if v, ok := tfMap["augmented_manifests"].([]interface{}); ok && len(v) > 0 {
+		apiObject.AugmentedManifests = expandAugmentedManifests(v)
+	}
+
+	if v, ok := tfMap["data_format"].(string); ok && v != "" {
+		apiObject.DataFormat = aws.String(v)
+	}
+
+	if v, ok := tfMap["label_delimiter"].(string); ok && v != "" {
+		apiObject.LabelDelimiter = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3_uri"].(string); ok && v != "" {
+		apiObject.S3Uri = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandAugmentedManifests(tfList []interface{}) []*comprehend.AugmentedManifestsListItem {
+	var apiObjects []*comprehend.AugmentedManifestsListItem
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &comprehend.AugmentedManifestsListItem{
+			AttributeNames: flex.ExpandStringList(tfMap["attribute_names"].([]interface{})),
+			S3Uri:          aws.String(tfMap["s3_uri"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandDocumentClassifierOutputDataConfig(tfList []interface{}) *comprehend.DocumentClassifierOutputDataConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &comprehend.DocumentClassifierOutputDataConfig{
+		S3Uri: aws.String(tfMap["s3_uri"].(string)),
+	}
+
+	if v, ok := tfMap["kms_key_id"].(string); ok && v != "" {
+		apiObject.KmsKeyId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandVPCConfig(tfList []interface{}) *comprehend.VpcConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &comprehend.VpcConfig{
+		SecurityGroupIds: flex.ExpandStringSet(tfMap["security_group_ids"].(*schema.Set)),
+		Subnets:          flex.ExpandStringSet(tfMap["subnets"].(*schema.Set)),
+	}
+}
+
+func flattenDocumentClassifierInputDataConfig(apiObject *comprehend.DocumentClassifierInputDataConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"augmented_manifests": flattenAugmentedManifests(apiObject.AugmentedManifests),
+		"data_format":         aws.StringValue(apiObject.DataFormat),
+		"label_delimiter":     aws.StringValue(apiObject.LabelDelimiter),
+		"s3_uri":              aws.StringValue(apiObject.S3Uri),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAugmentedManifests(apiObjects []*comprehend.AugmentedManifestsListItem) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"attribute_names": aws.StringValueSlice(apiObject.AttributeNames),
+			"s3_uri":          aws.StringValue(apiObject.S3Uri),
+		})
+	}
+
+	return tfList
+}
+
+// flattenDocumentClassifierOutputDataConfig flattens the output data configuration.
+// The API returns the location of the training output rather than the configured S3 URI,
+// so the configured value is kept when it is known and derived from the output location otherwise.
+func flattenDocumentClassifierOutputDataConfig(apiObject *comprehend.DocumentClassifierOutputDataConfig, tfList []interface{}) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	outputS3URI := aws.StringValue(apiObject.S3Uri)
+	tfMap := map[string]interface{}{
+		"kms_key_id":    aws.StringValue(apiObject.KmsKeyId),
+		"output_s3_uri": outputS3URI,
+	}
+
+	if len(tfList) > 0 && tfList[0] != nil {
+		if v, ok := tfList[0].(map[string]interface{})["s3_uri"].(string); ok && v != "" {
+			tfMap["s3_uri"] = v
+		}
+	}
+
+	if _, ok := tfMap["s3_uri"]; !ok {
+		if m := documentClassifierOutputS3URIRegexp.FindStringSubmatch(outputS3URI); m != nil {
+			tfMap["s3_uri"] = m[1]
+		} else {
+			tfMap["s3_uri"] = outputS3URI
+		}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenVPCConfig(apiObject *comprehend.VpcConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"security_group_ids": aws.StringValueSlice(apiObject.SecurityGroupIds),
+		"subnets":            aws.StringValueSlice(apiObject.Subnets),
+	}
+
+	return []interface{}{tfMap}
+}
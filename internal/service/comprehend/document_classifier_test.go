@@ -0,0 +1,295 @@
+package comprehend_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/comprehend"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcomprehend "github.com/hashicorp/terraform-provider-aws/internal/service/comprehend"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccComprehendDocumentClassifier_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_comprehend_document_classifier.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(comprehend.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, comprehend.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDocumentClassifierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDocumentClassifierConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentClassifierExists(resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "comprehend", fmt.Sprintf("document-classifier/%s", rName)),
+					resource.TestCheckResourceAttrPair(resourceName, "data_access_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "input_data_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "input_data_config.0.data_format", comprehend.DocumentClassifierDataFormatComprehendCsv),
+					resource.TestCheckResourceAttr(resourceName, "input_data_config.0.s3_uri", fmt.Sprintf("s3://%s/documents.csv", rName)),
+					resource.TestCheckResourceAttr(resourceName, "language_code", comprehend.LanguageCodeEn),
+					resource.TestCheckResourceAttr(resourceName, "mode", comprehend.DocumentClassifierModeMultiClass),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "output_data_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "output_data_config.0.s3_uri", fmt.Sprintf("s3://%s/outputs", rName)),
+					resource.TestMatchResourceAttr(resourceName, "output_data_config.0.output_s3_uri", regexp.MustCompile(fmt.Sprintf(`^s3://%s/outputs/[0-9]{12}-CLR-[0-9a-f]+/output/output\.tar\.gz$`, rName))),
+					resource.TestCheckResourceAttr(resourceName, "status", comprehend.ModelStatusTrained),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComprehendDocumentClassifier_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_comprehend_document_classifier.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(comprehend.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, comprehend.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDocumentClassifierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDocumentClassifierConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentClassifierExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfcomprehend.ResourceDocumentClassifier(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccComprehendDocumentClassifier_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_comprehend_document_classifier.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(comprehend.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, comprehend.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDocumentClassifierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDocumentClassifierTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentClassifierExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDocumentClassifierTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentClassifierExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccDocumentClassifierTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentClassifierExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDocumentClassifierExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Comprehend Document Classifier ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ComprehendConn
+
+		_, err := tfcomprehend.FindDocumentClassifierByARN(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckDocumentClassifierDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ComprehendConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_comprehend_document_classifier" {
+			continue
+		}
+
+		_, err := tfcomprehend.FindDocumentClassifierByARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Comprehend Document Classifier %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+// testAccDocumentClassifierDocuments returns MULTI_CLASS training data in the COMPREHEND_CSV format.
+// Comprehend requires at least 10 documents for each class.
+func testAccDocumentClassifierDocuments() string {
+	var b strings.Builder
+
+	subjects := []string{"order", "invoice", "delivery", "refund", "account", "subscription", "payment", "warranty", "shipment", "receipt", "return", "upgrade"}
+
+	for _, subject := range subjects {
+		fmt.Fprintf(&b, "COMPLAINT,\"My %[1]s is wrong and nobody has answered my messages about the %[1]s for a week.\"\n", subject)
+		fmt.Fprintf(&b, "PRAISE,\"Thank you for handling my %[1]s so quickly, the %[1]s was perfect.\"\n", subject)
+	}
+
+	return b.String()
+}
+
+func testAccDocumentClassifierConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "documents" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "documents.csv"
+  content = %[2]q
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"Service": "comprehend.${data.aws_partition.current.dns_suffix}"},
+    "Action": "sts:AssumeRole"
+  }]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  role = aws_iam_role.test.id
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": ["s3:GetObject", "s3:PutObject"],
+      "Resource": "${aws_s3_bucket.test.arn}/*"
+    },
+    {
+      "Effect": "Allow",
+      "Action": "s3:ListBucket",
+      "Resource": "${aws_s3_bucket.test.arn}"
+    }
+  ]
+}
+EOF
+}
+`, rName, testAccDocumentClassifierDocuments())
+}
+
+func testAccDocumentClassifierConfig(rName string) string {
+	return acctest.ConfigCompose(testAccDocumentClassifierConfigBase(rName), fmt.Sprintf(`
+resource "aws_comprehend_document_classifier" "test" {
+  name                 = %[1]q
+  data_access_role_arn = aws_iam_role.test.arn
+  language_code        = "en"
+
+  input_data_config {
+    s3_uri = "s3://${aws_s3_bucket_object.documents.bucket}/${aws_s3_bucket_object.documents.key}"
+  }
+
+  output_data_config {
+    s3_uri = "s3://${aws_s3_bucket.test.bucket}/outputs"
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccDocumentClassifierTags1Config(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccDocumentClassifierConfigBase(rName), fmt.Sprintf(`
+resource "aws_comprehend_document_classifier" "test" {
+  name                 = %[1]q
+  data_access_role_arn = aws_iam_role.test.arn
+  language_code        = "en"
+
+  input_data_config {
+    s3_uri = "s3://${aws_s3_bucket_object.documents.bucket}/${aws_s3_bucket_object.documents.key}"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccDocumentClassifierTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccDocumentClassifierConfigBase(rName), fmt.Sprintf(`
+resource "aws_comprehend_document_classifier" "test" {
+  name                 = %[1]q
+  data_access_role_arn = aws_iam_role.test.arn
+  language_code        = "en"
+
+  input_data_config {
+    s3_uri = "s3://${aws_s3_bucket_object.documents.bucket}/${aws_s3_bucket_object.documents.key}"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,233 @@
+package comprehend
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/comprehend"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEndpointCreate,
+		Read:   resourceEndpointRead,
+		Update: resourceEndpointUpdate,
+		Delete: resourceEndpointDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"current_inference_units": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"desired_inference_units": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"model_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 40),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9](-*[a-zA-Z0-9])*$`), "must contain only alphanumeric characters and hyphens, and must start and end with an alphanumeric character"),
+				),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceEndpointCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ComprehendConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &comprehend.CreateEndpointInput{
+		DesiredInferenceUnits: aws.Int64(int64(d.Get("desired_inference_units").(int))),
+		EndpointName:          aws.String(name),
+		ModelArn:              aws.String(d.Get("model_arn").(string)),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Comprehend Endpoint: %s", input)
+	output, err := conn.CreateEndpoint(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Comprehend Endpoint (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.EndpointArn))
+
+	if _, err := waitEndpointInService(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Comprehend Endpoint (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceEndpointRead(d, meta)
+}
+
+func resourceEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ComprehendConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindEndpointByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Comprehend Endpoint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Comprehend Endpoint (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.EndpointArn)
+	d.Set("arn", arn)
+	d.Set("current_inference_units", output.CurrentInferenceUnits)
+	d.Set("desired_inference_units", output.DesiredInferenceUnits)
+	d.Set("model_arn", output.ModelArn)
+
+	name, err := endpointNameFromARN(arn)
+
+	if err != nil {
+		return fmt.Errorf("error reading Comprehend Endpoint (%s): %w", d.Id(), err)
+	}
+
+	d.Set("name", name)
+	d.Set("status", output.Status)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Comprehend Endpoint (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ComprehendConn
+
+	if d.HasChange("desired_inference_units") {
+		input := &comprehend.UpdateEndpointInput{
+			DesiredInferenceUnits: aws.Int64(int64(d.Get("desired_inference_units").(int))),
+			EndpointArn:           aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Comprehend Endpoint: %s", input)
+		_, err := conn.UpdateEndpoint(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Comprehend Endpoint (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitEndpointInService(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Comprehend Endpoint (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating Comprehend Endpoint (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceEndpointRead(d, meta)
+}
+
+func resourceEndpointDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ComprehendConn
+
+	log.Printf("[DEBUG] Deleting Comprehend Endpoint: %s", d.Id())
+	_, err := conn.DeleteEndpoint(&comprehend.DeleteEndpointInput{
+		EndpointArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, comprehend.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Comprehend Endpoint (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitEndpointDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Comprehend Endpoint (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// endpointNameFromARN returns the name from an ARN of the form arn:PARTITION:comprehend:REGION:ACCOUNT:TYPE-endpoint/NAME.
+func endpointNameFromARN(v string) (string, error) {
+	parsedARN, err := arn.Parse(v)
+
+	if err != nil {
+		return "", err
+	}
+
+	parts := strings.SplitN(parsedARN.Resource, "/", 2)
+
+	if len(parts) != 2 || parts[1] == "" {
+		return "", fmt.Errorf("unexpected format for endpoint ARN (%s)", v)
+	}
+
+	return parts[1], nil
+}
@@ -0,0 +1,59 @@
+package comprehend
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/comprehend"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindDocumentClassifierByARN(conn *comprehend.Comprehend, arn string) (*comprehend.DocumentClassifierProperties, error) {
+	input := &comprehend.DescribeDocumentClassifierInput{
+		DocumentClassifierArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeDocumentClassifier(input)
+
+	if tfawserr.ErrCodeEquals(err, comprehend.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DocumentClassifierProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DocumentClassifierProperties, nil
+}
+
+func FindEndpointByARN(conn *comprehend.Comprehend, arn string) (*comprehend.EndpointProperties, error) {
+	input := &comprehend.DescribeEndpointInput{
+		EndpointArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeEndpoint(input)
+
+	if tfawserr.ErrCodeEquals(err, comprehend.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.EndpointProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.EndpointProperties, nil
+}
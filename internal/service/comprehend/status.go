@@ -0,0 +1,40 @@
+package comprehend
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/comprehend"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusDocumentClassifier(conn *comprehend.Comprehend, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDocumentClassifierByARN(conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusEndpoint(conn *comprehend.Comprehend, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindEndpointByARN(conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
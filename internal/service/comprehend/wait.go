@@ -0,0 +1,108 @@
+package comprehend
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/comprehend"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	documentClassifierStoppedTimeout = 30 * time.Minute
+)
+
+func waitDocumentClassifierTrained(conn *comprehend.Comprehend, arn string, timeout time.Duration) (*comprehend.DocumentClassifierProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{comprehend.ModelStatusSubmitted, comprehend.ModelStatusTraining},
+		Target:  []string{comprehend.ModelStatusTrained},
+		Refresh: statusDocumentClassifier(conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*comprehend.DocumentClassifierProperties); ok {
+		if status := aws.StringValue(output.Status); status == comprehend.ModelStatusInError {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDocumentClassifierStopped(conn *comprehend.Comprehend, arn string) (*comprehend.DocumentClassifierProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{comprehend.ModelStatusSubmitted, comprehend.ModelStatusTraining, comprehend.ModelStatusStopRequested},
+		Target:  []string{comprehend.ModelStatusStopped, comprehend.ModelStatusTrained, comprehend.ModelStatusInError},
+		Refresh: statusDocumentClassifier(conn, arn),
+		Timeout: documentClassifierStoppedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*comprehend.DocumentClassifierProperties); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDocumentClassifierDeleted(conn *comprehend.Comprehend, arn string, timeout time.Duration) (*comprehend.DocumentClassifierProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: comprehend.ModelStatus_Values(),
+		Target:  []string{},
+		Refresh: statusDocumentClassifier(conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*comprehend.DocumentClassifierProperties); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEndpointInService(conn *comprehend.Comprehend, arn string, timeout time.Duration) (*comprehend.EndpointProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{comprehend.EndpointStatusCreating, comprehend.EndpointStatusUpdating},
+		Target:  []string{comprehend.EndpointStatusInService},
+		Refresh: statusEndpoint(conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*comprehend.EndpointProperties); ok {
+		if status := aws.StringValue(output.Status); status == comprehend.EndpointStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEndpointDeleted(conn *comprehend.Comprehend, arn string, timeout time.Duration) (*comprehend.EndpointProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: comprehend.EndpointStatus_Values(),
+		Target:  []string{},
+		Refresh: statusEndpoint(conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*comprehend.EndpointProperties); ok {
+		return output, err
+	}
+
+	return nil, err
+}
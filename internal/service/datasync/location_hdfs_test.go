@@ -0,0 +1,220 @@
+package datasync_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/datasync"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdatasync "github.com/hashicorp/terraform-provider-aws/internal/service/datasync"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccDataSyncLocationHDFS_basic(t *testing.T) {
+	var v datasync.DescribeLocationHdfsOutput
+	resourceName := "aws_datasync_location_hdfs.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, datasync.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLocationHDFSDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLocationHDFSConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLocationHDFSExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "datasync", regexp.MustCompile(`location/loc-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "agent_arns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_type", "SIMPLE"),
+					resource.TestCheckResourceAttr(resourceName, "name_node.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "simple_user", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestMatchResourceAttr(resourceName, "uri", regexp.MustCompile(`^hdfs://.+/`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDataSyncLocationHDFS_disappears(t *testing.T) {
+	var v datasync.DescribeLocationHdfsOutput
+	resourceName := "aws_datasync_location_hdfs.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, datasync.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLocationHDFSDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLocationHDFSConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLocationHDFSExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfdatasync.ResourceLocationHDFS(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDataSyncLocationHDFS_tags(t *testing.T) {
+	var v datasync.DescribeLocationHdfsOutput
+	resourceName := "aws_datasync_location_hdfs.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, datasync.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLocationHDFSDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLocationHDFSTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLocationHDFSExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLocationHDFSTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLocationHDFSExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccLocationHDFSTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLocationHDFSExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLocationHDFSDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DataSyncConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_datasync_location_hdfs" {
+			continue
+		}
+
+		_, err := tfdatasync.FindLocationHDFSByARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("DataSync Location HDFS %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckLocationHDFSExists(resourceName string, v *datasync.DescribeLocationHdfsOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DataSync Location HDFS ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataSyncConn
+
+		output, err := tfdatasync.FindLocationHDFSByARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccLocationHDFSConfig(rName string) string {
+	return acctest.ConfigCompose(testAccLocationNFSBaseConfig(rName), fmt.Sprintf(`
+resource "aws_datasync_location_hdfs" "test" {
+  agent_arns          = [aws_datasync_agent.test.arn]
+  authentication_type = "SIMPLE"
+  simple_user         = %[1]q
+
+  name_node {
+    hostname = aws_instance.test.private_dns
+    port     = 80
+  }
+}
+`, rName))
+}
+
+func testAccLocationHDFSTags1Config(rName, key1, value1 string) string {
+	return acctest.ConfigCompose(testAccLocationNFSBaseConfig(rName), fmt.Sprintf(`
+resource "aws_datasync_location_hdfs" "test" {
+  agent_arns          = [aws_datasync_agent.test.arn]
+  authentication_type = "SIMPLE"
+  simple_user         = %[1]q
+
+  name_node {
+    hostname = aws_instance.test.private_dns
+    port     = 80
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, key1, value1))
+}
+
+func testAccLocationHDFSTags2Config(rName, key1, value1, key2, value2 string) string {
+	return acctest.ConfigCompose(testAccLocationNFSBaseConfig(rName), fmt.Sprintf(`
+resource "aws_datasync_location_hdfs" "test" {
+  agent_arns          = [aws_datasync_agent.test.arn]
+  authentication_type = "SIMPLE"
+  simple_user         = %[1]q
+
+  name_node {
+    hostname = aws_instance.test.private_dns
+    port     = 80
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, key1, value1, key2, value2))
+}
@@ -8,8 +8,8 @@ import (
 )
 
 var (
-	locationURIPattern                      = regexp.MustCompile(`^(efs|nfs|s3|smb|fsxw)://(.+)$`)
-	locationURIGlobalIDAndSubdirPattern     = regexp.MustCompile(`^([a-zA-Z0-9.\-]+)(/.*)$`)
+	locationURIPattern                      = regexp.MustCompile(`^(efs|hdfs|nfs|s3|smb|fsxw)://(.+)$`)
+	locationURIGlobalIDAndSubdirPattern     = regexp.MustCompile(`^([a-zA-Z0-9.\-]+(?::\d{0,5})?)(/.*)$`)
 	s3OutpostsAccessPointARNResourcePattern = regexp.MustCompile(`^outpost/.*/accesspoint/.*?(/.*)$`)
 )
 
@@ -123,6 +123,16 @@ func TestSubdirectoryFromLocationURI(t *testing.T) {
 			InputURI:             "fsxw://us-west-2.fs-abcdef012345678901/my-folder-1/my-folder-2", //lintignore:AWSAT003
 			ExpectedSubdirectory: "/my-folder-1/my-folder-2",
 		},
+		{
+			TestName:             "HDFS URI top level",
+			InputURI:             "hdfs://192.168.1.1:8020/",
+			ExpectedSubdirectory: "/",
+		},
+		{
+			TestName:             "HDFS URI one level",
+			InputURI:             "hdfs://192.168.1.1:8020/my-folder-1/",
+			ExpectedSubdirectory: "/my-folder-1/",
+		},
 	}
 
 	for _, testCase := range testCases {
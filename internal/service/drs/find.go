@@ -0,0 +1,38 @@
+package drs
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/drs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindReplicationConfigurationTemplateByID(conn *drs.Drs, id string) (*drs.ReplicationConfigurationTemplate, error) {
+	input := &drs.DescribeReplicationConfigurationTemplatesInput{
+		ReplicationConfigurationTemplateIDs: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeReplicationConfigurationTemplates(input)
+
+	if tfawserr.ErrCodeEquals(err, drs.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Items) == 0 || output.Items[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Items); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.Items[0], nil
+}
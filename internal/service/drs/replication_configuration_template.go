@@ -0,0 +1,357 @@
+package drs
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/drs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceReplicationConfigurationTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceReplicationConfigurationTemplateCreate,
+		Read:   resourceReplicationConfigurationTemplateRead,
+		Update: resourceReplicationConfigurationTemplateUpdate,
+		Delete: resourceReplicationConfigurationTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"associate_default_security_group": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"bandwidth_throttling": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"create_public_ip": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"data_plane_routing": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(drs.ReplicationConfigurationDataPlaneRouting_Values(), false),
+			},
+			"default_large_staging_disk_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(drs.ReplicationConfigurationDefaultLargeStagingDiskType_Values(), false),
+			},
+			"ebs_encryption": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(drs.ReplicationConfigurationEbsEncryption_Values(), false),
+			},
+			"ebs_encryption_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"pit_policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"interval": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"retention_duration": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"rule_id": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"units": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(drs.PITPolicyRuleUnits_Values(), false),
+						},
+					},
+				},
+			},
+			"replication_server_instance_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"replication_servers_security_groups_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"staging_area_subnet_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"staging_area_tags": {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"use_dedicated_replication_server": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceReplicationConfigurationTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DRSConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &drs.CreateReplicationConfigurationTemplateInput{
+		AssociateDefaultSecurityGroup:       aws.Bool(d.Get("associate_default_security_group").(bool)),
+		BandwidthThrottling:                 aws.Int64(int64(d.Get("bandwidth_throttling").(int))),
+		CreatePublicIP:                      aws.Bool(d.Get("create_public_ip").(bool)),
+		DataPlaneRouting:                    aws.String(d.Get("data_plane_routing").(string)),
+		DefaultLargeStagingDiskType:         aws.String(d.Get("default_large_staging_disk_type").(string)),
+		EbsEncryption:                       aws.String(d.Get("ebs_encryption").(string)),
+		PitPolicy:                           expandPITPolicyRules(d.Get("pit_policy").([]interface{})),
+		ReplicationServerInstanceType:       aws.String(d.Get("replication_server_instance_type").(string)),
+		ReplicationServersSecurityGroupsIDs: flex.ExpandStringSet(d.Get("replication_servers_security_groups_ids").(*schema.Set)),
+		StagingAreaSubnetId:                 aws.String(d.Get("staging_area_subnet_id").(string)),
+		StagingAreaTags:                     flex.ExpandStringMap(d.Get("staging_area_tags").(map[string]interface{})),
+		UseDedicatedReplicationServer:       aws.Bool(d.Get("use_dedicated_replication_server").(bool)),
+	}
+
+	if v, ok := d.GetOk("ebs_encryption_key_arn"); ok {
+		input.EbsEncryptionKeyArn = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating DRS Replication Configuration Template: %s", input)
+	output, err := conn.CreateReplicationConfigurationTemplate(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating DRS Replication Configuration Template: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.ReplicationConfigurationTemplateID))
+
+	return resourceReplicationConfigurationTemplateRead(d, meta)
+}
+
+func resourceReplicationConfigurationTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DRSConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	template, err := FindReplicationConfigurationTemplateByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DRS Replication Configuration Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading DRS Replication Configuration Template (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", template.Arn)
+	d.Set("associate_default_security_group", template.AssociateDefaultSecurityGroup)
+	d.Set("bandwidth_throttling", template.BandwidthThrottling)
+	d.Set("create_public_ip", template.CreatePublicIP)
+	d.Set("data_plane_routing", template.DataPlaneRouting)
+	d.Set("default_large_staging_disk_type", template.DefaultLargeStagingDiskType)
+	d.Set("ebs_encryption", template.EbsEncryption)
+	d.Set("ebs_encryption_key_arn", template.EbsEncryptionKeyArn)
+	if err := d.Set("pit_policy", flattenPITPolicyRules(template.PitPolicy)); err != nil {
+		return fmt.Errorf("error setting pit_policy: %w", err)
+	}
+	d.Set("replication_server_instance_type", template.ReplicationServerInstanceType)
+	d.Set("replication_servers_security_groups_ids", aws.StringValueSlice(template.ReplicationServersSecurityGroupsIDs))
+	d.Set("staging_area_subnet_id", template.StagingAreaSubnetId)
+	if err := d.Set("staging_area_tags", aws.StringValueMap(template.StagingAreaTags)); err != nil {
+		return fmt.Errorf("error setting staging_area_tags: %w", err)
+	}
+	d.Set("use_dedicated_replication_server", template.UseDedicatedReplicationServer)
+
+	tags := KeyValueTags(template.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceReplicationConfigurationTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DRSConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &drs.UpdateReplicationConfigurationTemplateInput{
+			AssociateDefaultSecurityGroup:       aws.Bool(d.Get("associate_default_security_group").(bool)),
+			BandwidthThrottling:                 aws.Int64(int64(d.Get("bandwidth_throttling").(int))),
+			CreatePublicIP:                      aws.Bool(d.Get("create_public_ip").(bool)),
+			DataPlaneRouting:                    aws.String(d.Get("data_plane_routing").(string)),
+			DefaultLargeStagingDiskType:         aws.String(d.Get("default_large_staging_disk_type").(string)),
+			EbsEncryption:                       aws.String(d.Get("ebs_encryption").(string)),
+			PitPolicy:                           expandPITPolicyRules(d.Get("pit_policy").([]interface{})),
+			ReplicationConfigurationTemplateID:  aws.String(d.Id()),
+			ReplicationServerInstanceType:       aws.String(d.Get("replication_server_instance_type").(string)),
+			ReplicationServersSecurityGroupsIDs: flex.ExpandStringSet(d.Get("replication_servers_security_groups_ids").(*schema.Set)),
+			StagingAreaSubnetId:                 aws.String(d.Get("staging_area_subnet_id").(string)),
+			StagingAreaTags:                     flex.ExpandStringMap(d.Get("staging_area_tags").(map[string]interface{})),
+			UseDedicatedReplicationServer:       aws.Bool(d.Get("use_dedicated_replication_server").(bool)),
+		}
+
+		if v, ok := d.GetOk("ebs_encryption_key_arn"); ok {
+			input.EbsEncryptionKeyArn = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating DRS Replication Configuration Template: %s", input)
+		_, err := conn.UpdateReplicationConfigurationTemplate(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating DRS Replication Configuration Template (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating DRS Replication Configuration Template (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceReplicationConfigurationTemplateRead(d, meta)
+}
+
+func resourceReplicationConfigurationTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DRSConn
+
+	log.Printf("[DEBUG] Deleting DRS Replication Configuration Template: %s", d.Id())
+	_, err := conn.DeleteReplicationConfigurationTemplate(&drs.DeleteReplicationConfigurationTemplateInput{
+		ReplicationConfigurationTemplateID: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, drs.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting DRS Replication Configuration Template (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandPITPolicyRule(tfMap map[string]interface{}) *drs.PITPolicyRule {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &drs.PITPolicyRule{
+		Interval:          aws.Int64(int64(tfMap["interval"].(int))),
+		RetentionDuration: aws.Int64(int64(tfMap["retention_duration"].(int))),
+		Units:             aws.String(tfMap["units"].(string)),
+	}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["rule_id"].(int); ok && v != 0 {
+		apiObject.RuleID = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandPITPolicyRules(tfList []interface{}) []*drs.PITPolicyRule {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*drs.PITPolicyRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandPITPolicyRule(tfMap))
+	}
+
+	return apiObjects
+}
+
+func flattenPITPolicyRule(apiObject *drs.PITPolicyRule) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"enabled":            aws.BoolValue(apiObject.Enabled),
+		"interval":           aws.Int64Value(apiObject.Interval),
+		"retention_duration": aws.Int64Value(apiObject.RetentionDuration),
+		"rule_id":            aws.Int64Value(apiObject.RuleID),
+		"units":              aws.StringValue(apiObject.Units),
+	}
+}
+
+func flattenPITPolicyRules(apiObjects []*drs.PITPolicyRule) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenPITPolicyRule(apiObject))
+	}
+
+	return tfList
+}
@@ -0,0 +1,328 @@
+package drs_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/drs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdrs "github.com/hashicorp/terraform-provider-aws/internal/service/drs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccDRSReplicationConfigurationTemplate_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_drs_replication_configuration_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, drs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckReplicationConfigurationTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationConfigurationTemplateConfig(rName, 0, drs.ReplicationConfigurationDefaultLargeStagingDiskTypeGp3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationConfigurationTemplateExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "drs", regexp.MustCompile(`replication-configuration-template/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "associate_default_security_group", "false"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_throttling", "0"),
+					resource.TestCheckResourceAttr(resourceName, "create_public_ip", "false"),
+					resource.TestCheckResourceAttr(resourceName, "data_plane_routing", drs.ReplicationConfigurationDataPlaneRoutingPrivateIp),
+					resource.TestCheckResourceAttr(resourceName, "default_large_staging_disk_type", drs.ReplicationConfigurationDefaultLargeStagingDiskTypeGp3),
+					resource.TestCheckResourceAttr(resourceName, "ebs_encryption", drs.ReplicationConfigurationEbsEncryptionDefault),
+					resource.TestCheckResourceAttr(resourceName, "pit_policy.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "pit_policy.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "pit_policy.0.interval", "10"),
+					resource.TestCheckResourceAttr(resourceName, "pit_policy.0.retention_duration", "60"),
+					resource.TestCheckResourceAttr(resourceName, "pit_policy.0.units", drs.PITPolicyRuleUnitsMinute),
+					resource.TestCheckResourceAttr(resourceName, "pit_policy.1.interval", "1"),
+					resource.TestCheckResourceAttr(resourceName, "pit_policy.1.retention_duration", "24"),
+					resource.TestCheckResourceAttr(resourceName, "pit_policy.1.units", drs.PITPolicyRuleUnitsHour),
+					resource.TestCheckResourceAttr(resourceName, "replication_server_instance_type", "t3.small"),
+					resource.TestCheckResourceAttr(resourceName, "replication_servers_security_groups_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "replication_servers_security_groups_ids.*", "aws_security_group.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "staging_area_subnet_id", "aws_subnet.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "staging_area_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "staging_area_tags.Name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "use_dedicated_replication_server", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccReplicationConfigurationTemplateConfig(rName, 12500, drs.ReplicationConfigurationDefaultLargeStagingDiskTypeSt1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationConfigurationTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_throttling", "12500"),
+					resource.TestCheckResourceAttr(resourceName, "default_large_staging_disk_type", drs.ReplicationConfigurationDefaultLargeStagingDiskTypeSt1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDRSReplicationConfigurationTemplate_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_drs_replication_configuration_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, drs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckReplicationConfigurationTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationConfigurationTemplateConfig(rName, 0, drs.ReplicationConfigurationDefaultLargeStagingDiskTypeGp3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationConfigurationTemplateExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfdrs.ResourceReplicationConfigurationTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDRSReplicationConfigurationTemplate_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_drs_replication_configuration_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, drs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckReplicationConfigurationTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationConfigurationTemplateTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationConfigurationTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccReplicationConfigurationTemplateTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationConfigurationTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccReplicationConfigurationTemplateTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationConfigurationTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPreCheck(t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DRSConn
+
+	input := &drs.DescribeReplicationConfigurationTemplatesInput{
+		ReplicationConfigurationTemplateIDs: aws.StringSlice([]string{}),
+	}
+
+	_, err := conn.DescribeReplicationConfigurationTemplates(input)
+
+	if acctest.PreCheckSkipError(err) || tfawserr.ErrCodeEquals(err, drs.ErrCodeUninitializedAccountException) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccCheckReplicationConfigurationTemplateExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DRS Replication Configuration Template ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DRSConn
+
+		_, err := tfdrs.FindReplicationConfigurationTemplateByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckReplicationConfigurationTemplateDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DRSConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_drs_replication_configuration_template" {
+			continue
+		}
+
+		_, err := tfdrs.FindReplicationConfigurationTemplateByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("DRS Replication Configuration Template %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccReplicationConfigurationTemplateConfigBase(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  vpc_id            = aws_vpc.test.id
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.0.0.0/24"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccReplicationConfigurationTemplateConfig(rName string, bandwidthThrottling int, stagingDiskType string) string {
+	return acctest.ConfigCompose(testAccReplicationConfigurationTemplateConfigBase(rName), fmt.Sprintf(`
+resource "aws_drs_replication_configuration_template" "test" {
+  associate_default_security_group        = false
+  bandwidth_throttling                    = %[2]d
+  create_public_ip                        = false
+  data_plane_routing                      = "PRIVATE_IP"
+  default_large_staging_disk_type         = %[3]q
+  ebs_encryption                          = "DEFAULT"
+  replication_server_instance_type        = "t3.small"
+  replication_servers_security_groups_ids = [aws_security_group.test.id]
+  staging_area_subnet_id                  = aws_subnet.test.id
+  use_dedicated_replication_server        = false
+
+  pit_policy {
+    interval           = 10
+    retention_duration = 60
+    units              = "MINUTE"
+  }
+
+  pit_policy {
+    interval           = 1
+    retention_duration = 24
+    units              = "HOUR"
+  }
+
+  staging_area_tags = {
+    Name = %[1]q
+  }
+}
+`, rName, bandwidthThrottling, stagingDiskType))
+}
+
+func testAccReplicationConfigurationTemplateTags1Config(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccReplicationConfigurationTemplateConfigBase(rName), fmt.Sprintf(`
+resource "aws_drs_replication_configuration_template" "test" {
+  associate_default_security_group        = false
+  bandwidth_throttling                    = 0
+  create_public_ip                        = false
+  data_plane_routing                      = "PRIVATE_IP"
+  default_large_staging_disk_type         = "GP3"
+  ebs_encryption                          = "DEFAULT"
+  replication_server_instance_type        = "t3.small"
+  replication_servers_security_groups_ids = [aws_security_group.test.id]
+  staging_area_subnet_id                  = aws_subnet.test.id
+  use_dedicated_replication_server        = false
+
+  pit_policy {
+    interval           = 10
+    retention_duration = 60
+    units              = "MINUTE"
+  }
+
+  staging_area_tags = {
+    Name = %[1]q
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccReplicationConfigurationTemplateTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccReplicationConfigurationTemplateConfigBase(rName), fmt.Sprintf(`
+resource "aws_drs_replication_configuration_template" "test" {
+  associate_default_security_group        = false
+  bandwidth_throttling                    = 0
+  create_public_ip                        = false
+  data_plane_routing                      = "PRIVATE_IP"
+  default_large_staging_disk_type         = "GP3"
+  ebs_encryption                          = "DEFAULT"
+  replication_server_instance_type        = "t3.small"
+  replication_servers_security_groups_ids = [aws_security_group.test.id]
+  staging_area_subnet_id                  = aws_subnet.test.id
+  use_dedicated_replication_server        = false
+
+  pit_policy {
+    interval           = 10
+    retention_duration = 60
+    units              = "MINUTE"
+  }
+
+  staging_area_tags = {
+    Name = %[1]q
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
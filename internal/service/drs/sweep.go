@@ -0,0 +1,68 @@
+//go:build sweep
+// +build sweep
+
+package drs
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/drs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_drs_replication_configuration_template", &resource.Sweeper{
+		Name: "aws_drs_replication_configuration_template",
+		F:    sweepReplicationConfigurationTemplates,
+	})
+}
+
+func sweepReplicationConfigurationTemplates(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).DRSConn
+	input := &drs.DescribeReplicationConfigurationTemplatesInput{
+		ReplicationConfigurationTemplateIDs: aws.StringSlice([]string{}),
+	}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.DescribeReplicationConfigurationTemplatesPages(input, func(page *drs.DescribeReplicationConfigurationTemplatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			r := ResourceReplicationConfigurationTemplate()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.ReplicationConfigurationTemplateID))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) || tfawserr.ErrCodeEquals(err, drs.ErrCodeUninitializedAccountException) {
+		log.Printf("[WARN] Skipping DRS Replication Configuration Template sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing DRS Replication Configuration Templates (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping DRS Replication Configuration Templates (%s): %w", region, err)
+	}
+
+	return nil
+}
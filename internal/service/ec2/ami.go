@@ -61,6 +61,12 @@ func ResourceAMI() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"deprecation_time": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     verify.ValidUTCTimestamp,
+				DiffSuppressFunc: suppressAMIDeprecationTimeDiffs,
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -327,6 +333,12 @@ func resourceAMICreate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if v, ok := d.GetOk("deprecation_time"); ok {
+		if err := enableAMIDeprecation(client, d.Id(), v.(string)); err != nil {
+			return err
+		}
+	}
+
 	return resourceAMIRead(d, meta)
 }
 
@@ -407,6 +419,7 @@ func resourceAMIRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("architecture", image.Architecture)
+	d.Set("deprecation_time", flattenAMIDeprecationTime(image.DeprecationTime))
 	d.Set("description", image.Description)
 	d.Set("ena_support", image.EnaSupport)
 	d.Set("hypervisor", image.Hypervisor)
@@ -468,6 +481,18 @@ func resourceAMIUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("deprecation_time") {
+		if v := d.Get("deprecation_time").(string); v != "" {
+			if err := enableAMIDeprecation(client, d.Id(), v); err != nil {
+				return err
+			}
+		} else {
+			if err := disableAMIDeprecation(client, d.Id()); err != nil {
+				return err
+			}
+		}
+	}
+
 	if d.Get("description").(string) != "" {
 		_, err := client.ModifyImageAttribute(&ec2.ModifyImageAttributeInput{
 			ImageId: aws.String(d.Id()),
@@ -574,6 +599,69 @@ func AMIWaitForDestroy(timeout time.Duration, id string, client *ec2.EC2) error
 	return nil
 }
 
+func enableAMIDeprecation(conn *ec2.EC2, id string, deprecateAt string) error {
+	v, _ := time.Parse(time.RFC3339, deprecateAt)
+
+	_, err := conn.EnableImageDeprecation(&ec2.EnableImageDeprecationInput{
+		DeprecateAt: aws.Time(v),
+		ImageId:     aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error enabling deprecation for AMI (%s): %w", id, err)
+	}
+
+	return nil
+}
+
+func disableAMIDeprecation(conn *ec2.EC2, id string) error {
+	_, err := conn.DisableImageDeprecation(&ec2.DisableImageDeprecationInput{
+		ImageId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error disabling deprecation for AMI (%s): %w", id, err)
+	}
+
+	return nil
+}
+
+// flattenAMIDeprecationTime returns the deprecation time in the RFC3339 form used in configuration.
+// The API reports it with milliseconds, e.g. "2021-12-31T00:00:00.000Z".
+func flattenAMIDeprecationTime(v *string) string {
+	if v == nil {
+		return ""
+	}
+
+	t, err := time.Parse(time.RFC3339, aws.StringValue(v))
+
+	if err != nil {
+		return aws.StringValue(v)
+	}
+
+	return t.Format(time.RFC3339)
+}
+
+// suppressAMIDeprecationTimeDiffs suppresses differences of less than a minute.
+// EC2 rounds deprecation times to the nearest minute, so any seconds in the configured value are lost.
+func suppressAMIDeprecationTimeDiffs(k, old, new string, d *schema.ResourceData) bool {
+	o, err := time.Parse(time.RFC3339, old)
+
+	if err != nil {
+		return false
+	}
+
+	n, err := time.Parse(time.RFC3339, new)
+
+	if err != nil {
+		return false
+	}
+
+	delta := o.Sub(n)
+
+	return delta > -time.Minute && delta < time.Minute
+}
+
 func resourceAMIWaitForAvailable(timeout time.Duration, id string, client *ec2.EC2) (*ec2.Image, error) {
 	log.Printf("Waiting for AMI %s to become available...", id)
 
@@ -32,6 +32,12 @@ func ResourceAMICopy() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"deprecation_time": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     verify.ValidUTCTimestamp,
+				DiffSuppressFunc: suppressAMIDeprecationTimeDiffs,
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -282,5 +288,11 @@ func resourceAMICopyCreate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if v, ok := d.GetOk("deprecation_time"); ok {
+		if err := enableAMIDeprecation(client, d.Id(), v.(string)); err != nil {
+			return err
+		}
+	}
+
 	return resourceAMIRead(d, meta)
 }
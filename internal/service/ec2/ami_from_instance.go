@@ -32,6 +32,12 @@ func ResourceAMIFromInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"deprecation_time": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     verify.ValidUTCTimestamp,
+				DiffSuppressFunc: suppressAMIDeprecationTimeDiffs,
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -202,6 +208,11 @@ func ResourceAMIFromInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"tag_snapshots": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 			"usage_operation": {
@@ -237,6 +248,11 @@ func resourceAMIFromInstanceCreate(d *schema.ResourceData, meta interface{}) err
 		TagSpecifications: ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeImage),
 	}
 
+	// The snapshots backing the AMI are only tagged at creation; later tag changes apply to the AMI alone.
+	if d.Get("tag_snapshots").(bool) {
+		req.TagSpecifications = append(req.TagSpecifications, ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeSnapshot)...)
+	}
+
 	res, err := client.CreateImage(req)
 	if err != nil {
 		return err
@@ -250,5 +266,11 @@ func resourceAMIFromInstanceCreate(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 
+	if v, ok := d.GetOk("deprecation_time"); ok {
+		if err := enableAMIDeprecation(client, d.Id(), v.(string)); err != nil {
+			return err
+		}
+	}
+
 	return resourceAMIRead(d, meta)
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -107,6 +108,105 @@ func TestAccEC2AMIFromInstance_disappears(t *testing.T) {
 	})
 }
 
+func TestAccEC2AMIFromInstance_deprecationTime(t *testing.T) {
+	var image ec2.Image
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ami_from_instance.test"
+	deprecateAt := time.Now().UTC().AddDate(0, 0, 30).Truncate(time.Minute).Format(time.RFC3339)
+	deprecateAtUpdated := time.Now().UTC().AddDate(0, 0, 60).Truncate(time.Minute)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAMIFromInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAMIFromInstanceDeprecationTimeConfig(rName, deprecateAt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAMIFromInstanceExists(resourceName, &image),
+					resource.TestCheckResourceAttr(resourceName, "deprecation_time", deprecateAt),
+				),
+			},
+			{
+				Config: testAccAMIFromInstanceDeprecationTimeConfig(rName, deprecateAtUpdated.Format(time.RFC3339)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAMIFromInstanceExists(resourceName, &image),
+					resource.TestCheckResourceAttr(resourceName, "deprecation_time", deprecateAtUpdated.Format(time.RFC3339)),
+				),
+			},
+			{
+				// EC2 drops the seconds, which must not show up as a difference.
+				Config:   testAccAMIFromInstanceDeprecationTimeConfig(rName, deprecateAtUpdated.Add(10*time.Second).Format(time.RFC3339)),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccAMIFromInstanceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAMIFromInstanceExists(resourceName, &image),
+					resource.TestCheckResourceAttr(resourceName, "deprecation_time", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2AMIFromInstance_tagSnapshots(t *testing.T) {
+	var image ec2.Image
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ami_from_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAMIFromInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAMIFromInstanceTagSnapshotsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAMIFromInstanceExists(resourceName, &image),
+					resource.TestCheckResourceAttr(resourceName, "tag_snapshots", "true"),
+					testAccCheckAMIFromInstanceRootSnapshotTag(&image, "Name", rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAMIFromInstanceRootSnapshotTag(image *ec2.Image, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		var snapshotIDs []*string
+		for _, v := range image.BlockDeviceMappings {
+			if v.Ebs != nil && v.Ebs.SnapshotId != nil {
+				snapshotIDs = append(snapshotIDs, v.Ebs.SnapshotId)
+			}
+		}
+
+		if len(snapshotIDs) == 0 {
+			return fmt.Errorf("AMI (%s) has no EBS snapshots", aws.StringValue(image.ImageId))
+		}
+
+		output, err := conn.DescribeSnapshots(&ec2.DescribeSnapshotsInput{
+			SnapshotIds: snapshotIDs,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		for _, snapshot := range output.Snapshots {
+			if got := tfec2.KeyValueTags(snapshot.Tags).KeyValue(key); got == nil || *got != value {
+				return fmt.Errorf("EBS snapshot (%s) tag %q: expected %q", aws.StringValue(snapshot.SnapshotId), key, value)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckAMIFromInstanceExists(resourceName string, image *ec2.Image) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -223,3 +323,33 @@ resource "aws_ami_from_instance" "test" {
 }
 `, rName, tagKey1, tagValue1, tagKey2, tagValue2))
 }
+
+func testAccAMIFromInstanceDeprecationTimeConfig(rName, deprecationTime string) string {
+	return acctest.ConfigCompose(
+		testAccAMIFromInstanceBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_ami_from_instance" "test" {
+  name               = %[1]q
+  description        = "Testing Terraform aws_ami_from_instance resource"
+  source_instance_id = aws_instance.test.id
+  deprecation_time   = %[2]q
+}
+`, rName, deprecationTime))
+}
+
+func testAccAMIFromInstanceTagSnapshotsConfig(rName string) string {
+	return acctest.ConfigCompose(
+		testAccAMIFromInstanceBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_ami_from_instance" "test" {
+  name               = %[1]q
+  description        = "Testing Terraform aws_ami_from_instance resource"
+  source_instance_id = aws_instance.test.id
+  tag_snapshots      = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
@@ -0,0 +1,406 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// There is no constant in the SDK for this resource type
+	ec2ResourceTypeCapacityReservationFleet = "capacity-reservation-fleet"
+
+	// Only the "prioritized" allocation strategy is currently supported
+	capacityReservationFleetAllocationStrategyPrioritized = "prioritized"
+)
+
+func ResourceCapacityReservationFleet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCapacityReservationFleetCreate,
+		Read:   resourceCapacityReservationFleetRead,
+		Update: resourceCapacityReservationFleetUpdate,
+		Delete: resourceCapacityReservationFleetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(CapacityReservationFleetCreatedTimeout),
+			Update: schema.DefaultTimeout(CapacityReservationFleetUpdatedTimeout),
+			Delete: schema.DefaultTimeout(CapacityReservationFleetDeletedTimeout),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"allocation_strategy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  capacityReservationFleetAllocationStrategyPrioritized,
+				ValidateFunc: validation.StringInSlice([]string{
+					capacityReservationFleetAllocationStrategyPrioritized,
+				}, false),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"instance_match_criteria": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      ec2.FleetInstanceMatchCriteriaOpen,
+				ValidateFunc: validation.StringInSlice(ec2.FleetInstanceMatchCriteria_Values(), false),
+			},
+			"instance_type_specification": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"ebs_optimized": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  false,
+						},
+						"instance_platform": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(ec2.CapacityReservationInstancePlatform_Values(), false),
+						},
+						"instance_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"priority": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 999),
+						},
+						"weight": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.FloatBetween(0.001, 999.999),
+						},
+					},
+				},
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"tenancy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      ec2.FleetCapacityReservationTenancyDefault,
+				ValidateFunc: validation.StringInSlice(ec2.FleetCapacityReservationTenancy_Values(), false),
+			},
+			"total_fulfilled_capacity": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"total_target_capacity": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func resourceCapacityReservationFleetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &ec2.CreateCapacityReservationFleetInput{
+		AllocationStrategy:         aws.String(d.Get("allocation_strategy").(string)),
+		ClientToken:                aws.String(resource.UniqueId()),
+		InstanceMatchCriteria:      aws.String(d.Get("instance_match_criteria").(string)),
+		InstanceTypeSpecifications: expandReservationFleetInstanceSpecifications(d.Get("instance_type_specification").(*schema.Set).List()),
+		TagSpecifications:          ec2TagSpecificationsFromKeyValueTags(tags, ec2ResourceTypeCapacityReservationFleet),
+		Tenancy:                    aws.String(d.Get("tenancy").(string)),
+		TotalTargetCapacity:        aws.Int64(int64(d.Get("total_target_capacity").(int))),
+	}
+
+	if v, ok := d.GetOk("end_date"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+
+		input.EndDate = aws.Time(v)
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Capacity Reservation Fleet: %s", input)
+	output, err := conn.CreateCapacityReservationFleet(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Capacity Reservation Fleet: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.CapacityReservationFleetId))
+
+	if _, err := WaitCapacityReservationFleetCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for EC2 Capacity Reservation Fleet (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceCapacityReservationFleetRead(d, meta)
+}
+
+func resourceCapacityReservationFleetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	fleet, err := FindCapacityReservationFleetByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Capacity Reservation Fleet (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Capacity Reservation Fleet (%s): %w", d.Id(), err)
+	}
+
+	d.Set("allocation_strategy", fleet.AllocationStrategy)
+	d.Set("arn", fleet.CapacityReservationFleetArn)
+	if fleet.EndDate != nil {
+		d.Set("end_date", aws.TimeValue(fleet.EndDate).Format(time.RFC3339))
+	} else {
+		d.Set("end_date", nil)
+	}
+	d.Set("instance_match_criteria", fleet.InstanceMatchCriteria)
+	if err := d.Set("instance_type_specification", flattenFleetCapacityReservations(fleet.InstanceTypeSpecifications)); err != nil {
+		return fmt.Errorf("error setting instance_type_specification: %w", err)
+	}
+	d.Set("state", fleet.State)
+	d.Set("tenancy", fleet.Tenancy)
+	d.Set("total_fulfilled_capacity", fleet.TotalFulfilledCapacity)
+	d.Set("total_target_capacity", fleet.TotalTargetCapacity)
+
+	tags := KeyValueTags(fleet.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceCapacityReservationFleetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChanges("end_date", "total_target_capacity") {
+		input := &ec2.ModifyCapacityReservationFleetInput{
+			CapacityReservationFleetId: aws.String(d.Id()),
+			TotalTargetCapacity:        aws.Int64(int64(d.Get("total_target_capacity").(int))),
+		}
+
+		if v, ok := d.GetOk("end_date"); ok {
+			v, _ := time.Parse(time.RFC3339, v.(string))
+
+			input.EndDate = aws.Time(v)
+		} else if d.HasChange("end_date") {
+			input.RemoveEndDate = aws.Bool(true)
+		}
+
+		log.Printf("[DEBUG] Modifying EC2 Capacity Reservation Fleet: %s", input)
+		_, err := conn.ModifyCapacityReservationFleet(input)
+
+		if err != nil {
+			return fmt.Errorf("error modifying EC2 Capacity Reservation Fleet (%s): %w", d.Id(), err)
+		}
+
+		if _, err := WaitCapacityReservationFleetUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for EC2 Capacity Reservation Fleet (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating EC2 Capacity Reservation Fleet (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceCapacityReservationFleetRead(d, meta)
+}
+
+func resourceCapacityReservationFleetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	log.Printf("[DEBUG] Cancelling EC2 Capacity Reservation Fleet: %s", d.Id())
+	output, err := conn.CancelCapacityReservationFleets(&ec2.CancelCapacityReservationFleetsInput{
+		CapacityReservationFleetIds: aws.StringSlice([]string{d.Id()}),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidCapacityReservationFleetIDNotFound) {
+		return nil
+	}
+
+	if err == nil && output != nil && len(output.FailedFleetCancellations) > 0 && output.FailedFleetCancellations[0] != nil {
+		if v := output.FailedFleetCancellations[0].CancelCapacityReservationFleetError; v != nil {
+			err = fmt.Errorf("%s: %s", aws.StringValue(v.Code), aws.StringValue(v.Message))
+		}
+	}
+
+	if err != nil {
+		return fmt.Errorf("error cancelling EC2 Capacity Reservation Fleet (%s): %w", d.Id(), err)
+	}
+
+	if _, err := WaitCapacityReservationFleetDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for EC2 Capacity Reservation Fleet (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandReservationFleetInstanceSpecification(tfMap map[string]interface{}) *ec2.ReservationFleetInstanceSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.ReservationFleetInstanceSpecification{}
+
+	if v, ok := tfMap["availability_zone"].(string); ok && v != "" {
+		apiObject.AvailabilityZone = aws.String(v)
+	}
+
+	if v, ok := tfMap["ebs_optimized"].(bool); ok {
+		apiObject.EbsOptimized = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["instance_platform"].(string); ok && v != "" {
+		apiObject.InstancePlatform = aws.String(v)
+	}
+
+	if v, ok := tfMap["instance_type"].(string); ok && v != "" {
+		apiObject.InstanceType = aws.String(v)
+	}
+
+	if v, ok := tfMap["priority"].(int); ok {
+		apiObject.Priority = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["weight"].(float64); ok && v != 0 {
+		apiObject.Weight = aws.Float64(v)
+	}
+
+	return apiObject
+}
+
+func expandReservationFleetInstanceSpecifications(tfList []interface{}) []*ec2.ReservationFleetInstanceSpecification {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*ec2.ReservationFleetInstanceSpecification
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandReservationFleetInstanceSpecification(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenFleetCapacityReservation(apiObject *ec2.FleetCapacityReservation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AvailabilityZone; v != nil {
+		tfMap["availability_zone"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.EbsOptimized; v != nil {
+		tfMap["ebs_optimized"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.InstancePlatform; v != nil {
+		tfMap["instance_platform"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.InstanceType; v != nil {
+		tfMap["instance_type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Priority; v != nil {
+		tfMap["priority"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Weight; v != nil {
+		tfMap["weight"] = aws.Float64Value(v)
+	}
+
+	return tfMap
+}
+
+func flattenFleetCapacityReservations(apiObjects []*ec2.FleetCapacityReservation) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenFleetCapacityReservation(apiObject))
+	}
+
+	return tfList
+}
@@ -0,0 +1,152 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceEIPDomainName() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEIPDomainNameCreate,
+		Read:   resourceEIPDomainNameRead,
+		Update: resourceEIPDomainNameUpdate,
+		Delete: resourceEIPDomainNameDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(EIPDomainNameAttributeCreatedTimeout),
+			Update: schema.DefaultTimeout(EIPDomainNameAttributeUpdatedTimeout),
+			Delete: schema.DefaultTimeout(EIPDomainNameAttributeDeletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allocation_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+				StateFunc: func(v interface{}) string {
+					return strings.TrimSuffix(v.(string), ".")
+				},
+			},
+			"ptr_record": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceEIPDomainNameCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	allocationID := d.Get("allocation_id").(string)
+	input := &ec2.ModifyAddressAttributeInput{
+		AllocationId: aws.String(allocationID),
+		DomainName:   aws.String(d.Get("domain_name").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating EC2 EIP domain name: %s", input)
+	_, err := conn.ModifyAddressAttribute(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EC2 EIP (%s) domain name: %w", allocationID, err)
+	}
+
+	d.SetId(allocationID)
+
+	if _, err := WaitEIPDomainNameAttributeUpdated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for EC2 EIP (%s) domain name create: %w", d.Id(), err)
+	}
+
+	return resourceEIPDomainNameRead(d, meta)
+}
+
+func resourceEIPDomainNameRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	output, err := FindEIPDomainNameAttributeByAllocationID(conn, d.Id())
+
+	if err == nil && aws.StringValue(output.PtrRecord) == "" {
+		err = tfresource.NewEmptyResultError(d.Id())
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 EIP domain name (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 EIP (%s) domain name: %w", d.Id(), err)
+	}
+
+	d.Set("allocation_id", output.AllocationId)
+	d.Set("domain_name", strings.TrimSuffix(aws.StringValue(output.PtrRecord), "."))
+	d.Set("ptr_record", output.PtrRecord)
+
+	return nil
+}
+
+func resourceEIPDomainNameUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChange("domain_name") {
+		input := &ec2.ModifyAddressAttributeInput{
+			AllocationId: aws.String(d.Id()),
+			DomainName:   aws.String(d.Get("domain_name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating EC2 EIP domain name: %s", input)
+		_, err := conn.ModifyAddressAttribute(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating EC2 EIP (%s) domain name: %w", d.Id(), err)
+		}
+
+		if _, err := WaitEIPDomainNameAttributeUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for EC2 EIP (%s) domain name update: %w", d.Id(), err)
+		}
+	}
+
+	return resourceEIPDomainNameRead(d, meta)
+}
+
+func resourceEIPDomainNameDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	log.Printf("[DEBUG] Deleting EC2 EIP domain name: %s", d.Id())
+	_, err := conn.ResetAddressAttribute(&ec2.ResetAddressAttributeInput{
+		AllocationId: aws.String(d.Id()),
+		Attribute:    aws.String(ec2.AddressAttributeNameDomainName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidAllocationIDNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 EIP (%s) domain name: %w", d.Id(), err)
+	}
+
+	if _, err := WaitEIPDomainNameAttributeDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for EC2 EIP (%s) domain name delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
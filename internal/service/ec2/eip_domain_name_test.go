@@ -0,0 +1,142 @@
+package ec2_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEC2EIPDomainName_basic(t *testing.T) {
+	key := "AWS_EC2_EIP_PUBLIC_DNS_ZONE"
+	zoneName := os.Getenv(key)
+	if zoneName == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eip_domain_name.test"
+	eipResourceName := "aws_eip.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEIPDomainNameDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEIPDomainNameConfig(rName, zoneName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEIPDomainNameExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "allocation_id", eipResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_name", "aws_route53_record.test", "fqdn"),
+					resource.TestCheckResourceAttrSet(resourceName, "ptr_record"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEIPDomainNameConfig(rName, zoneName, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEIPDomainNameExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_name", "aws_route53_record.test", "fqdn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEIPDomainNameExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 EIP domain name ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindEIPDomainNameAttributeByAllocationID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.PtrRecord) == "" {
+			return fmt.Errorf("EC2 EIP (%s) has no domain name", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEIPDomainNameDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_eip_domain_name" {
+			continue
+		}
+
+		output, err := tfec2.FindEIPDomainNameAttributeByAllocationID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.PtrRecord) != "" {
+			return fmt.Errorf("EC2 EIP (%s) domain name still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccEIPDomainNameConfig(rName, zoneName, subdomain string) string {
+	return fmt.Sprintf(`
+data "aws_route53_zone" "test" {
+  name         = %[2]q
+  private_zone = false
+}
+
+resource "aws_eip" "test" {
+  vpc = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route53_record" "test" {
+  zone_id = data.aws_route53_zone.test.zone_id
+  name    = "%[3]s.${data.aws_route53_zone.test.name}"
+  type    = "A"
+  ttl     = "5"
+  records = [aws_eip.test.public_ip]
+}
+
+resource "aws_eip_domain_name" "test" {
+  allocation_id = aws_eip.test.allocation_id
+  domain_name   = aws_route53_record.test.fqdn
+}
+`, rName, zoneName, subdomain)
+}
@@ -12,6 +12,7 @@ import (
 const (
 	ErrCodeDependencyViolation          = "DependencyViolation"
 	ErrCodeGatewayNotAttached           = "Gateway.NotAttached"
+	ErrCodeIncorrectState               = "IncorrectState"
 	ErrCodeInvalidAssociationIDNotFound = "InvalidAssociationID.NotFound"
 	ErrCodeInvalidAttachmentIDNotFound  = "InvalidAttachmentID.NotFound"
 	ErrCodeInvalidKeyPairNotFound       = "InvalidKeyPair.NotFound"
@@ -20,6 +21,14 @@ const (
 	ErrCodeInvalidParameterValue        = "InvalidParameterValue"
 )
 
+const (
+	ErrCodeInvalidCapacityReservationFleetIDNotFound = "InvalidCapacityReservationFleetId.NotFound"
+)
+
+const (
+	ErrCodeInvalidAllocationIDNotFound = "InvalidAllocationID.NotFound"
+)
+
 const (
 	ErrCodeInvalidCarrierGatewayIDNotFound = "InvalidCarrierGatewayID.NotFound"
 )
@@ -39,6 +48,7 @@ const (
 
 const (
 	ErrCodeInvalidPrefixListIDNotFound = "InvalidPrefixListID.NotFound"
+	ErrCodePrefixListVersionMismatch   = "PrefixListVersionMismatch"
 )
 
 const (
@@ -48,7 +58,9 @@ const (
 )
 
 const (
-	ErrCodeInvalidTransitGatewayIDNotFound = "InvalidTransitGatewayID.NotFound"
+	ErrCodeInvalidTransitGatewayAttachmentIDNotFound  = "InvalidTransitGatewayAttachmentID.NotFound"
+	ErrCodeInvalidTransitGatewayConnectPeerIDNotFound = "InvalidTransitGatewayConnectPeerID.NotFound"
+	ErrCodeInvalidTransitGatewayIDNotFound            = "InvalidTransitGatewayID.NotFound"
 )
 
 const (
@@ -72,8 +84,9 @@ const (
 )
 
 const (
-	ErrCodeInvalidSubnetIdNotFound = "InvalidSubnetId.NotFound"
-	ErrCodeInvalidSubnetIDNotFound = "InvalidSubnetID.NotFound"
+	ErrCodeInvalidSubnetIdNotFound                = "InvalidSubnetId.NotFound"
+	ErrCodeInvalidSubnetIDNotFound                = "InvalidSubnetID.NotFound"
+	ErrCodeInvalidSubnetCidrReservationIDNotFound = "InvalidSubnetCidrReservationID.NotFound"
 )
 
 const (
@@ -109,6 +122,11 @@ const (
 	ErrCodeInvalidPlacementGroupUnknown = "InvalidPlacementGroup.Unknown"
 )
 
+const (
+	ErrCodeInvalidNetworkInsightsAnalysisIdNotFound = "InvalidNetworkInsightsAnalysisId.NotFound"
+	ErrCodeInvalidNetworkInsightsPathIdNotFound     = "InvalidNetworkInsightsPathId.NotFound"
+)
+
 func UnsuccessfulItemError(apiObject *ec2.UnsuccessfulItemError) error {
 	if apiObject == nil {
 		return nil
@@ -51,6 +51,26 @@ func DataSourceHost() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"owner_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -95,6 +115,9 @@ func dataSourceHostRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("host_recovery", host.HostRecovery)
 	d.Set("instance_family", host.HostProperties.InstanceFamily)
 	d.Set("instance_type", host.HostProperties.InstanceType)
+	if err := d.Set("instances", flattenHostInstances(host.Instances)); err != nil {
+		return fmt.Errorf("error setting instances: %w", err)
+	}
 	d.Set("owner_id", host.OwnerId)
 	d.Set("sockets", host.HostProperties.Sockets)
 	d.Set("total_vcpus", host.HostProperties.TotalVCpus)
@@ -105,3 +128,43 @@ func dataSourceHostRead(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+func flattenHostInstance(apiObject *ec2.HostInstance) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.InstanceId; v != nil {
+		tfMap["instance_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.InstanceType; v != nil {
+		tfMap["instance_type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.OwnerId; v != nil {
+		tfMap["owner_id"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenHostInstances(apiObjects []*ec2.HostInstance) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenHostInstance(apiObject))
+	}
+
+	return tfList
+}
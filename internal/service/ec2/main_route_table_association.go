@@ -17,6 +17,9 @@ func ResourceMainRouteTableAssociation() *schema.Resource {
 		Read:   resourceMainRouteTableAssociationRead,
 		Update: resourceMainRouteTableAssociationUpdate,
 		Delete: resourceMainRouteTableAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceMainRouteTableAssociationImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			// We use this field to record the main route table that is automatically
@@ -80,7 +83,17 @@ func resourceMainRouteTableAssociationCreate(d *schema.ResourceData, meta interf
 func resourceMainRouteTableAssociationRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).EC2Conn
 
-	_, err := FindMainRouteTableAssociationByID(conn, d.Id())
+	association, err := FindMainRouteTableAssociationByID(conn, d.Id())
+
+	// Changing the VPC's main route table outside Terraform replaces the association.
+	// Follow the VPC's current main route table association so that the change shows up as a diff.
+	if tfresource.NotFound(err) {
+		association, err = FindMainRouteTableAssociationByVPCID(conn, d.Get("vpc_id").(string))
+
+		if err == nil {
+			d.SetId(aws.StringValue(association.RouteTableAssociationId))
+		}
+	}
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Main Route Table Association (%s) not found, removing from state", d.Id())
@@ -92,6 +105,8 @@ func resourceMainRouteTableAssociationRead(d *schema.ResourceData, meta interfac
 		return fmt.Errorf("error reading Main Route Table Association (%s): %w", d.Id(), err)
 	}
 
+	d.Set("route_table_id", association.RouteTableId)
+
 	return nil
 }
 
@@ -145,3 +160,23 @@ func resourceMainRouteTableAssociationDelete(d *schema.ResourceData, meta interf
 
 	return nil
 }
+
+// resourceMainRouteTableAssociationImport imports the Main Route Table Association of a VPC by VPC ID.
+// The route table that is main at the time of import is recorded as the original route table,
+// so that destroying the resource leaves the VPC's main route table unchanged.
+func resourceMainRouteTableAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	vpcID := d.Id()
+	association, err := FindMainRouteTableAssociationByVPCID(conn, vpcID)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading Main Route Table Association (%s): %w", vpcID, err)
+	}
+
+	d.SetId(aws.StringValue(association.RouteTableAssociationId))
+	d.Set("original_route_table_id", association.RouteTableId)
+	d.Set("vpc_id", vpcID)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -35,12 +36,61 @@ func TestAccEC2MainRouteTableAssociation_basic(t *testing.T) {
 				Config: testAccMainRouteTableAssociationConfigUpdated(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckMainRouteTableAssociationExists(resourceName, &rta),
+					resource.TestCheckResourceAttrPair(resourceName, "route_table_id", "aws_route_table.test2", "id"),
 				),
 			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccMainRouteTableAssociationImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"original_route_table_id"},
+			},
 		},
 	})
 }
 
+func TestAccEC2MainRouteTableAssociation_mainRouteTableChanged(t *testing.T) {
+	var rta ec2.RouteTableAssociation
+	resourceName := "aws_main_route_table_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckMainRouteTableAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMainRouteTableAssociationConfigUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMainRouteTableAssociationExists(resourceName, &rta),
+					testAccCheckMainRouteTableAssociationReplace(&rta, "aws_route_table.test"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccMainRouteTableAssociationConfigUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMainRouteTableAssociationExists(resourceName, &rta),
+					resource.TestCheckResourceAttrPair(resourceName, "route_table_id", "aws_route_table.test2", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMainRouteTableAssociationImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["vpc_id"], nil
+	}
+}
+
 func testAccCheckMainRouteTableAssociationDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
 
@@ -90,6 +140,31 @@ func testAccCheckMainRouteTableAssociationExists(n string, v *ec2.RouteTableAsso
 	}
 }
 
+// testAccCheckMainRouteTableAssociationReplace makes the specified route table the VPC's main route table outside Terraform.
+func testAccCheckMainRouteTableAssociationReplace(v *ec2.RouteTableAssociation, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := conn.ReplaceRouteTableAssociation(&ec2.ReplaceRouteTableAssociationInput{
+			AssociationId: v.RouteTableAssociationId,
+			RouteTableId:  aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfec2.WaitRouteTableAssociationUpdated(conn, aws.StringValue(output.NewAssociationId))
+
+		return err
+	}
+}
+
 func testAccMainRouteTableAssociationConfigBaseVPC(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
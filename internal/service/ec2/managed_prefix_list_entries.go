@@ -0,0 +1,280 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// ModifyManagedPrefixList accepts at most 100 entries to add and 100 entries to remove per call.
+	managedPrefixListEntriesMaxBatchSize = 100
+)
+
+func ResourceManagedPrefixListEntries() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceManagedPrefixListEntriesCreate,
+		Read:   resourceManagedPrefixListEntriesRead,
+		Update: resourceManagedPrefixListEntriesUpdate,
+		Delete: resourceManagedPrefixListEntriesDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceManagedPrefixListEntriesImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(ManagedPrefixListTimeout),
+			Update: schema.DefaultTimeout(ManagedPrefixListTimeout),
+			Delete: schema.DefaultTimeout(ManagedPrefixListTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"entry": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsCIDR,
+						},
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 255),
+						},
+					},
+				},
+			},
+			"prefix_list_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceManagedPrefixListEntriesCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	plID := d.Get("prefix_list_id").(string)
+
+	if err := syncManagedPrefixListEntries(conn, plID, d.Get("entry").(*schema.Set).List(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error creating EC2 Managed Prefix List (%s) Entries: %w", plID, err)
+	}
+
+	d.SetId(plID)
+
+	return resourceManagedPrefixListEntriesRead(d, meta)
+}
+
+func resourceManagedPrefixListEntriesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	pl, err := FindManagedPrefixListByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Managed Prefix List (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Managed Prefix List (%s): %w", d.Id(), err)
+	}
+
+	prefixListEntries, err := FindManagedPrefixListEntriesByID(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Managed Prefix List (%s) Entries: %w", d.Id(), err)
+	}
+
+	if err := d.Set("entry", flattenEc2PrefixListEntries(prefixListEntries)); err != nil {
+		return fmt.Errorf("error setting entry: %w", err)
+	}
+
+	d.Set("prefix_list_id", pl.PrefixListId)
+	d.Set("version", pl.Version)
+
+	return nil
+}
+
+func resourceManagedPrefixListEntriesUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChange("entry") {
+		if err := syncManagedPrefixListEntries(conn, d.Id(), d.Get("entry").(*schema.Set).List(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error updating EC2 Managed Prefix List (%s) Entries: %w", d.Id(), err)
+		}
+	}
+
+	return resourceManagedPrefixListEntriesRead(d, meta)
+}
+
+func resourceManagedPrefixListEntriesDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	log.Printf("[INFO] Deleting EC2 Managed Prefix List (%s) Entries", d.Id())
+	err := syncManagedPrefixListEntries(conn, d.Id(), nil, d.Timeout(schema.TimeoutDelete))
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Managed Prefix List (%s) Entries: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceManagedPrefixListEntriesImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("prefix_list_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// syncManagedPrefixListEntries makes the prefix list's entries match the desired entries.
+// Additions and removals are sent together, in as few ModifyManagedPrefixList calls as the API's batch limits allow.
+func syncManagedPrefixListEntries(conn *ec2.EC2, id string, tfList []interface{}, timeout time.Duration) error {
+	prefixListEntries, err := FindManagedPrefixListEntriesByID(conn, id)
+
+	if err != nil {
+		return err
+	}
+
+	current := make(map[string]string, len(prefixListEntries))
+	for _, v := range prefixListEntries {
+		current[aws.StringValue(v.Cidr)] = aws.StringValue(v.Description)
+	}
+
+	desired := make(map[string]string, len(tfList))
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		desired[tfMap["cidr"].(string)] = tfMap["description"].(string)
+	}
+
+	var addEntries []*ec2.AddPrefixListEntry
+	var removeEntries []*ec2.RemovePrefixListEntry
+	// A CIDR cannot be both added and removed in the same request,
+	// so description-only changes are removed first and then added back.
+	var descriptionOnlyRemovals []*ec2.RemovePrefixListEntry
+
+	for cidr, description := range desired {
+		currentDescription, ok := current[cidr]
+
+		if ok && currentDescription == description {
+			continue
+		}
+
+		if ok {
+			descriptionOnlyRemovals = append(descriptionOnlyRemovals, &ec2.RemovePrefixListEntry{Cidr: aws.String(cidr)})
+		}
+
+		apiObject := &ec2.AddPrefixListEntry{Cidr: aws.String(cidr)}
+
+		if description != "" {
+			apiObject.Description = aws.String(description)
+		}
+
+		addEntries = append(addEntries, apiObject)
+	}
+
+	for cidr := range current {
+		if _, ok := desired[cidr]; !ok {
+			removeEntries = append(removeEntries, &ec2.RemovePrefixListEntry{Cidr: aws.String(cidr)})
+		}
+	}
+
+	for len(descriptionOnlyRemovals) > 0 {
+		n := len(descriptionOnlyRemovals)
+		if n > managedPrefixListEntriesMaxBatchSize {
+			n = managedPrefixListEntriesMaxBatchSize
+		}
+
+		if err := modifyManagedPrefixListEntries(conn, id, nil, descriptionOnlyRemovals[:n], timeout); err != nil {
+			return err
+		}
+
+		descriptionOnlyRemovals = descriptionOnlyRemovals[n:]
+	}
+
+	for len(addEntries) > 0 || len(removeEntries) > 0 {
+		nAdd, nRemove := len(addEntries), len(removeEntries)
+		if nAdd > managedPrefixListEntriesMaxBatchSize {
+			nAdd = managedPrefixListEntriesMaxBatchSize
+		}
+		if nRemove > managedPrefixListEntriesMaxBatchSize {
+			nRemove = managedPrefixListEntriesMaxBatchSize
+		}
+
+		// Removals go first so that a prefix list at its maximum size can still be changed.
+		if nRemove > 0 && len(removeEntries) > nRemove {
+			nAdd = 0
+		}
+
+		if err := modifyManagedPrefixListEntries(conn, id, addEntries[:nAdd], removeEntries[:nRemove], timeout); err != nil {
+			return err
+		}
+
+		addEntries, removeEntries = addEntries[nAdd:], removeEntries[nRemove:]
+	}
+
+	return nil
+}
+
+// modifyManagedPrefixListEntries issues a single ModifyManagedPrefixList call against the prefix list's current version.
+// The call is retried while another modification is in progress or the version has moved on.
+func modifyManagedPrefixListEntries(conn *ec2.EC2, id string, addEntries []*ec2.AddPrefixListEntry, removeEntries []*ec2.RemovePrefixListEntry, timeout time.Duration) error {
+	input := &ec2.ModifyManagedPrefixListInput{
+		PrefixListId: aws.String(id),
+	}
+
+	if len(addEntries) > 0 {
+		input.AddEntries = addEntries
+	}
+
+	if len(removeEntries) > 0 {
+		input.RemoveEntries = removeEntries
+	}
+
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(timeout, func() (interface{}, error) {
+		pl, err := FindManagedPrefixListByID(conn, id)
+
+		if err != nil {
+			return nil, err
+		}
+
+		input.CurrentVersion = pl.Version
+
+		log.Printf("[DEBUG] Modifying EC2 Managed Prefix List: %s", input)
+		return conn.ModifyManagedPrefixList(input)
+	}, ErrCodeIncorrectState, ErrCodePrefixListVersionMismatch)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := WaitManagedPrefixListModified(conn, id); err != nil {
+		return fmt.Errorf("error waiting for EC2 Managed Prefix List (%s) update: %w", id, err)
+	}
+
+	return nil
+}
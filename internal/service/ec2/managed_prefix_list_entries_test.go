@@ -0,0 +1,216 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEC2ManagedPrefixListEntries_basic(t *testing.T) {
+	resourceName := "aws_ec2_managed_prefix_list_entries.test"
+	plResourceName := "aws_ec2_managed_prefix_list.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckEc2ManagedPrefixList(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckManagedPrefixListEntriesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagedPrefixListEntriesConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckManagedPrefixListEntriesCount(resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "entry.*", map[string]string{
+						"cidr":        "10.0.0.0/8",
+						"description": "",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "entry.*", map[string]string{
+						"cidr":        "192.168.0.0/16",
+						"description": "Test",
+					}),
+					resource.TestCheckResourceAttrPair(resourceName, "prefix_list_id", plResourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "version"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccManagedPrefixListEntriesUpdatedConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckManagedPrefixListEntriesCount(resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "entry.*", map[string]string{
+						"cidr":        "172.16.0.0/12",
+						"description": "",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "entry.*", map[string]string{
+						"cidr":        "192.168.0.0/16",
+						"description": "Updated",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2ManagedPrefixListEntries_batches(t *testing.T) {
+	resourceName := "aws_ec2_managed_prefix_list_entries.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckEc2ManagedPrefixList(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckManagedPrefixListEntriesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagedPrefixListEntriesCountConfig(rName, 250),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckManagedPrefixListEntriesCount(resourceName, 250),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "250"),
+				),
+			},
+			{
+				Config: testAccManagedPrefixListEntriesCountConfig(rName, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckManagedPrefixListEntriesCount(resourceName, 20),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "20"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckManagedPrefixListEntriesDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_managed_prefix_list_entries" {
+			continue
+		}
+
+		entries, err := tfec2.FindManagedPrefixListEntriesByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if len(entries) > 0 {
+			return fmt.Errorf("EC2 Managed Prefix List %s still has %d entries", rs.Primary.ID, len(entries))
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckManagedPrefixListEntriesCount(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Managed Prefix List ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		entries, err := tfec2.FindManagedPrefixListEntriesByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(entries); got != count {
+			return fmt.Errorf("EC2 Managed Prefix List %s has %d entries, expected %d", rs.Primary.ID, got, count)
+		}
+
+		return nil
+	}
+}
+
+func testAccManagedPrefixListEntriesConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list" "test" {
+  name           = %[1]q
+  address_family = "IPv4"
+  max_entries    = 5
+}
+
+resource "aws_ec2_managed_prefix_list_entries" "test" {
+  prefix_list_id = aws_ec2_managed_prefix_list.test.id
+
+  entry {
+    cidr = "10.0.0.0/8"
+  }
+
+  entry {
+    cidr        = "192.168.0.0/16"
+    description = "Test"
+  }
+}
+`, rName)
+}
+
+func testAccManagedPrefixListEntriesUpdatedConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list" "test" {
+  name           = %[1]q
+  address_family = "IPv4"
+  max_entries    = 5
+}
+
+resource "aws_ec2_managed_prefix_list_entries" "test" {
+  prefix_list_id = aws_ec2_managed_prefix_list.test.id
+
+  entry {
+    cidr = "172.16.0.0/12"
+  }
+
+  entry {
+    cidr        = "192.168.0.0/16"
+    description = "Updated"
+  }
+}
+`, rName)
+}
+
+func testAccManagedPrefixListEntriesCountConfig(rName string, count int) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list" "test" {
+  name           = %[1]q
+  address_family = "IPv4"
+  max_entries    = 250
+}
+
+resource "aws_ec2_managed_prefix_list_entries" "test" {
+  prefix_list_id = aws_ec2_managed_prefix_list.test.id
+
+  dynamic "entry" {
+    for_each = range(%[2]d)
+
+    content {
+      cidr = cidrsubnet("10.0.0.0/8", 8, entry.value)
+    }
+  }
+}
+`, rName, count)
+}
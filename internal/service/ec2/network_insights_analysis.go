@@ -0,0 +1,380 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceNetworkInsightsAnalysis() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkInsightsAnalysisCreate,
+		Read:   resourceNetworkInsightsAnalysisRead,
+		Update: resourceNetworkInsightsAnalysisUpdate,
+		Delete: resourceNetworkInsightsAnalysisDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("wait_for_completion", true)
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(NetworkInsightsAnalysisCreatedTimeout),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"alternate_path_hints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"component_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"component_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"explanations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"component_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"component_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"direction": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"explanation_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"missing_component": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"filter_in_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"forward_path_components": networkInsightsAnalysisPathComponentsSchema(),
+			"network_insights_path_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"path_found": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"return_path_components": networkInsightsAnalysisPathComponentsSchema(),
+			"start_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func networkInsightsAnalysisPathComponentsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"component_arn": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"component_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"sequence_number": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"subnet_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"vpc_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceNetworkInsightsAnalysisCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &ec2.StartNetworkInsightsAnalysisInput{
+		ClientToken:           aws.String(resource.UniqueId()),
+		NetworkInsightsPathId: aws.String(d.Get("network_insights_path_id").(string)),
+		TagSpecifications:     ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeNetworkInsightsAnalysis),
+	}
+
+	if v, ok := d.GetOk("filter_in_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.FilterInArns = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Starting EC2 Network Insights Analysis: %s", input)
+	output, err := conn.StartNetworkInsightsAnalysis(input)
+
+	if err != nil {
+		return fmt.Errorf("error starting EC2 Network Insights Analysis: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.NetworkInsightsAnalysis.NetworkInsightsAnalysisId))
+
+	if d.Get("wait_for_completion").(bool) {
+		if _, err := WaitNetworkInsightsAnalysisCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error waiting for EC2 Network Insights Analysis (%s) create: %w", d.Id(), err)
+		}
+	}
+
+	return resourceNetworkInsightsAnalysisRead(d, meta)
+}
+
+func resourceNetworkInsightsAnalysisRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(PropagationTimeout, func() (interface{}, error) {
+		return FindNetworkInsightsAnalysisByID(conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Network Insights Analysis (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Network Insights Analysis (%s): %w", d.Id(), err)
+	}
+
+	analysis := outputRaw.(*ec2.NetworkInsightsAnalysis)
+
+	if err := d.Set("alternate_path_hints", flattenAlternatePathHints(analysis.AlternatePathHints)); err != nil {
+		return fmt.Errorf("error setting alternate_path_hints: %w", err)
+	}
+	d.Set("arn", analysis.NetworkInsightsAnalysisArn)
+	if err := d.Set("explanations", flattenExplanations(analysis.Explanations)); err != nil {
+		return fmt.Errorf("error setting explanations: %w", err)
+	}
+	d.Set("filter_in_arns", aws.StringValueSlice(analysis.FilterInArns))
+	if err := d.Set("forward_path_components", flattenPathComponents(analysis.ForwardPathComponents)); err != nil {
+		return fmt.Errorf("error setting forward_path_components: %w", err)
+	}
+	d.Set("network_insights_path_id", analysis.NetworkInsightsPathId)
+	d.Set("path_found", analysis.NetworkPathFound)
+	if err := d.Set("return_path_components", flattenPathComponents(analysis.ReturnPathComponents)); err != nil {
+		return fmt.Errorf("error setting return_path_components: %w", err)
+	}
+	if analysis.StartDate != nil {
+		d.Set("start_date", aws.TimeValue(analysis.StartDate).Format(time.RFC3339))
+	} else {
+		d.Set("start_date", nil)
+	}
+	d.Set("status", analysis.Status)
+	d.Set("status_message", analysis.StatusMessage)
+
+	tags := KeyValueTags(analysis.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceNetworkInsightsAnalysisUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating EC2 Network Insights Analysis (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceNetworkInsightsAnalysisRead(d, meta)
+}
+
+func resourceNetworkInsightsAnalysisDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	log.Printf("[INFO] Deleting EC2 Network Insights Analysis: %s", d.Id())
+	_, err := conn.DeleteNetworkInsightsAnalysis(&ec2.DeleteNetworkInsightsAnalysisInput{
+		NetworkInsightsAnalysisId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidNetworkInsightsAnalysisIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Network Insights Analysis (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func flattenAlternatePathHints(apiObjects []*ec2.AlternatePathHint) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"component_arn": aws.StringValue(apiObject.ComponentArn),
+			"component_id":  aws.StringValue(apiObject.ComponentId),
+		})
+	}
+
+	return tfList
+}
+
+func flattenExplanations(apiObjects []*ec2.Explanation) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"direction":         aws.StringValue(apiObject.Direction),
+			"explanation_code":  aws.StringValue(apiObject.ExplanationCode),
+			"missing_component": aws.StringValue(apiObject.MissingComponent),
+			"state":             aws.StringValue(apiObject.State),
+		}
+
+		if v := apiObject.Component; v != nil {
+			tfMap["component_arn"] = aws.StringValue(v.Arn)
+			tfMap["component_id"] = aws.StringValue(v.Id)
+		}
+
+		if v := apiObject.Subnet; v != nil {
+			tfMap["subnet_id"] = aws.StringValue(v.Id)
+		}
+
+		if v := apiObject.Vpc; v != nil {
+			tfMap["vpc_id"] = aws.StringValue(v.Id)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenPathComponents(apiObjects []*ec2.PathComponent) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"sequence_number": aws.Int64Value(apiObject.SequenceNumber),
+		}
+
+		if v := apiObject.Component; v != nil {
+			tfMap["component_arn"] = aws.StringValue(v.Arn)
+			tfMap["component_id"] = aws.StringValue(v.Id)
+		}
+
+		if v := apiObject.Subnet; v != nil {
+			tfMap["subnet_id"] = aws.StringValue(v.Id)
+		}
+
+		if v := apiObject.Vpc; v != nil {
+			tfMap["vpc_id"] = aws.StringValue(v.Id)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
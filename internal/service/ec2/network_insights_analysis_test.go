@@ -0,0 +1,256 @@
+package ec2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEC2NetworkInsightsAnalysis_basic(t *testing.T) {
+	var v ec2.NetworkInsightsAnalysis
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_network_insights_analysis.test"
+	pathResourceName := "aws_ec2_network_insights_path.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckNetworkInsightsAnalysisDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkInsightsAnalysisConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAnalysisExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexp.MustCompile(`network-insights-analysis/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "filter_in_arns.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "forward_path_components.#"),
+					resource.TestCheckResourceAttrPair(resourceName, "network_insights_path_id", pathResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "path_found", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "start_date"),
+					resource.TestCheckResourceAttr(resourceName, "status", ec2.AnalysisStatusSucceeded),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2NetworkInsightsAnalysis_disappears(t *testing.T) {
+	var v ec2.NetworkInsightsAnalysis
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_network_insights_analysis.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckNetworkInsightsAnalysisDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkInsightsAnalysisConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAnalysisExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceNetworkInsightsAnalysis(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2NetworkInsightsAnalysis_waitForCompletion(t *testing.T) {
+	var v ec2.NetworkInsightsAnalysis
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_network_insights_analysis.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckNetworkInsightsAnalysisDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkInsightsAnalysisWaitForCompletionConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAnalysisExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "status", ec2.AnalysisStatusRunning),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", "false"),
+				),
+			},
+			{
+				Config: testAccNetworkInsightsAnalysisWaitForCompletionConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAnalysisExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "status", ec2.AnalysisStatusSucceeded),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2NetworkInsightsAnalysis_tags(t *testing.T) {
+	var v ec2.NetworkInsightsAnalysis
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_network_insights_analysis.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckNetworkInsightsAnalysisDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkInsightsAnalysisTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAnalysisExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccNetworkInsightsAnalysisTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAnalysisExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccNetworkInsightsAnalysisTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAnalysisExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkInsightsAnalysisDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_network_insights_analysis" {
+			continue
+		}
+
+		_, err := tfec2.FindNetworkInsightsAnalysisByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 Network Insights Analysis %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckNetworkInsightsAnalysisExists(n string, v *ec2.NetworkInsightsAnalysis) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Network Insights Analysis ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindNetworkInsightsAnalysisByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccNetworkInsightsAnalysisBaseConfig(rName string) string {
+	return acctest.ConfigCompose(testAccNetworkInsightsPathBaseConfig(rName), fmt.Sprintf(`
+resource "aws_ec2_network_insights_path" "test" {
+  source      = aws_network_interface.test[0].id
+  destination = aws_network_interface.test[1].id
+  protocol    = "tcp"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccNetworkInsightsAnalysisConfig(rName string) string {
+	return acctest.ConfigCompose(testAccNetworkInsightsAnalysisBaseConfig(rName), `
+resource "aws_ec2_network_insights_analysis" "test" {
+  network_insights_path_id = aws_ec2_network_insights_path.test.id
+}
+`)
+}
+
+func testAccNetworkInsightsAnalysisWaitForCompletionConfig(rName string, waitForCompletion bool) string {
+	return acctest.ConfigCompose(testAccNetworkInsightsAnalysisBaseConfig(rName), fmt.Sprintf(`
+resource "aws_ec2_network_insights_analysis" "test" {
+  network_insights_path_id = aws_ec2_network_insights_path.test.id
+  wait_for_completion      = %[1]t
+}
+`, waitForCompletion))
+}
+
+func testAccNetworkInsightsAnalysisTags1Config(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccNetworkInsightsAnalysisBaseConfig(rName), fmt.Sprintf(`
+resource "aws_ec2_network_insights_analysis" "test" {
+  network_insights_path_id = aws_ec2_network_insights_path.test.id
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccNetworkInsightsAnalysisTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccNetworkInsightsAnalysisBaseConfig(rName), fmt.Sprintf(`
+resource "aws_ec2_network_insights_analysis" "test" {
+  network_insights_path_id = aws_ec2_network_insights_path.test.id
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,187 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceNetworkInsightsPath() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkInsightsPathCreate,
+		Read:   resourceNetworkInsightsPathRead,
+		Update: resourceNetworkInsightsPathUpdate,
+		Delete: resourceNetworkInsightsPathDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"destination": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"destination_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"destination_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ec2.Protocol_Values(), false),
+			},
+			"source": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceNetworkInsightsPathCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &ec2.CreateNetworkInsightsPathInput{
+		ClientToken:       aws.String(resource.UniqueId()),
+		Destination:       aws.String(d.Get("destination").(string)),
+		Protocol:          aws.String(d.Get("protocol").(string)),
+		Source:            aws.String(d.Get("source").(string)),
+		TagSpecifications: ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeNetworkInsightsPath),
+	}
+
+	if v, ok := d.GetOk("destination_ip"); ok {
+		input.DestinationIp = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("destination_port"); ok {
+		input.DestinationPort = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("source_ip"); ok {
+		input.SourceIp = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Network Insights Path: %s", input)
+	output, err := conn.CreateNetworkInsightsPath(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Network Insights Path: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.NetworkInsightsPath.NetworkInsightsPathId))
+
+	return resourceNetworkInsightsPathRead(d, meta)
+}
+
+func resourceNetworkInsightsPathRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(PropagationTimeout, func() (interface{}, error) {
+		return FindNetworkInsightsPathByID(conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Network Insights Path (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Network Insights Path (%s): %w", d.Id(), err)
+	}
+
+	nip := outputRaw.(*ec2.NetworkInsightsPath)
+
+	d.Set("arn", nip.NetworkInsightsPathArn)
+	d.Set("destination", nip.Destination)
+	d.Set("destination_ip", nip.DestinationIp)
+	d.Set("destination_port", nip.DestinationPort)
+	d.Set("protocol", nip.Protocol)
+	d.Set("source", nip.Source)
+	d.Set("source_ip", nip.SourceIp)
+
+	tags := KeyValueTags(nip.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceNetworkInsightsPathUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating EC2 Network Insights Path (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceNetworkInsightsPathRead(d, meta)
+}
+
+func resourceNetworkInsightsPathDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	log.Printf("[INFO] Deleting EC2 Network Insights Path: %s", d.Id())
+	_, err := conn.DeleteNetworkInsightsPath(&ec2.DeleteNetworkInsightsPathInput{
+		NetworkInsightsPathId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidNetworkInsightsPathIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Network Insights Path (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,299 @@
+package ec2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEC2NetworkInsightsPath_basic(t *testing.T) {
+	var v ec2.NetworkInsightsPath
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_network_insights_path.test"
+	sourceResourceName := "aws_network_interface.test.0"
+	destinationResourceName := "aws_network_interface.test.1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckNetworkInsightsPathDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkInsightsPathConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsPathExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexp.MustCompile(`network-insights-path/.+$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "destination", destinationResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "destination_ip", ""),
+					resource.TestCheckResourceAttr(resourceName, "destination_port", "0"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "tcp"),
+					resource.TestCheckResourceAttrPair(resourceName, "source", sourceResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "source_ip", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2NetworkInsightsPath_disappears(t *testing.T) {
+	var v ec2.NetworkInsightsPath
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_network_insights_path.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckNetworkInsightsPathDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkInsightsPathConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsPathExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceNetworkInsightsPath(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2NetworkInsightsPath_ipsAndPort(t *testing.T) {
+	var v ec2.NetworkInsightsPath
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_network_insights_path.test"
+	sourceResourceName := "aws_network_interface.test.0"
+	destinationResourceName := "aws_network_interface.test.1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckNetworkInsightsPathDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkInsightsPathIPsAndPortConfig(rName, 443),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsPathExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_ip", destinationResourceName, "private_ip"),
+					resource.TestCheckResourceAttr(resourceName, "destination_port", "443"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_ip", sourceResourceName, "private_ip"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccNetworkInsightsPathIPsAndPortConfig(rName, 8443),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsPathExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "destination_port", "8443"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2NetworkInsightsPath_tags(t *testing.T) {
+	var v ec2.NetworkInsightsPath
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_network_insights_path.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckNetworkInsightsPathDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkInsightsPathTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsPathExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccNetworkInsightsPathTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsPathExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccNetworkInsightsPathTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsPathExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkInsightsPathDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_network_insights_path" {
+			continue
+		}
+
+		_, err := tfec2.FindNetworkInsightsPathByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 Network Insights Path %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckNetworkInsightsPathExists(n string, v *ec2.NetworkInsightsPath) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Network Insights Path ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindNetworkInsightsPathByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccNetworkInsightsPathBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  vpc_id     = aws_vpc.test.id
+  cidr_block = "10.0.0.0/24"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_network_interface" "test" {
+  count = 2
+
+  subnet_id = aws_subnet.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccNetworkInsightsPathConfig(rName string) string {
+	return acctest.ConfigCompose(testAccNetworkInsightsPathBaseConfig(rName), fmt.Sprintf(`
+resource "aws_ec2_network_insights_path" "test" {
+  source      = aws_network_interface.test[0].id
+  destination = aws_network_interface.test[1].id
+  protocol    = "tcp"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccNetworkInsightsPathIPsAndPortConfig(rName string, port int) string {
+	return acctest.ConfigCompose(testAccNetworkInsightsPathBaseConfig(rName), fmt.Sprintf(`
+resource "aws_ec2_network_insights_path" "test" {
+  source           = aws_network_interface.test[0].id
+  source_ip        = aws_network_interface.test[0].private_ip
+  destination      = aws_network_interface.test[1].id
+  destination_ip   = aws_network_interface.test[1].private_ip
+  destination_port = %[2]d
+  protocol         = "tcp"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, port))
+}
+
+func testAccNetworkInsightsPathTags1Config(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccNetworkInsightsPathBaseConfig(rName), fmt.Sprintf(`
+resource "aws_ec2_network_insights_path" "test" {
+  source      = aws_network_interface.test[0].id
+  destination = aws_network_interface.test[1].id
+  protocol    = "tcp"
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccNetworkInsightsPathTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccNetworkInsightsPathBaseConfig(rName), fmt.Sprintf(`
+resource "aws_ec2_network_insights_path" "test" {
+  source      = aws_network_interface.test[0].id
+  destination = aws_network_interface.test[1].id
+  protocol    = "tcp"
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
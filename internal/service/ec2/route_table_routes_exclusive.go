@@ -0,0 +1,190 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceRouteTableRoutesExclusive() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRouteTableRoutesExclusiveCreate,
+		Read:   resourceRouteTableRoutesExclusiveRead,
+		Update: resourceRouteTableRoutesExclusiveUpdate,
+		Delete: resourceRouteTableRoutesExclusiveDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceRouteTableRoutesExclusiveImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"destination_cidr_blocks": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
+				},
+			},
+			"destination_ipv6_cidr_blocks": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidIPv6CIDRNetworkAddress,
+				},
+			},
+			"destination_prefix_list_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"route_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceRouteTableRoutesExclusiveCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	routeTableID := d.Get("route_table_id").(string)
+
+	if err := deleteUnmanagedRouteTableRoutes(conn, routeTableID, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	d.SetId(routeTableID)
+
+	return resourceRouteTableRoutesExclusiveRead(d, meta)
+}
+
+func resourceRouteTableRoutesExclusiveRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	routeTable, err := FindRouteTableByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route Table (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Route Table (%s): %w", d.Id(), err)
+	}
+
+	var cidrBlocks, ipv6CidrBlocks, prefixListIDs []string
+
+	for _, v := range flattenEc2Routes(conn, routeTable.Routes) {
+		switch key, destination := routeTableRouteDestinationAttribute(v.(map[string]interface{})); key {
+		case "cidr_block":
+			cidrBlocks = append(cidrBlocks, destination)
+		case "ipv6_cidr_block":
+			ipv6CidrBlocks = append(ipv6CidrBlocks, destination)
+		case "destination_prefix_list_id":
+			prefixListIDs = append(prefixListIDs, destination)
+		}
+	}
+
+	d.Set("route_table_id", routeTable.RouteTableId)
+
+	if err := d.Set("destination_cidr_blocks", cidrBlocks); err != nil {
+		return fmt.Errorf("error setting destination_cidr_blocks: %w", err)
+	}
+
+	if err := d.Set("destination_ipv6_cidr_blocks", ipv6CidrBlocks); err != nil {
+		return fmt.Errorf("error setting destination_ipv6_cidr_blocks: %w", err)
+	}
+
+	if err := d.Set("destination_prefix_list_ids", prefixListIDs); err != nil {
+		return fmt.Errorf("error setting destination_prefix_list_ids: %w", err)
+	}
+
+	return nil
+}
+
+func resourceRouteTableRoutesExclusiveUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if err := deleteUnmanagedRouteTableRoutes(conn, d.Id(), d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	return resourceRouteTableRoutesExclusiveRead(d, meta)
+}
+
+func resourceRouteTableRoutesExclusiveDelete(d *schema.ResourceData, meta interface{}) error {
+	// Routes are owned by other resources; giving up exclusive management leaves them in place.
+	log.Printf("[DEBUG] Removing exclusive route management for Route Table (%s) from state", d.Id())
+
+	return nil
+}
+
+func resourceRouteTableRoutesExclusiveImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("route_table_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// deleteUnmanagedRouteTableRoutes removes every route from the route table whose destination is not listed in the configuration.
+// Local and propagated routes, and routes owned by VPC endpoints, are never removed.
+func deleteUnmanagedRouteTableRoutes(conn *ec2.EC2, routeTableID string, d *schema.ResourceData, timeout time.Duration) error {
+	routeTable, err := FindRouteTableByID(conn, routeTableID)
+
+	if err != nil {
+		return fmt.Errorf("error reading Route Table (%s): %w", routeTableID, err)
+	}
+
+	managed := map[string]*schema.Set{
+		"cidr_block":                 d.Get("destination_cidr_blocks").(*schema.Set),
+		"ipv6_cidr_block":            d.Get("destination_ipv6_cidr_blocks").(*schema.Set),
+		"destination_prefix_list_id": d.Get("destination_prefix_list_ids").(*schema.Set),
+	}
+
+	for _, v := range flattenEc2Routes(conn, routeTable.Routes) {
+		tfMap := v.(map[string]interface{})
+		key, destination := routeTableRouteDestinationAttribute(tfMap)
+
+		if key == "" || isManagedRouteTableRouteDestination(key, destination, managed[key]) {
+			continue
+		}
+
+		log.Printf("[INFO] Removing unmanaged Route in Route Table (%s) with destination (%s)", routeTableID, destination)
+		if err := ec2RouteTableDeleteRoute(conn, routeTableID, tfMap, timeout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func isManagedRouteTableRouteDestination(key, destination string, managed *schema.Set) bool {
+	if managed.Contains(destination) {
+		return true
+	}
+
+	// IPv6 CIDR blocks may be configured in a non-canonical form.
+	if key == "ipv6_cidr_block" {
+		for _, v := range managed.List() {
+			if verify.CIDRBlocksEqual(v.(string), destination) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
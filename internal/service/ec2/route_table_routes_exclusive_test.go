@@ -0,0 +1,122 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAccEC2RouteTableRoutesExclusive_basic(t *testing.T) {
+	var routeTable ec2.RouteTable
+	resourceName := "aws_route_table_routes_exclusive.test"
+	routeTableResourceName := "aws_route_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRouteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRouteTableRoutesExclusiveConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableExists(routeTableResourceName, &routeTable),
+					testAccCheckRouteTableNumberOfRoutes(&routeTable, 2),
+					resource.TestCheckResourceAttr(resourceName, "destination_cidr_blocks.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "destination_cidr_blocks.*", "10.2.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "destination_ipv6_cidr_blocks.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "destination_prefix_list_ids.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "route_table_id", routeTableResourceName, "id"),
+					testAccCheckRouteTableRoutesExclusiveAddUnmanagedRoute(routeTableResourceName, "aws_internet_gateway.test", "10.3.0.0/16"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccRouteTableRoutesExclusiveConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableExists(routeTableResourceName, &routeTable),
+					testAccCheckRouteTableNumberOfRoutes(&routeTable, 2),
+					resource.TestCheckResourceAttr(resourceName, "destination_cidr_blocks.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "destination_cidr_blocks.*", "10.2.0.0/16"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccCheckRouteTableRoutesExclusiveAddUnmanagedRoute simulates a route added outside of Terraform, e.g. in the console.
+func testAccCheckRouteTableRoutesExclusiveAddUnmanagedRoute(routeTableResourceName, gatewayResourceName, destinationCidr string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		routeTable, ok := s.RootModule().Resources[routeTableResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", routeTableResourceName)
+		}
+
+		gateway, ok := s.RootModule().Resources[gatewayResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", gatewayResourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		_, err := conn.CreateRoute(&ec2.CreateRouteInput{
+			DestinationCidrBlock: aws.String(destinationCidr),
+			GatewayId:            aws.String(gateway.Primary.ID),
+			RouteTableId:         aws.String(routeTable.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccRouteTableRoutesExclusiveConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route" "test" {
+  route_table_id         = aws_route_table.test.id
+  destination_cidr_block = "10.2.0.0/16"
+  gateway_id             = aws_internet_gateway.test.id
+}
+
+resource "aws_route_table_routes_exclusive" "test" {
+  route_table_id          = aws_route.test.route_table_id
+  destination_cidr_blocks = [aws_route.test.destination_cidr_block]
+}
+`, rName)
+}
@@ -0,0 +1,338 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTransitGatewayConnect() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTransitGatewayConnectCreate,
+		Read:   resourceTransitGatewayConnectRead,
+		Update: resourceTransitGatewayConnectUpdate,
+		Delete: resourceTransitGatewayConnectDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(TransitGatewayConnectCreatedTimeout),
+			Update: schema.DefaultTimeout(TransitGatewayConnectUpdatedTimeout),
+			Delete: schema.DefaultTimeout(TransitGatewayConnectDeletedTimeout),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bgp_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bgp_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"connect_peer_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"peer_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"peer_asn": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"transit_gateway_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"transit_gateway_asn": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      ec2.ProtocolValueGre,
+				ValidateFunc: validation.StringInSlice(ec2.ProtocolValue_Values(), false),
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"transit_gateway_default_route_table_association": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"transit_gateway_default_route_table_propagation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"transit_gateway_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"transport_attachment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceTransitGatewayConnectCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	transitGatewayID := d.Get("transit_gateway_id").(string)
+
+	input := &ec2.CreateTransitGatewayConnectInput{
+		Options: &ec2.CreateTransitGatewayConnectRequestOptions{
+			Protocol: aws.String(d.Get("protocol").(string)),
+		},
+		TagSpecifications:                   ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeTransitGatewayAttachment),
+		TransportTransitGatewayAttachmentId: aws.String(d.Get("transport_attachment_id").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Transit Gateway Connect Attachment: %s", input)
+	output, err := conn.CreateTransitGatewayConnect(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Transit Gateway Connect Attachment: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.TransitGatewayConnect.TransitGatewayAttachmentId))
+
+	if _, err := WaitTransitGatewayConnectCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway Connect Attachment (%s) create: %w", d.Id(), err)
+	}
+
+	transitGateway, err := DescribeTransitGateway(conn, transitGatewayID)
+
+	if err != nil {
+		return fmt.Errorf("error describing EC2 Transit Gateway (%s): %w", transitGatewayID, err)
+	}
+
+	if transitGateway.Options == nil {
+		return fmt.Errorf("error describing EC2 Transit Gateway (%s): missing options", transitGatewayID)
+	}
+
+	if err := transitGatewayRouteTableAssociationUpdate(conn, aws.StringValue(transitGateway.Options.AssociationDefaultRouteTableId), d.Id(), d.Get("transit_gateway_default_route_table_association").(bool), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error updating EC2 Transit Gateway Attachment (%s) Route Table (%s) association: %w", d.Id(), aws.StringValue(transitGateway.Options.AssociationDefaultRouteTableId), err)
+	}
+
+	if err := transitGatewayRouteTablePropagationUpdate(conn, aws.StringValue(transitGateway.Options.PropagationDefaultRouteTableId), d.Id(), d.Get("transit_gateway_default_route_table_propagation").(bool)); err != nil {
+		return fmt.Errorf("error updating EC2 Transit Gateway Attachment (%s) Route Table (%s) propagation: %w", d.Id(), aws.StringValue(transitGateway.Options.PropagationDefaultRouteTableId), err)
+	}
+
+	return resourceTransitGatewayConnectRead(d, meta)
+}
+
+func resourceTransitGatewayConnectRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	transitGatewayConnect, err := FindTransitGatewayConnectByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Transit Gateway Connect Attachment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Connect Attachment (%s): %w", d.Id(), err)
+	}
+
+	if state := aws.StringValue(transitGatewayConnect.State); !d.IsNewResource() && state == ec2.TransitGatewayAttachmentStateDeleting {
+		log.Printf("[WARN] EC2 Transit Gateway Connect Attachment (%s) in deleted state (%s), removing from state", d.Id(), state)
+		d.SetId("")
+		return nil
+	}
+
+	transitGatewayID := aws.StringValue(transitGatewayConnect.TransitGatewayId)
+	transitGateway, err := DescribeTransitGateway(conn, transitGatewayID)
+
+	if err != nil {
+		return fmt.Errorf("error describing EC2 Transit Gateway (%s): %w", transitGatewayID, err)
+	}
+
+	if transitGateway.Options == nil {
+		return fmt.Errorf("error describing EC2 Transit Gateway (%s): missing options", transitGatewayID)
+	}
+
+	transitGatewayAssociationDefaultRouteTableID := aws.StringValue(transitGateway.Options.AssociationDefaultRouteTableId)
+	transitGatewayDefaultRouteTableAssociation, err := DescribeTransitGatewayRouteTableAssociation(conn, transitGatewayAssociationDefaultRouteTableID, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error determining EC2 Transit Gateway Attachment (%s) association to Route Table (%s): %w", d.Id(), transitGatewayAssociationDefaultRouteTableID, err)
+	}
+
+	transitGatewayPropagationDefaultRouteTableID := aws.StringValue(transitGateway.Options.PropagationDefaultRouteTableId)
+	transitGatewayDefaultRouteTablePropagation, err := FindTransitGatewayRouteTablePropagation(conn, transitGatewayPropagationDefaultRouteTableID, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error determining EC2 Transit Gateway Attachment (%s) propagation to Route Table (%s): %w", d.Id(), transitGatewayPropagationDefaultRouteTableID, err)
+	}
+
+	connectPeers, err := FindTransitGatewayConnectPeers(conn, &ec2.DescribeTransitGatewayConnectPeersInput{
+		Filters: BuildAttributeFilterList(map[string]string{
+			"transit-gateway-attachment-id": d.Id(),
+		}),
+	})
+
+	if err != nil && !tfresource.NotFound(err) {
+		return fmt.Errorf("error reading EC2 Transit Gateway Connect Attachment (%s) peers: %w", d.Id(), err)
+	}
+
+	if err := d.Set("bgp_configuration", flattenTransitGatewayConnectPeerBgpConfigurations(connectPeers)); err != nil {
+		return fmt.Errorf("error setting bgp_configuration: %w", err)
+	}
+
+	if transitGatewayConnect.CreationTime != nil {
+		d.Set("creation_time", aws.TimeValue(transitGatewayConnect.CreationTime).Format(time.RFC3339))
+	} else {
+		d.Set("creation_time", nil)
+	}
+	d.Set("protocol", transitGatewayConnect.Options.Protocol)
+	d.Set("state", transitGatewayConnect.State)
+	d.Set("transit_gateway_default_route_table_association", transitGatewayDefaultRouteTableAssociation != nil)
+	d.Set("transit_gateway_default_route_table_propagation", transitGatewayDefaultRouteTablePropagation != nil)
+	d.Set("transit_gateway_id", transitGatewayConnect.TransitGatewayId)
+	d.Set("transport_attachment_id", transitGatewayConnect.TransportTransitGatewayAttachmentId)
+
+	tags := KeyValueTags(transitGatewayConnect.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceTransitGatewayConnectUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChanges("transit_gateway_default_route_table_association", "transit_gateway_default_route_table_propagation") {
+		transitGatewayID := d.Get("transit_gateway_id").(string)
+
+		transitGateway, err := DescribeTransitGateway(conn, transitGatewayID)
+
+		if err != nil {
+			return fmt.Errorf("error describing EC2 Transit Gateway (%s): %w", transitGatewayID, err)
+		}
+
+		if transitGateway.Options == nil {
+			return fmt.Errorf("error describing EC2 Transit Gateway (%s): missing options", transitGatewayID)
+		}
+
+		if d.HasChange("transit_gateway_default_route_table_association") {
+			if err := transitGatewayRouteTableAssociationUpdate(conn, aws.StringValue(transitGateway.Options.AssociationDefaultRouteTableId), d.Id(), d.Get("transit_gateway_default_route_table_association").(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("error updating EC2 Transit Gateway Attachment (%s) Route Table (%s) association: %w", d.Id(), aws.StringValue(transitGateway.Options.AssociationDefaultRouteTableId), err)
+			}
+		}
+
+		if d.HasChange("transit_gateway_default_route_table_propagation") {
+			if err := transitGatewayRouteTablePropagationUpdate(conn, aws.StringValue(transitGateway.Options.PropagationDefaultRouteTableId), d.Id(), d.Get("transit_gateway_default_route_table_propagation").(bool)); err != nil {
+				return fmt.Errorf("error updating EC2 Transit Gateway Attachment (%s) Route Table (%s) propagation: %w", d.Id(), aws.StringValue(transitGateway.Options.PropagationDefaultRouteTableId), err)
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating EC2 Transit Gateway Connect Attachment (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceTransitGatewayConnectRead(d, meta)
+}
+
+func resourceTransitGatewayConnectDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	log.Printf("[DEBUG] Deleting EC2 Transit Gateway Connect Attachment: %s", d.Id())
+	_, err := conn.DeleteTransitGatewayConnect(&ec2.DeleteTransitGatewayConnectInput{
+		TransitGatewayAttachmentId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidTransitGatewayAttachmentIDNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Transit Gateway Connect Attachment (%s): %w", d.Id(), err)
+	}
+
+	if _, err := WaitTransitGatewayConnectDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway Connect Attachment (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func flattenTransitGatewayConnectPeerBgpConfigurations(apiObjects []*ec2.TransitGatewayConnectPeer) []interface{} {
+	var tfList []interface{}
+
+	for _, connectPeer := range apiObjects {
+		if connectPeer == nil || connectPeer.ConnectPeerConfiguration == nil {
+			continue
+		}
+
+		for _, apiObject := range connectPeer.ConnectPeerConfiguration.BgpConfigurations {
+			if apiObject == nil {
+				continue
+			}
+
+			tfList = append(tfList, map[string]interface{}{
+				"bgp_status":              aws.StringValue(apiObject.BgpStatus),
+				"connect_peer_id":         aws.StringValue(connectPeer.TransitGatewayConnectPeerId),
+				"peer_address":            aws.StringValue(apiObject.PeerAddress),
+				"peer_asn":                int(aws.Int64Value(apiObject.PeerAsn)),
+				"transit_gateway_address": aws.StringValue(apiObject.TransitGatewayAddress),
+				"transit_gateway_asn":     int(aws.Int64Value(apiObject.TransitGatewayAsn)),
+			})
+		}
+	}
+
+	return tfList
+}
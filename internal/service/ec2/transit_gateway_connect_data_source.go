@@ -0,0 +1,85 @@
+package ec2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func DataSourceTransitGatewayConnect() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTransitGatewayConnectRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": CustomFiltersSchema(),
+			"protocol": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tftags.TagsSchemaComputed(),
+			"transit_gateway_connect_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"transit_gateway_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"transport_attachment_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceTransitGatewayConnectRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	input := &ec2.DescribeTransitGatewayConnectsInput{}
+
+	if v, ok := d.GetOk("transit_gateway_connect_id"); ok {
+		input.TransitGatewayAttachmentIds = aws.StringSlice([]string{v.(string)})
+	}
+
+	if v, ok := d.GetOk("transport_attachment_id"); ok {
+		input.Filters = BuildAttributeFilterList(map[string]string{
+			"transport-transit-gateway-attachment-id": v.(string),
+		})
+	}
+
+	input.Filters = append(input.Filters, BuildCustomFilterList(d.Get("filter").(*schema.Set))...)
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Filters = append(input.Filters, ec2TagFiltersFromMap(v)...)
+	}
+	if len(input.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		input.Filters = nil
+	}
+
+	transitGatewayConnect, err := FindTransitGatewayConnect(conn, input)
+
+	if err != nil {
+		return tfresource.SingularDataSourceFindError("EC2 Transit Gateway Connect", err)
+	}
+
+	d.SetId(aws.StringValue(transitGatewayConnect.TransitGatewayAttachmentId))
+	d.Set("protocol", transitGatewayConnect.Options.Protocol)
+	d.Set("transit_gateway_connect_id", transitGatewayConnect.TransitGatewayAttachmentId)
+	d.Set("transit_gateway_id", transitGatewayConnect.TransitGatewayId)
+	d.Set("transport_attachment_id", transitGatewayConnect.TransportTransitGatewayAttachmentId)
+
+	if err := d.Set("tags", KeyValueTags(transitGatewayConnect.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
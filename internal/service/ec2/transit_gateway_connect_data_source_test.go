@@ -0,0 +1,134 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccTransitGatewayConnectDataSource_Filter(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ec2_transit_gateway_connect.test"
+	resourceName := "aws_ec2_transit_gateway_connect.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransitGatewayConnectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayConnectFilterDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "protocol", dataSourceName, "protocol"),
+					resource.TestCheckResourceAttrPair(resourceName, "tags.%", dataSourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(resourceName, "id", dataSourceName, "transit_gateway_connect_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_id", dataSourceName, "transit_gateway_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "transport_attachment_id", dataSourceName, "transport_attachment_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayConnectDataSource_ID(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ec2_transit_gateway_connect.test"
+	resourceName := "aws_ec2_transit_gateway_connect.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransitGatewayConnectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayConnectIDDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "protocol", dataSourceName, "protocol"),
+					resource.TestCheckResourceAttrPair(resourceName, "tags.%", dataSourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(resourceName, "id", dataSourceName, "transit_gateway_connect_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_id", dataSourceName, "transit_gateway_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "transport_attachment_id", dataSourceName, "transport_attachment_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayConnectDataSource_TransportAttachmentID(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ec2_transit_gateway_connect.test"
+	resourceName := "aws_ec2_transit_gateway_connect.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransitGatewayConnectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayConnectTransportAttachmentIDDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", dataSourceName, "transit_gateway_connect_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_id", dataSourceName, "transit_gateway_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "transport_attachment_id", dataSourceName, "transport_attachment_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayConnectFilterDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayConnectBaseConfig(rName), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway_connect" "test" {
+  transit_gateway_id      = aws_ec2_transit_gateway.test.id
+  transport_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_ec2_transit_gateway_connect" "test" {
+  filter {
+    name   = "transit-gateway-attachment-id"
+    values = [aws_ec2_transit_gateway_connect.test.id]
+  }
+}
+`, rName))
+}
+
+func testAccTransitGatewayConnectIDDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayConnectBaseConfig(rName), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway_connect" "test" {
+  transit_gateway_id      = aws_ec2_transit_gateway.test.id
+  transport_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_ec2_transit_gateway_connect" "test" {
+  transit_gateway_connect_id = aws_ec2_transit_gateway_connect.test.id
+}
+`, rName))
+}
+
+func testAccTransitGatewayConnectTransportAttachmentIDDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayConnectBaseConfig(rName), `
+resource "aws_ec2_transit_gateway_connect" "test" {
+  transit_gateway_id      = aws_ec2_transit_gateway.test.id
+  transport_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+}
+
+data "aws_ec2_transit_gateway_connect" "test" {
+  transport_attachment_id = aws_ec2_transit_gateway_connect.test.transport_attachment_id
+}
+`)
+}
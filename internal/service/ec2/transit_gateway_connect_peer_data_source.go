@@ -0,0 +1,189 @@
+package ec2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func DataSourceTransitGatewayConnectPeer() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTransitGatewayConnectPeerRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bgp_asn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bgp_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bgp_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"peer_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"peer_asn": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"transit_gateway_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"transit_gateway_asn": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"filter": CustomFiltersSchema(),
+			"inside_cidr_blocks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"peer_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tftags.TagsSchemaComputed(),
+			"transit_gateway_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"transit_gateway_attachment_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"transit_gateway_connect_peer_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"transit_gateway_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceTransitGatewayConnectPeerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	input := &ec2.DescribeTransitGatewayConnectPeersInput{}
+
+	if v, ok := d.GetOk("transit_gateway_connect_peer_id"); ok {
+		input.TransitGatewayConnectPeerIds = aws.StringSlice([]string{v.(string)})
+	}
+
+	if v, ok := d.GetOk("transit_gateway_attachment_id"); ok {
+		input.Filters = BuildAttributeFilterList(map[string]string{
+			"transit-gateway-attachment-id": v.(string),
+		})
+	}
+
+	input.Filters = append(input.Filters, BuildCustomFilterList(d.Get("filter").(*schema.Set))...)
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Filters = append(input.Filters, ec2TagFiltersFromMap(v)...)
+	}
+	if len(input.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		input.Filters = nil
+	}
+
+	transitGatewayConnectPeer, err := FindTransitGatewayConnectPeer(conn, input)
+
+	if err != nil {
+		return tfresource.SingularDataSourceFindError("EC2 Transit Gateway Connect Peer", err)
+	}
+
+	// The Connect Peer itself doesn't carry the Transit Gateway ID; resolve it through the owning Connect attachment.
+	transitGatewayAttachmentID := aws.StringValue(transitGatewayConnectPeer.TransitGatewayAttachmentId)
+	transitGatewayConnect, err := FindTransitGatewayConnectByID(conn, transitGatewayAttachmentID)
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Connect Attachment (%s): %w", transitGatewayAttachmentID, err)
+	}
+
+	d.SetId(aws.StringValue(transitGatewayConnectPeer.TransitGatewayConnectPeerId))
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   ec2.ServiceName,
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("transit-gateway-connect-peer/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+
+	connectPeerConfiguration := transitGatewayConnectPeer.ConnectPeerConfiguration
+	bgpConfigurations := flattenTransitGatewayAttachmentBgpConfigurations(connectPeerConfiguration.BgpConfigurations)
+
+	if len(connectPeerConfiguration.BgpConfigurations) > 0 {
+		d.Set("bgp_asn", fmt.Sprintf("%d", aws.Int64Value(connectPeerConfiguration.BgpConfigurations[0].PeerAsn)))
+	} else {
+		d.Set("bgp_asn", nil)
+	}
+
+	if err := d.Set("bgp_configuration", bgpConfigurations); err != nil {
+		return fmt.Errorf("error setting bgp_configuration: %w", err)
+	}
+
+	d.Set("inside_cidr_blocks", aws.StringValueSlice(connectPeerConfiguration.InsideCidrBlocks))
+	d.Set("peer_address", connectPeerConfiguration.PeerAddress)
+	d.Set("protocol", connectPeerConfiguration.Protocol)
+	d.Set("transit_gateway_address", connectPeerConfiguration.TransitGatewayAddress)
+	d.Set("transit_gateway_attachment_id", transitGatewayConnectPeer.TransitGatewayAttachmentId)
+	d.Set("transit_gateway_connect_peer_id", transitGatewayConnectPeer.TransitGatewayConnectPeerId)
+	d.Set("transit_gateway_id", transitGatewayConnect.TransitGatewayId)
+
+	if err := d.Set("tags", KeyValueTags(transitGatewayConnectPeer.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func flattenTransitGatewayAttachmentBgpConfigurations(apiObjects []*ec2.TransitGatewayAttachmentBgpConfiguration) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"bgp_status":              aws.StringValue(apiObject.BgpStatus),
+			"peer_address":            aws.StringValue(apiObject.PeerAddress),
+			"peer_asn":                int(aws.Int64Value(apiObject.PeerAsn)),
+			"transit_gateway_address": aws.StringValue(apiObject.TransitGatewayAddress),
+			"transit_gateway_asn":     int(aws.Int64Value(apiObject.TransitGatewayAsn)),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,33 @@
+package ec2_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccTransitGatewayConnectPeerDataSource_NotFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck: acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTransitGatewayConnectPeerNotFoundDataSourceConfig,
+				ExpectError: regexp.MustCompile(`no matching EC2 Transit Gateway Connect Peer found`),
+			},
+		},
+	})
+}
+
+const testAccTransitGatewayConnectPeerNotFoundDataSourceConfig = `
+data "aws_ec2_transit_gateway_connect_peer" "test" {
+  filter {
+    name   = "transit-gateway-connect-peer-id"
+    values = ["tgw-connect-peer-00000000000000000"]
+  }
+}
+`
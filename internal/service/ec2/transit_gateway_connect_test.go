@@ -0,0 +1,271 @@
+package ec2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccTransitGatewayConnect_basic(t *testing.T) {
+	var transitGatewayConnect ec2.TransitGatewayConnect
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_transit_gateway_connect.test"
+	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
+	vpcAttachmentResourceName := "aws_ec2_transit_gateway_vpc_attachment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransitGatewayConnectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayConnectConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayConnectExists(resourceName, &transitGatewayConnect),
+					resource.TestCheckResourceAttr(resourceName, "bgp_configuration.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_time"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", ec2.ProtocolValueGre),
+					resource.TestCheckResourceAttr(resourceName, "state", ec2.TransitGatewayAttachmentStateAvailable),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_default_route_table_association", "true"),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_default_route_table_propagation", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_id", transitGatewayResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "transport_attachment_id", vpcAttachmentResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayConnect_disappears(t *testing.T) {
+	var transitGatewayConnect ec2.TransitGatewayConnect
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_transit_gateway_connect.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransitGatewayConnectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayConnectConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayConnectExists(resourceName, &transitGatewayConnect),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceTransitGatewayConnect(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayConnect_Protocol(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransitGatewayConnectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTransitGatewayConnectProtocolConfig(rName, "ipsec"),
+				ExpectError: regexp.MustCompile(`expected protocol to be one of`),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayConnect_Tags(t *testing.T) {
+	var transitGatewayConnect ec2.TransitGatewayConnect
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_transit_gateway_connect.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransitGatewayConnectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayConnectTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayConnectExists(resourceName, &transitGatewayConnect),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTransitGatewayConnectTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayConnectExists(resourceName, &transitGatewayConnect),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccTransitGatewayConnectTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayConnectExists(resourceName, &transitGatewayConnect),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTransitGatewayConnectExists(n string, v *ec2.TransitGatewayConnect) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Transit Gateway Connect Attachment ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindTransitGatewayConnectByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckTransitGatewayConnectDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_transit_gateway_connect" {
+			continue
+		}
+
+		_, err := tfec2.FindTransitGatewayConnectByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 Transit Gateway Connect Attachment %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccTransitGatewayConnectBaseConfig(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptInDefaultExclude(), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.0.0.0/24"
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_vpc_attachment" "test" {
+  subnet_ids         = [aws_subnet.test.id]
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+  vpc_id             = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccTransitGatewayConnectConfig(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayConnectBaseConfig(rName), `
+resource "aws_ec2_transit_gateway_connect" "test" {
+  transit_gateway_id      = aws_ec2_transit_gateway.test.id
+  transport_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+}
+`)
+}
+
+func testAccTransitGatewayConnectProtocolConfig(rName, protocol string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayConnectBaseConfig(rName), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway_connect" "test" {
+  protocol                = %[1]q
+  transit_gateway_id      = aws_ec2_transit_gateway.test.id
+  transport_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+}
+`, protocol))
+}
+
+func testAccTransitGatewayConnectTags1Config(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayConnectBaseConfig(rName), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway_connect" "test" {
+  transit_gateway_id      = aws_ec2_transit_gateway.test.id
+  transport_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccTransitGatewayConnectTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayConnectBaseConfig(rName), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway_connect" "test" {
+  transit_gateway_id      = aws_ec2_transit_gateway.test.id
+  transport_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
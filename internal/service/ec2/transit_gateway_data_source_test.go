@@ -10,6 +10,14 @@ import (
 
 func TestAccEC2TransitGatewayDataSource_serial(t *testing.T) {
 	testCases := map[string]map[string]func(t *testing.T){
+		"Connect": {
+			"Filter":                testAccTransitGatewayConnectDataSource_Filter,
+			"ID":                    testAccTransitGatewayConnectDataSource_ID,
+			"TransportAttachmentID": testAccTransitGatewayConnectDataSource_TransportAttachmentID,
+		},
+		"ConnectPeer": {
+			"NotFound": testAccTransitGatewayConnectPeerDataSource_NotFound,
+		},
 		"DxGatewayAttachment": {
 			"Filter":                         testAccTransitGatewayDxGatewayAttachmentDataSource_filter,
 			"TransitGatewayIdAndDxGatewayId": testAccTransitGatewayDxGatewayAttachmentDataSource_TransitGatewayIdAndDxGatewayID,
@@ -0,0 +1,299 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const transitGatewayRouteTargetBlackhole = "blackhole"
+
+func ResourceTransitGatewayRouteTableRoutes() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTransitGatewayRouteTableRoutesCreate,
+		Read:   resourceTransitGatewayRouteTableRoutesRead,
+		Update: resourceTransitGatewayRouteTableRoutesUpdate,
+		Delete: resourceTransitGatewayRouteTableRoutesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(TransitGatewayRouteTableRoutesCreatedTimeout),
+			Update: schema.DefaultTimeout(TransitGatewayRouteTableRoutesUpdatedTimeout),
+			Delete: schema.DefaultTimeout(TransitGatewayRouteTableRoutesDeletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"route": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"blackhole": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"destination_cidr_block": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidCIDRNetworkAddress,
+						},
+						"transit_gateway_attachment_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"transit_gateway_route_table_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceTransitGatewayRouteTableRoutesCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	transitGatewayRouteTableID := d.Get("transit_gateway_route_table_id").(string)
+	want := expandTransitGatewayRoutes(d.Get("route").(*schema.Set).List())
+
+	// The resource is authoritative, so static routes already in the route table but absent from configuration are removed.
+	routes, err := FindTransitGatewayStaticRoutes(conn, transitGatewayRouteTableID)
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Route Table (%s) static routes: %w", transitGatewayRouteTableID, err)
+	}
+
+	if err := updateTransitGatewayRoutes(conn, transitGatewayRouteTableID, transitGatewayRoutesByDestination(routes), want); err != nil {
+		return err
+	}
+
+	d.SetId(transitGatewayRouteTableID)
+
+	if err := WaitTransitGatewayStaticRoutesPropagated(conn, d.Id(), want, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway Route Table (%s) static routes create: %w", d.Id(), err)
+	}
+
+	return resourceTransitGatewayRouteTableRoutesRead(d, meta)
+}
+
+func resourceTransitGatewayRouteTableRoutesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	routes, err := FindTransitGatewayStaticRoutes(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Transit Gateway Route Table (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Route Table (%s) static routes: %w", d.Id(), err)
+	}
+
+	if err := d.Set("route", flattenTransitGatewayRoutes(routes)); err != nil {
+		return fmt.Errorf("error setting route: %w", err)
+	}
+
+	d.Set("transit_gateway_route_table_id", d.Id())
+
+	return nil
+}
+
+func resourceTransitGatewayRouteTableRoutesUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChange("route") {
+		o, n := d.GetChange("route")
+		want := expandTransitGatewayRoutes(n.(*schema.Set).List())
+
+		if err := updateTransitGatewayRoutes(conn, d.Id(), expandTransitGatewayRoutes(o.(*schema.Set).List()), want); err != nil {
+			return err
+		}
+
+		if err := WaitTransitGatewayStaticRoutesPropagated(conn, d.Id(), want, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for EC2 Transit Gateway Route Table (%s) static routes update: %w", d.Id(), err)
+		}
+	}
+
+	return resourceTransitGatewayRouteTableRoutesRead(d, meta)
+}
+
+func resourceTransitGatewayRouteTableRoutesDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	err := updateTransitGatewayRoutes(conn, d.Id(), expandTransitGatewayRoutes(d.Get("route").(*schema.Set).List()), nil)
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidRouteTableIDNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	err = WaitTransitGatewayStaticRoutesPropagated(conn, d.Id(), nil, d.Timeout(schema.TimeoutDelete))
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway Route Table (%s) static routes delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// updateTransitGatewayRoutes reconciles the static routes in a Transit Gateway route table.
+// Routes whose destination is unchanged are replaced in place rather than deleted and recreated.
+func updateTransitGatewayRoutes(conn *ec2.EC2, transitGatewayRouteTableID string, o, n map[string]*ec2.TransitGatewayRoute) error {
+	for destination := range o {
+		if _, ok := n[destination]; ok {
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting EC2 Transit Gateway Route Table (%s) static route: %s", transitGatewayRouteTableID, destination)
+		_, err := conn.DeleteTransitGatewayRoute(&ec2.DeleteTransitGatewayRouteInput{
+			DestinationCidrBlock:       aws.String(destination),
+			TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, ErrCodeInvalidRouteNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error deleting EC2 Transit Gateway Route Table (%s) static route (%s): %w", transitGatewayRouteTableID, destination, err)
+		}
+	}
+
+	for destination, route := range n {
+		target := transitGatewayRouteTarget(route)
+
+		if v, ok := o[destination]; ok {
+			if transitGatewayRouteTarget(v) == target {
+				continue
+			}
+
+			input := &ec2.ReplaceTransitGatewayRouteInput{
+				Blackhole:                  aws.Bool(target == transitGatewayRouteTargetBlackhole),
+				DestinationCidrBlock:       aws.String(destination),
+				TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+			}
+
+			if target != transitGatewayRouteTargetBlackhole {
+				input.TransitGatewayAttachmentId = aws.String(target)
+			}
+
+			log.Printf("[DEBUG] Replacing EC2 Transit Gateway Route Table (%s) static route: %s", transitGatewayRouteTableID, input)
+			if _, err := conn.ReplaceTransitGatewayRoute(input); err != nil {
+				return fmt.Errorf("error replacing EC2 Transit Gateway Route Table (%s) static route (%s): %w", transitGatewayRouteTableID, destination, err)
+			}
+
+			continue
+		}
+
+		input := &ec2.CreateTransitGatewayRouteInput{
+			Blackhole:                  aws.Bool(target == transitGatewayRouteTargetBlackhole),
+			DestinationCidrBlock:       aws.String(destination),
+			TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+		}
+
+		if target != transitGatewayRouteTargetBlackhole {
+			input.TransitGatewayAttachmentId = aws.String(target)
+		}
+
+		log.Printf("[DEBUG] Creating EC2 Transit Gateway Route Table (%s) static route: %s", transitGatewayRouteTableID, input)
+		if _, err := conn.CreateTransitGatewayRoute(input); err != nil {
+			return fmt.Errorf("error creating EC2 Transit Gateway Route Table (%s) static route (%s): %w", transitGatewayRouteTableID, destination, err)
+		}
+	}
+
+	return nil
+}
+
+// transitGatewayRouteTarget returns the attachment ID that the route targets, or "blackhole".
+func transitGatewayRouteTarget(route *ec2.TransitGatewayRoute) string {
+	if aws.StringValue(route.State) == ec2.TransitGatewayRouteStateBlackhole || len(route.TransitGatewayAttachments) == 0 || route.TransitGatewayAttachments[0] == nil {
+		return transitGatewayRouteTargetBlackhole
+	}
+
+	return aws.StringValue(route.TransitGatewayAttachments[0].TransitGatewayAttachmentId)
+}
+
+func transitGatewayRoutesByDestination(routes []*ec2.TransitGatewayRoute) map[string]*ec2.TransitGatewayRoute {
+	m := make(map[string]*ec2.TransitGatewayRoute, len(routes))
+
+	for _, route := range routes {
+		m[verify.CanonicalCIDRBlock(aws.StringValue(route.DestinationCidrBlock))] = route
+	}
+
+	return m
+}
+
+func expandTransitGatewayRoutes(tfList []interface{}) map[string]*ec2.TransitGatewayRoute {
+	apiObjects := make(map[string]*ec2.TransitGatewayRoute, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		destination := verify.CanonicalCIDRBlock(tfMap["destination_cidr_block"].(string))
+		apiObject := &ec2.TransitGatewayRoute{
+			DestinationCidrBlock: aws.String(destination),
+			State:                aws.String(ec2.TransitGatewayRouteStateBlackhole),
+		}
+
+		if v, ok := tfMap["transit_gateway_attachment_id"].(string); ok && v != "" && !tfMap["blackhole"].(bool) {
+			apiObject.State = aws.String(ec2.TransitGatewayRouteStateActive)
+			apiObject.TransitGatewayAttachments = []*ec2.TransitGatewayRouteAttachment{{
+				TransitGatewayAttachmentId: aws.String(v),
+			}}
+		}
+
+		apiObjects[destination] = apiObject
+	}
+
+	return apiObjects
+}
+
+func flattenTransitGatewayRoutes(apiObjects []*ec2.TransitGatewayRoute) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"destination_cidr_block": verify.CanonicalCIDRBlock(aws.StringValue(apiObject.DestinationCidrBlock)),
+		}
+
+		if target := transitGatewayRouteTarget(apiObject); target == transitGatewayRouteTargetBlackhole {
+			tfMap["blackhole"] = true
+			tfMap["transit_gateway_attachment_id"] = ""
+		} else {
+			tfMap["blackhole"] = false
+			tfMap["transit_gateway_attachment_id"] = target
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,250 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccTransitGatewayRouteTableRoutes_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_transit_gateway_route_table_routes.test"
+	routeTableResourceName := "aws_ec2_transit_gateway_route_table.test"
+	vpcAttachmentResourceName := "aws_ec2_transit_gateway_vpc_attachment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransitGatewayRouteTableRoutesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTableRoutesConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableRoutesExists(resourceName, 2),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_route_table_id", routeTableResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"blackhole":              "false",
+						"destination_cidr_block": "10.1.0.0/16",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "route.*.transit_gateway_attachment_id", vpcAttachmentResourceName, "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"blackhole":                     "true",
+						"destination_cidr_block":        "10.2.0.0/16",
+						"transit_gateway_attachment_id": "",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayRouteTableRoutes_update(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_transit_gateway_route_table_routes.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransitGatewayRouteTableRoutesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTableRoutesConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableRoutesExists(resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "2"),
+				),
+			},
+			{
+				Config: testAccTransitGatewayRouteTableRoutesUpdatedConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableRoutesExists(resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"blackhole":                     "true",
+						"destination_cidr_block":        "10.1.0.0/16",
+						"transit_gateway_attachment_id": "",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"blackhole":              "false",
+						"destination_cidr_block": "10.3.0.0/16",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"blackhole":              "false",
+						"destination_cidr_block": "10.4.0.0/16",
+					}),
+				),
+			},
+			{
+				Config: testAccTransitGatewayRouteTableRoutesEmptyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableRoutesExists(resourceName, 0),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTransitGatewayRouteTableRoutesExists(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Transit Gateway Route Table ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		routes, err := tfec2.FindTransitGatewayStaticRoutes(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(routes); got != count {
+			return fmt.Errorf("EC2 Transit Gateway Route Table (%s) has %d static routes, expected %d", rs.Primary.ID, got, count)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTransitGatewayRouteTableRoutesDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_transit_gateway_route_table_routes" {
+			continue
+		}
+
+		routes, err := tfec2.FindTransitGatewayStaticRoutes(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if len(routes) > 0 {
+			return fmt.Errorf("EC2 Transit Gateway Route Table (%s) still has %d static routes", rs.Primary.ID, len(routes))
+		}
+	}
+
+	return nil
+}
+
+func testAccTransitGatewayRouteTableRoutesBaseConfig(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptInDefaultExclude(), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.0.0.0/24"
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_vpc_attachment" "test" {
+  subnet_ids         = [aws_subnet.test.id]
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+  vpc_id             = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_route_table" "test" {
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccTransitGatewayRouteTableRoutesConfig(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayRouteTableRoutesBaseConfig(rName), `
+resource "aws_ec2_transit_gateway_route_table_routes" "test" {
+  transit_gateway_route_table_id = aws_ec2_transit_gateway_route_table.test.id
+
+  route {
+    destination_cidr_block        = "10.1.0.0/16"
+    transit_gateway_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+  }
+
+  route {
+    destination_cidr_block = "10.2.0.0/16"
+    blackhole              = true
+  }
+}
+`)
+}
+
+func testAccTransitGatewayRouteTableRoutesUpdatedConfig(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayRouteTableRoutesBaseConfig(rName), `
+resource "aws_ec2_transit_gateway_route_table_routes" "test" {
+  transit_gateway_route_table_id = aws_ec2_transit_gateway_route_table.test.id
+
+  route {
+    destination_cidr_block = "10.1.0.0/16"
+    blackhole              = true
+  }
+
+  route {
+    destination_cidr_block        = "10.3.0.0/16"
+    transit_gateway_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+  }
+
+  route {
+    destination_cidr_block        = "10.4.0.0/16"
+    transit_gateway_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+  }
+}
+`)
+}
+
+func testAccTransitGatewayRouteTableRoutesEmptyConfig(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayRouteTableRoutesBaseConfig(rName), `
+resource "aws_ec2_transit_gateway_route_table_routes" "test" {
+  transit_gateway_route_table_id = aws_ec2_transit_gateway_route_table.test.id
+}
+`)
+}
@@ -31,6 +31,12 @@ func TestAccEC2TransitGateway_serial(t *testing.T) {
 			"Tags":                                               testAccTransitGateway_Tags,
 			"VpnEcmpSupport":                                     testAccTransitGateway_VPNECMPSupport,
 		},
+		"Connect": {
+			"basic":      testAccTransitGatewayConnect_basic,
+			"disappears": testAccTransitGatewayConnect_disappears,
+			"Protocol":   testAccTransitGatewayConnect_Protocol,
+			"Tags":       testAccTransitGatewayConnect_Tags,
+		},
 		"PeeringAttachment": {
 			"basic":            testAccTransitGatewayPeeringAttachment_basic,
 			"disappears":       testAccTransitGatewayPeeringAttachment_disappears,
@@ -67,6 +73,10 @@ func TestAccEC2TransitGateway_serial(t *testing.T) {
 		"RouteTablePropagation": {
 			"basic": testAccTransitGatewayRouteTablePropagation_basic,
 		},
+		"RouteTableRoutes": {
+			"basic":  testAccTransitGatewayRouteTableRoutes_basic,
+			"update": testAccTransitGatewayRouteTableRoutes_update,
+		},
 		"VpcAttachment": {
 			"basic":                testAccTransitGatewayVPCAttachment_basic,
 			"disappears":           testAccTransitGatewayVPCAttachment_disappears,
@@ -0,0 +1,145 @@
+package ec2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceVPCCIDRBlockAssociations() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVPCCIDRBlockAssociationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"cidr_block_associations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"association_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"ipv6_cidr_block_associations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"association_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipv6_cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipv6_pool": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"network_border_group": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceVPCCIDRBlockAssociationsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	vpcID := d.Get("vpc_id").(string)
+	vpc, err := FindVPCByID(conn, vpcID)
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 VPC (%s): %w", vpcID, err)
+	}
+
+	if vpc == nil {
+		return fmt.Errorf("error reading EC2 VPC (%s): not found", vpcID)
+	}
+
+	d.SetId(vpcID)
+
+	cidrAssociations := []interface{}{}
+	for _, v := range vpc.CidrBlockAssociationSet {
+		if v == nil {
+			continue
+		}
+
+		association := map[string]interface{}{
+			"association_id": aws.StringValue(v.AssociationId),
+			"cidr_block":     aws.StringValue(v.CidrBlock),
+		}
+
+		if v := v.CidrBlockState; v != nil {
+			association["state"] = aws.StringValue(v.State)
+			association["status_message"] = aws.StringValue(v.StatusMessage)
+		}
+
+		cidrAssociations = append(cidrAssociations, association)
+	}
+
+	if err := d.Set("cidr_block_associations", cidrAssociations); err != nil {
+		return fmt.Errorf("error setting cidr_block_associations: %w", err)
+	}
+
+	ipv6CidrAssociations := []interface{}{}
+	for _, v := range vpc.Ipv6CidrBlockAssociationSet {
+		if v == nil {
+			continue
+		}
+
+		association := map[string]interface{}{
+			"association_id":       aws.StringValue(v.AssociationId),
+			"ipv6_cidr_block":      aws.StringValue(v.Ipv6CidrBlock),
+			"ipv6_pool":            aws.StringValue(v.Ipv6Pool),
+			"network_border_group": aws.StringValue(v.NetworkBorderGroup),
+		}
+
+		if v := v.Ipv6CidrBlockState; v != nil {
+			association["state"] = aws.StringValue(v.State)
+			association["status_message"] = aws.StringValue(v.StatusMessage)
+		}
+
+		ipv6CidrAssociations = append(ipv6CidrAssociations, association)
+	}
+
+	if err := d.Set("ipv6_cidr_block_associations", ipv6CidrAssociations); err != nil {
+		return fmt.Errorf("error setting ipv6_cidr_block_associations: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2VPCCIDRBlockAssociationsDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_vpc_cidr_block_associations.test"
+	secondaryResourceName := "aws_vpc_ipv4_cidr_block_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCCIDRBlockAssociationsDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cidr_block_associations.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "cidr_block_associations.*", map[string]string{
+						"cidr_block": "10.0.0.0/16",
+						"state":      ec2.VpcCidrBlockStateCodeAssociated,
+					}),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "cidr_block_associations.*.association_id", secondaryResourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "ipv6_cidr_block_associations.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "ipv6_cidr_block_associations.0.state", ec2.VpcCidrBlockStateCodeAssociated),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ipv6_cidr_block_associations.0.association_id", "aws_vpc.test", "ipv6_association_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_id", "aws_vpc.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCCIDRBlockAssociationsDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block                       = "10.0.0.0/16"
+  assign_generated_ipv6_cidr_block = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_ipv4_cidr_block_association" "test" {
+  vpc_id     = aws_vpc.test.id
+  cidr_block = "172.2.0.0/16"
+}
+
+data "aws_vpc_cidr_block_associations" "test" {
+  vpc_id = aws_vpc_ipv4_cidr_block_association.test.vpc_id
+}
+`, rName)
+}
@@ -40,6 +40,11 @@ func ResourceVPCIPv4CIDRBlockAssociation() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.IsCIDRNetwork(16, 28), // The allowed block size is between a /28 netmask and /16 netmask.
 			},
+
+			"cidr_block_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -120,6 +125,11 @@ func resourceVPCIPv4CIDRBlockAssociationRead(d *schema.ResourceData, meta interf
 	}
 
 	d.Set("cidr_block", vpcCidrBlockAssociation.CidrBlock)
+	if vpcCidrBlockAssociation.CidrBlockState != nil {
+		d.Set("cidr_block_state", vpcCidrBlockAssociation.CidrBlockState.State)
+	} else {
+		d.Set("cidr_block_state", nil)
+	}
 	d.Set("vpc_id", vpc.VpcId)
 
 	return nil
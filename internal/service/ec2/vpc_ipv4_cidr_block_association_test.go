@@ -27,8 +27,10 @@ func TestAccEC2VPCIPv4CIDRBlockAssociation_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckVPCIPv4CIDRBlockAssociationExists("aws_vpc_ipv4_cidr_block_association.secondary_cidr", &associationSecondary),
 					testAccCheckAdditionalVPCIPv4CIDRBlock(&associationSecondary, "172.2.0.0/16"),
+					resource.TestCheckResourceAttr("aws_vpc_ipv4_cidr_block_association.secondary_cidr", "cidr_block_state", ec2.VpcCidrBlockStateCodeAssociated),
 					testAccCheckVPCIPv4CIDRBlockAssociationExists("aws_vpc_ipv4_cidr_block_association.tertiary_cidr", &associationTertiary),
 					testAccCheckAdditionalVPCIPv4CIDRBlock(&associationTertiary, "170.2.0.0/16"),
+					resource.TestCheckResourceAttr("aws_vpc_ipv4_cidr_block_association.tertiary_cidr", "cidr_block_state", ec2.VpcCidrBlockStateCodeAssociated),
 				),
 			},
 			{
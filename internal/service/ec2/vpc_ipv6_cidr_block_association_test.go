@@ -0,0 +1,150 @@
+package ec2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEC2VPCIPv6CIDRBlockAssociation_basic(t *testing.T) {
+	var association ec2.VpcIpv6CidrBlockAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_ipv6_cidr_block_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCIPv6CIDRBlockAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCIPv6CIDRBlockAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCIPv6CIDRBlockAssociationExists(resourceName, &association),
+					resource.TestMatchResourceAttr(resourceName, "ipv6_cidr_block", regexp.MustCompile(`/56$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "ipv6_cidr_block_network_border_group"),
+					resource.TestCheckResourceAttr(resourceName, "ipv6_cidr_block_state", ec2.VpcCidrBlockStateCodeAssociated),
+					resource.TestCheckResourceAttr(resourceName, "ipv6_pool", "Amazon"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", "aws_vpc.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2VPCIPv6CIDRBlockAssociation_disappears(t *testing.T) {
+	var association ec2.VpcIpv6CidrBlockAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_ipv6_cidr_block_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCIPv6CIDRBlockAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCIPv6CIDRBlockAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCIPv6CIDRBlockAssociationExists(resourceName, &association),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceVPCIPv6CIDRBlockAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVPCIPv6CIDRBlockAssociationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_vpc_ipv6_cidr_block_association" {
+			continue
+		}
+
+		vpc, err := tfec2.FindVPCByID(conn, rs.Primary.Attributes["vpc_id"])
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range vpc.Ipv6CidrBlockAssociationSet {
+			if aws.StringValue(v.AssociationId) == rs.Primary.ID && aws.StringValue(v.Ipv6CidrBlockState.State) != ec2.VpcCidrBlockStateCodeDisassociated {
+				return fmt.Errorf("VPC IPv6 CIDR block association %s still exists", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckVPCIPv6CIDRBlockAssociationExists(n string, v *ec2.VpcIpv6CidrBlockAssociation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No VPC IPv6 CIDR block association ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		vpc, err := tfec2.FindVPCByID(conn, rs.Primary.Attributes["vpc_id"])
+
+		if err != nil {
+			return err
+		}
+
+		for _, association := range vpc.Ipv6CidrBlockAssociationSet {
+			if aws.StringValue(association.AssociationId) == rs.Primary.ID {
+				*v = *association
+
+				return nil
+			}
+		}
+
+		return fmt.Errorf("VPC IPv6 CIDR block association %s not found", rs.Primary.ID)
+	}
+}
+
+func testAccVPCIPv6CIDRBlockAssociationConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+
+  lifecycle {
+    ignore_changes = [assign_generated_ipv6_cidr_block]
+  }
+}
+
+resource "aws_vpc_ipv6_cidr_block_association" "test" {
+  vpc_id = aws_vpc.test.id
+}
+`, rName)
+}
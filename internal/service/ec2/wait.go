@@ -27,6 +27,69 @@ const (
 	RouteTableAssociationCreatedNotFoundChecks = 1000 // Should exceed any reasonable custom timeout value.
 )
 
+const (
+	CapacityReservationFleetCreatedTimeout = 10 * time.Minute
+	CapacityReservationFleetUpdatedTimeout = 10 * time.Minute
+	CapacityReservationFleetDeletedTimeout = 10 * time.Minute
+)
+
+func WaitCapacityReservationFleetCreated(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.CapacityReservationFleet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.CapacityReservationFleetStateSubmitted},
+		Target:  []string{ec2.CapacityReservationFleetStateActive, ec2.CapacityReservationFleetStatePartiallyFulfilled},
+		Timeout: timeout,
+		Refresh: StatusCapacityReservationFleetState(conn, id),
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.CapacityReservationFleet); ok {
+		if state := aws.StringValue(output.State); state == ec2.CapacityReservationFleetStateFailed {
+			tfresource.SetLastError(err, errors.New(state))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitCapacityReservationFleetUpdated(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.CapacityReservationFleet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.CapacityReservationFleetStateModifying},
+		Target:  []string{ec2.CapacityReservationFleetStateActive, ec2.CapacityReservationFleetStatePartiallyFulfilled},
+		Timeout: timeout,
+		Refresh: StatusCapacityReservationFleetState(conn, id),
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.CapacityReservationFleet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitCapacityReservationFleetDeleted(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.CapacityReservationFleet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.CapacityReservationFleetStateCancelling},
+		Target:  []string{},
+		Timeout: timeout,
+		Refresh: StatusCapacityReservationFleetState(conn, id),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.CapacityReservationFleet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 const (
 	CarrierGatewayAvailableTimeout = 5 * time.Minute
 
@@ -470,6 +533,92 @@ func WaitSubnetMapPublicIPOnLaunchUpdated(conn *ec2.EC2, subnetID string, expect
 	return nil, err
 }
 
+const (
+	TransitGatewayConnectCreatedTimeout = 10 * time.Minute
+	TransitGatewayConnectUpdatedTimeout = 10 * time.Minute
+	TransitGatewayConnectDeletedTimeout = 10 * time.Minute
+
+	TransitGatewayRouteTableAssociationTimeout = 5 * time.Minute
+)
+
+func WaitTransitGatewayConnectCreated(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.TransitGatewayConnect, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayAttachmentStatePending},
+		Target:  []string{ec2.TransitGatewayAttachmentStateAvailable},
+		Timeout: timeout,
+		Refresh: StatusTransitGatewayConnectState(conn, id),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.TransitGatewayConnect); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitTransitGatewayConnectDeleted(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.TransitGatewayConnect, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.TransitGatewayAttachmentStateAvailable,
+			ec2.TransitGatewayAttachmentStateDeleting,
+		},
+		Target:         []string{},
+		Timeout:        timeout,
+		Refresh:        StatusTransitGatewayConnectState(conn, id),
+		NotFoundChecks: 1,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.TransitGatewayConnect); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+const (
+	TransitGatewayRouteTableRoutesCreatedTimeout = 10 * time.Minute
+	TransitGatewayRouteTableRoutesUpdatedTimeout = 10 * time.Minute
+	TransitGatewayRouteTableRoutesDeletedTimeout = 10 * time.Minute
+)
+
+// WaitTransitGatewayStaticRoutesPropagated waits until the static routes in the specified Transit Gateway
+// route table match the expected set of destination CIDR blocks and their targets.
+func WaitTransitGatewayStaticRoutesPropagated(conn *ec2.EC2, transitGatewayRouteTableID string, want map[string]*ec2.TransitGatewayRoute, timeout time.Duration) error {
+	checkFunc := func() (bool, error) {
+		routes, err := FindTransitGatewayStaticRoutes(conn, transitGatewayRouteTableID)
+
+		if err != nil {
+			return false, err
+		}
+
+		got := transitGatewayRoutesByDestination(routes)
+
+		if len(got) != len(want) {
+			return false, nil
+		}
+
+		for destination, route := range want {
+			v, ok := got[destination]
+
+			if !ok || transitGatewayRouteTarget(v) != transitGatewayRouteTarget(route) {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	}
+	opts := tfresource.WaitOpts{
+		ContinuousTargetOccurence: 2,
+		MinTimeout:                2 * time.Second,
+	}
+
+	return tfresource.WaitUntil(timeout, checkFunc, opts)
+}
+
 const (
 	TransitGatewayPrefixListReferenceTimeout = 5 * time.Minute
 )
@@ -634,6 +783,59 @@ func WaitVPNGatewayVPCAttachmentDetached(conn *ec2.EC2, vpnGatewayID, vpcID stri
 	return nil, err
 }
 
+const (
+	EIPDomainNameAttributeCreatedTimeout = 10 * time.Minute
+	EIPDomainNameAttributeUpdatedTimeout = 10 * time.Minute
+	EIPDomainNameAttributeDeletedTimeout = 10 * time.Minute
+
+	// There is no constant in the SDK for this status
+	ptrUpdateStatusPending = "PENDING"
+)
+
+func WaitEIPDomainNameAttributeUpdated(conn *ec2.EC2, allocationID string, timeout time.Duration) (*ec2.AddressAttribute, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ptrUpdateStatusPending},
+		Target:  []string{""},
+		Timeout: timeout,
+		Refresh: StatusEIPDomainNameAttribute(conn, allocationID),
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.AddressAttribute); ok {
+		if v := output.PtrRecordUpdate; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.Reason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitEIPDomainNameAttributeDeleted(conn *ec2.EC2, allocationID string, timeout time.Duration) (*ec2.AddressAttribute, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ptrUpdateStatusPending},
+		Target:  []string{""},
+		Timeout: timeout,
+		Refresh: StatusEIPDomainNameAttribute(conn, allocationID),
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.AddressAttribute); ok {
+		if v := output.PtrRecordUpdate; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.Reason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 const (
 	HostCreatedTimeout = 10 * time.Minute
 	HostUpdatedTimeout = 10 * time.Minute
@@ -1031,3 +1233,29 @@ func WaitEBSSnapshotImportComplete(conn *ec2.EC2, importTaskID string) (*ec2.Sna
 		return detail.(*ec2.SnapshotTaskDetail), nil
 	}
 }
+
+const (
+	NetworkInsightsAnalysisCreatedTimeout = 20 * time.Minute
+)
+
+func WaitNetworkInsightsAnalysisCreated(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.NetworkInsightsAnalysis, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.AnalysisStatusRunning},
+		Target:  []string{ec2.AnalysisStatusSucceeded},
+		Timeout: timeout,
+		Refresh: StatusNetworkInsightsAnalysis(conn, id),
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.NetworkInsightsAnalysis); ok {
+		if status := aws.StringValue(output.Status); status == ec2.AnalysisStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
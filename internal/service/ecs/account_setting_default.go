@@ -11,6 +11,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+)
+
+const (
+	ResNameAccountSettingDefault = "ECS Account Setting Default"
 )
 
 func ResourceAccountSettingDefault() *schema.Resource {
@@ -70,11 +75,11 @@ func resourceAccountSettingDefaultCreate(d *schema.ResourceData, meta interface{
 	out, err := conn.PutAccountSettingDefault(&input)
 
 	if err != nil {
-		return err
+		return create.Error(ResNameAccountSettingDefault, create.ErrActionCreating, settingName, err)
 	}
 	log.Printf("[DEBUG] Account Setting Default %s set", aws.StringValue(out.Setting.Value))
 
-	d.SetId(aws.StringValue(out.Setting.Value))
+	d.SetId(aws.StringValue(out.Setting.PrincipalArn))
 	d.Set("principal_arn", out.Setting.PrincipalArn)
 
 	return resourceAccountSettingDefaultRead(d, meta)
@@ -92,7 +97,7 @@ func resourceAccountSettingDefaultRead(d *schema.ResourceData, meta interface{})
 	resp, err := conn.ListAccountSettings(input)
 
 	if err != nil {
-		return err
+		return create.Error(ResNameAccountSettingDefault, create.ErrActionReading, d.Id(), err)
 	}
 
 	if len(resp.Settings) == 0 {
@@ -125,11 +130,11 @@ func resourceAccountSettingDefaultUpdate(d *schema.ResourceData, meta interface{
 
 		_, err := conn.PutAccountSettingDefault(&input)
 		if err != nil {
-			return fmt.Errorf("updating ECS Account Setting Default (%s): %w", settingName, err)
+			return create.Error(ResNameAccountSettingDefault, create.ErrActionUpdating, settingName, err)
 		}
 	}
 
-	return nil
+	return resourceAccountSettingDefaultRead(d, meta)
 }
 
 func resourceAccountSettingDefaultDelete(d *schema.ResourceData, meta interface{}) error {
@@ -151,7 +156,7 @@ func resourceAccountSettingDefaultDelete(d *schema.ResourceData, meta interface{
 	}
 
 	if err != nil {
-		return fmt.Errorf("disabling ECS Account Setting Default: %s", err)
+		return create.Error(ResNameAccountSettingDefault, create.ErrActionDeleting, settingName, err)
 	}
 
 	log.Printf("[DEBUG] ECS Account Setting Default (%q) disabled", settingName)
@@ -12,11 +12,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+const (
+	ResNameCapacityProvider = "ECS Capacity Provider"
+)
+
 func ResourceCapacityProvider() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceCapacityProviderCreate,
@@ -126,7 +131,7 @@ func resourceCapacityProviderCreate(d *schema.ResourceData, meta interface{}) er
 	output, err := conn.CreateCapacityProvider(&input)
 
 	if err != nil {
-		return fmt.Errorf("error creating ECS Capacity Provider (%s): %w", name, err)
+		return create.Error(ResNameCapacityProvider, create.ErrActionCreating, name, err)
 	}
 
 	d.SetId(aws.StringValue(output.CapacityProvider.CapacityProviderArn))
@@ -148,7 +153,7 @@ func resourceCapacityProviderRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if err != nil {
-		return fmt.Errorf("error reading ECS Capacity Provider (%s): %w", d.Id(), err)
+		return create.Error(ResNameCapacityProvider, create.ErrActionReading, d.Id(), err)
 	}
 
 	d.Set("arn", output.CapacityProviderArn)
@@ -202,18 +207,18 @@ func resourceCapacityProviderUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 
 		if err != nil {
-			return fmt.Errorf("error updating ECS Capacity Provider (%s): %w", d.Id(), err)
+			return create.Error(ResNameCapacityProvider, create.ErrActionUpdating, d.Id(), err)
 		}
 
 		if _, err = waitCapacityProviderUpdated(conn, d.Id()); err != nil {
-			return fmt.Errorf("error waiting for ECS Capacity Provider (%s) to update: %w", d.Id(), err)
+			return create.Error(ResNameCapacityProvider, create.ErrActionWaitingForUpdate, d.Id(), err)
 		}
 	}
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
-			return fmt.Errorf("error updating ECS Capacity Provider (%s) tags: %w", d.Id(), err)
+			return create.Error(ResNameCapacityProvider, create.ErrActionUpdatingTags, d.Id(), err)
 		}
 	}
 
@@ -234,11 +239,11 @@ func resourceCapacityProviderDelete(d *schema.ResourceData, meta interface{}) er
 	}
 
 	if err != nil {
-		return fmt.Errorf("error deleting ECS Capacity Provider (%s): %w", d.Id(), err)
+		return create.Error(ResNameCapacityProvider, create.ErrActionDeleting, d.Id(), err)
 	}
 
 	if _, err := waitCapacityProviderDeleted(conn, d.Id()); err != nil {
-		return fmt.Errorf("error waiting for ECS Capacity Provider (%s) to delete: %w", d.Id(), err)
+		return create.Error(ResNameCapacityProvider, create.ErrActionWaitingForDeletion, d.Id(), err)
 	}
 
 	return nil
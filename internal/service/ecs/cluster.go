@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -21,6 +22,8 @@ import (
 )
 
 const (
+	ResNameCluster = "ECS Cluster"
+
 	ecsClusterTimeoutDelete = 10 * time.Minute
 	ecsClusterTimeoutUpdate = 10 * time.Minute
 )
@@ -49,8 +52,10 @@ func ResourceCluster() *schema.Resource {
 				Computed: true,
 			},
 			"capacity_providers": {
-				Type:     schema.TypeSet,
-				Optional: true,
+				Type:       schema.TypeSet,
+				Optional:   true,
+				Computed:   true,
+				Deprecated: "Use the aws_ecs_cluster_capacity_providers resource instead",
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -112,8 +117,10 @@ func ResourceCluster() *schema.Resource {
 				},
 			},
 			"default_capacity_provider_strategy": {
-				Type:     schema.TypeSet,
-				Optional: true,
+				Type:       schema.TypeSet,
+				Optional:   true,
+				Computed:   true,
+				Deprecated: "Use the aws_ecs_cluster_capacity_providers resource instead",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"base": {
@@ -220,7 +227,7 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("error creating ECS Cluster (%s): %w", clusterName, err)
+		return create.Error(ResNameCluster, create.ErrActionCreating, clusterName, err)
 	}
 
 	log.Printf("[DEBUG] ECS cluster %s created", aws.StringValue(out.Cluster.ClusterArn))
@@ -228,7 +235,7 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(aws.StringValue(out.Cluster.ClusterArn))
 
 	if _, err := waitClusterAvailable(conn, d.Id()); err != nil {
-		return fmt.Errorf("error waiting for ECS Cluster (%s) to become Available: %w", d.Id(), err)
+		return create.Error(ResNameCluster, create.ErrActionWaitingForCreation, d.Id(), err)
 	}
 
 	return resourceClusterRead(d, meta)
@@ -268,7 +275,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("error reading ECS Cluster (%s): %s", d.Id(), err)
+		return create.Error(ResNameCluster, create.ErrActionReading, d.Id(), err)
 	}
 
 	var cluster *ecs.Cluster
@@ -344,11 +351,11 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		_, err := conn.UpdateCluster(&input)
 		if err != nil {
-			return fmt.Errorf("error changing ECS cluster (%s): %w", d.Id(), err)
+			return create.Error(ResNameCluster, create.ErrActionUpdating, d.Id(), err)
 		}
 
 		if _, err := waitClusterAvailable(conn, d.Id()); err != nil {
-			return fmt.Errorf("error waiting for ECS Cluster (%s) to become Available: %w", d.Id(), err)
+			return create.Error(ResNameCluster, create.ErrActionWaitingForUpdate, d.Id(), err)
 		}
 	}
 
@@ -356,42 +363,23 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		o, n := d.GetChange("tags_all")
 
 		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
-			return fmt.Errorf("error updating ECS Cluster (%s) tags: %w", d.Id(), err)
+			return create.Error(ResNameCluster, create.ErrActionUpdatingTags, d.Id(), err)
 		}
 	}
 
 	if d.HasChanges("capacity_providers", "default_capacity_provider_strategy") {
-		input := ecs.PutClusterCapacityProvidersInput{
+		input := &ecs.PutClusterCapacityProvidersInput{
 			Cluster:                         aws.String(d.Id()),
 			CapacityProviders:               flex.ExpandStringSet(d.Get("capacity_providers").(*schema.Set)),
 			DefaultCapacityProviderStrategy: expandEcsCapacityProviderStrategy(d.Get("default_capacity_provider_strategy").(*schema.Set)),
 		}
 
-		err := resource.Retry(ecsClusterTimeoutUpdate, func() *resource.RetryError {
-			_, err := conn.PutClusterCapacityProviders(&input)
-			if err != nil {
-				if tfawserr.ErrMessageContains(err, ecs.ErrCodeClientException, "Cluster was not ACTIVE") {
-					return resource.RetryableError(err)
-				}
-				if tfawserr.ErrMessageContains(err, ecs.ErrCodeResourceInUseException, "") {
-					return resource.RetryableError(err)
-				}
-				if tfawserr.ErrMessageContains(err, ecs.ErrCodeUpdateInProgressException, "") {
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
-		if tfresource.TimedOut(err) {
-			_, err = conn.PutClusterCapacityProviders(&input)
-		}
-		if err != nil {
-			return fmt.Errorf("error changing ECS cluster capacity provider settings (%s): %w", d.Id(), err)
+		if err := retryClusterCapacityProvidersPut(conn, input); err != nil {
+			return create.Error(ResNameCluster, create.ErrActionUpdating, d.Id(), err)
 		}
 
 		if _, err := waitClusterAvailable(conn, d.Id()); err != nil {
-			return fmt.Errorf("error waiting for ECS Cluster (%s) to become Available: %w", d.Id(), err)
+			return create.Error(ResNameCluster, create.ErrActionWaitingForUpdate, d.Id(), err)
 		}
 	}
 
@@ -435,17 +423,41 @@ func resourceClusterDelete(d *schema.ResourceData, meta interface{}) error {
 		_, err = conn.DeleteCluster(input)
 	}
 	if err != nil {
-		return fmt.Errorf("Error deleting ECS cluster: %s", err)
+		return create.Error(ResNameCluster, create.ErrActionDeleting, d.Id(), err)
 	}
 
 	if _, err := waitClusterDeleted(conn, d.Id()); err != nil {
-		return fmt.Errorf("error waiting for ECS Cluster (%s) to become Deleted: %w", d.Id(), err)
+		return create.Error(ResNameCluster, create.ErrActionWaitingForDeletion, d.Id(), err)
 	}
 
 	log.Printf("[DEBUG] ECS cluster %q deleted", d.Id())
 	return nil
 }
 
+func retryClusterCapacityProvidersPut(conn *ecs.ECS, input *ecs.PutClusterCapacityProvidersInput) error {
+	err := resource.Retry(ecsClusterTimeoutUpdate, func() *resource.RetryError {
+		_, err := conn.PutClusterCapacityProviders(input)
+		if err != nil {
+			if tfawserr.ErrMessageContains(err, ecs.ErrCodeClientException, "Cluster was not ACTIVE") {
+				return resource.RetryableError(err)
+			}
+			if tfawserr.ErrMessageContains(err, ecs.ErrCodeResourceInUseException, "") {
+				return resource.RetryableError(err)
+			}
+			if tfawserr.ErrMessageContains(err, ecs.ErrCodeUpdateInProgressException, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if tfresource.TimedOut(err) {
+		_, err = conn.PutClusterCapacityProviders(input)
+	}
+
+	return err
+}
+
 func expandEcsSettings(configured *schema.Set) []*ecs.ClusterSetting {
 	list := configured.List()
 	if len(list) == 0 {
@@ -0,0 +1,164 @@
+package ecs
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	ResNameClusterCapacityProviders = "ECS Cluster Capacity Providers"
+)
+
+func ResourceClusterCapacityProviders() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceClusterCapacityProvidersPut,
+		Read:   resourceClusterCapacityProvidersRead,
+		Update: resourceClusterCapacityProvidersPut,
+		Delete: resourceClusterCapacityProvidersDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"capacity_providers": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"cluster_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"default_capacity_provider_strategy": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"base": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 100000),
+						},
+						"capacity_provider": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 1000),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceClusterCapacityProvidersPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ECSConn
+
+	clusterName := d.Get("cluster_name").(string)
+	input := &ecs.PutClusterCapacityProvidersInput{
+		CapacityProviders:               flex.ExpandStringSet(d.Get("capacity_providers").(*schema.Set)),
+		Cluster:                         aws.String(clusterName),
+		DefaultCapacityProviderStrategy: expandEcsCapacityProviderStrategy(d.Get("default_capacity_provider_strategy").(*schema.Set)),
+	}
+
+	log.Printf("[DEBUG] Updating ECS Cluster (%s) capacity providers: %s", clusterName, input)
+	if err := retryClusterCapacityProvidersPut(conn, input); err != nil {
+		return create.Error(ResNameClusterCapacityProviders, create.ErrActionUpdating, clusterName, err)
+	}
+
+	if _, err := waitClusterAvailable(conn, clusterName); err != nil {
+		return create.Error(ResNameClusterCapacityProviders, create.ErrActionWaitingForUpdate, clusterName, err)
+	}
+
+	d.SetId(clusterName)
+
+	return resourceClusterCapacityProvidersRead(d, meta)
+}
+
+func resourceClusterCapacityProvidersRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ECSConn
+
+	output, err := FindClusterByARN(conn, d.Id())
+
+	if err != nil && !tfresource.NotFound(err) {
+		return create.Error(ResNameClusterCapacityProviders, create.ErrActionReading, d.Id(), err)
+	}
+
+	var cluster *ecs.Cluster
+	if output != nil {
+		for _, c := range output.Clusters {
+			if aws.StringValue(c.ClusterName) == d.Id() {
+				cluster = c
+				break
+			}
+		}
+	}
+
+	if !d.IsNewResource() && (cluster == nil || aws.StringValue(cluster.Status) == "INACTIVE") {
+		log.Printf("[WARN] ECS Cluster (%s) not found, removing capacity providers from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if cluster == nil {
+		return create.Error(ResNameClusterCapacityProviders, create.ErrActionReading, d.Id(), errors.New("not found after update"))
+	}
+
+	if err := d.Set("capacity_providers", aws.StringValueSlice(cluster.CapacityProviders)); err != nil {
+		return fmt.Errorf("error setting capacity_providers: %w", err)
+	}
+
+	d.Set("cluster_name", cluster.ClusterName)
+
+	if err := d.Set("default_capacity_provider_strategy", flattenEcsCapacityProviderStrategy(cluster.DefaultCapacityProviderStrategy)); err != nil {
+		return fmt.Errorf("error setting default_capacity_provider_strategy: %w", err)
+	}
+
+	return nil
+}
+
+func resourceClusterCapacityProvidersDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ECSConn
+
+	input := &ecs.PutClusterCapacityProvidersInput{
+		CapacityProviders:               []*string{},
+		Cluster:                         aws.String(d.Id()),
+		DefaultCapacityProviderStrategy: []*ecs.CapacityProviderStrategyItem{},
+	}
+
+	log.Printf("[DEBUG] Removing ECS Cluster (%s) capacity providers", d.Id())
+	err := retryClusterCapacityProvidersPut(conn, input)
+
+	if tfawserr.ErrCodeEquals(err, ecs.ErrCodeClusterNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.Error(ResNameClusterCapacityProviders, create.ErrActionDeleting, d.Id(), err)
+	}
+
+	if _, err := waitClusterAvailable(conn, d.Id()); err != nil {
+		return create.Error(ResNameClusterCapacityProviders, create.ErrActionWaitingForDeletion, d.Id(), err)
+	}
+
+	return nil
+}
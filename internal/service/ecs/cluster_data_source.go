@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 )
 
 func DataSourceCluster() *schema.Resource {
@@ -75,7 +76,7 @@ func dataSourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 	desc, err := conn.DescribeClusters(params)
 
 	if err != nil {
-		return err
+		return create.Error(ResNameCluster, create.ErrActionReading, d.Get("cluster_name").(string), err)
 	}
 
 	if len(desc.Clusters) == 0 {
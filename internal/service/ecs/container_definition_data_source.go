@@ -1,6 +1,7 @@
 package ecs
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -9,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 )
 
 func DataSourceContainerDefinition() *schema.Resource {
@@ -73,11 +75,11 @@ func dataSourceContainerDefinitionRead(d *schema.ResourceData, meta interface{})
 	desc, err := conn.DescribeTaskDefinition(params)
 
 	if err != nil {
-		return fmt.Errorf("error reading ECS Task Definition: %w", err)
+		return create.Error(ResNameTaskDefinition, create.ErrActionReading, d.Get("task_definition").(string), err)
 	}
 
 	if desc == nil || desc.TaskDefinition == nil {
-		return fmt.Errorf("error reading ECS Task Definition: empty response")
+		return create.Error(ResNameTaskDefinition, create.ErrActionReading, d.Get("task_definition").(string), errors.New("empty response"))
 	}
 
 	taskDefinition := desc.TaskDefinition
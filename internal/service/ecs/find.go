@@ -3,6 +3,7 @@ package ecs
 import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -65,3 +66,66 @@ func FindClusterByARN(conn *ecs.ECS, arn string) (*ecs.DescribeClustersOutput, e
 
 	return output, nil
 }
+
+func FindTaskSetByID(conn *ecs.ECS, taskSetID, service, cluster string) (*ecs.TaskSet, error) {
+	input := &ecs.DescribeTaskSetsInput{
+		Cluster:  aws.String(cluster),
+		Include:  aws.StringSlice([]string{ecs.TaskSetFieldTags}),
+		Service:  aws.String(service),
+		TaskSets: aws.StringSlice([]string{taskSetID}),
+	}
+
+	output, err := conn.DescribeTaskSets(input)
+
+	if tfawserr.ErrCodeEquals(err, ecs.ErrCodeClusterNotFoundException, ecs.ErrCodeServiceNotFoundException, ecs.ErrCodeTaskSetNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.TaskSets) == 0 || output.TaskSets[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.TaskSets[0], nil
+}
+
+func FindServiceByID(conn *ecs.ECS, id, cluster string) (*ecs.Service, error) {
+	input := &ecs.DescribeServicesInput{
+		Services: aws.StringSlice([]string{id}),
+	}
+
+	if cluster != "" {
+		input.Cluster = aws.String(cluster)
+	}
+
+	output, err := conn.DescribeServices(input)
+
+	if tfawserr.ErrCodeEquals(err, ecs.ErrCodeClusterNotFoundException, ecs.ErrCodeServiceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Services) == 0 || output.Services[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.Services[0], nil
+}
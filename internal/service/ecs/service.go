@@ -3,6 +3,7 @@ package ecs
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"math"
@@ -26,6 +27,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+const (
+	ResNameService = "ECS Service"
+)
+
 func ResourceService() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceServiceCreate,
@@ -37,6 +42,8 @@ func ResourceService() *schema.Resource {
 		},
 
 		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
@@ -580,7 +587,7 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
 				return resource.RetryableError(err)
 			}
 
-			return resource.NonRetryableError(fmt.Errorf("error waiting for ECS service (%s) creation: %w", d.Get("name").(string), err))
+			return resource.NonRetryableError(err)
 		}
 
 		log.Printf("[DEBUG] ECS service created: %s", aws.StringValue(output.Service.ServiceArn))
@@ -593,11 +600,11 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
 		output, err := conn.CreateService(&input)
 
 		if err != nil {
-			return fmt.Errorf("error creating ECS service: %w", err)
+			return create.Error(ResNameService, create.ErrActionCreating, d.Get("name").(string), err)
 		}
 
 		if output == nil || output.Service == nil {
-			return fmt.Errorf("error creating ECS service: empty response")
+			return create.Error(ResNameService, create.ErrActionCreating, d.Get("name").(string), errors.New("empty response"))
 		}
 
 		log.Printf("[DEBUG] ECS service created: %s", aws.StringValue(output.Service.ServiceArn))
@@ -605,7 +612,7 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("error creating %s service: %w", d.Get("name").(string), err)
+		return create.Error(ResNameService, create.ErrActionCreating, d.Get("name").(string), err)
 	}
 
 	if d.Get("wait_for_steady_state").(bool) {
@@ -614,8 +621,8 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
 			cluster = v.(string)
 		}
 
-		if err := waitServiceStable(conn, d.Id(), cluster); err != nil {
-			return fmt.Errorf("error waiting for ECS service (%s) to become ready: %w", d.Id(), err)
+		if _, err := waitServiceStable(conn, d.Id(), cluster, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return create.Error(ResNameService, create.ErrActionWaitingForCreation, d.Id(), err)
 		}
 	}
 
@@ -653,12 +660,12 @@ func resourceServiceRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("error reading ECS service: %w", err)
+		return create.Error(ResNameService, create.ErrActionReading, d.Id(), err)
 	}
 
 	if len(output.Services) < 1 {
 		if d.IsNewResource() {
-			return fmt.Errorf("ECS service not created: %q", d.Id())
+			return create.Error(ResNameService, create.ErrActionReading, d.Id(), errors.New("not found after creation"))
 		}
 		log.Printf("[WARN] Removing ECS service %s (%s) because it's gone", d.Get("name").(string), d.Id())
 		d.SetId("")
@@ -1178,7 +1185,7 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		if err != nil {
-			return fmt.Errorf("error updating ECS Service (%s): %w", d.Id(), err)
+			return create.Error(ResNameService, create.ErrActionUpdating, d.Id(), err)
 		}
 	}
 
@@ -1188,8 +1195,8 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 			cluster = v.(string)
 		}
 
-		if err := waitServiceStable(conn, d.Id(), cluster); err != nil {
-			return fmt.Errorf("error waiting for ECS service (%s) to become ready: %w", d.Id(), err)
+		if _, err := waitServiceStable(conn, d.Id(), cluster, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.Error(ResNameService, create.ErrActionWaitingForUpdate, d.Id(), err)
 		}
 	}
 
@@ -1197,7 +1204,7 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 		o, n := d.GetChange("tags_all")
 
 		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
-			return fmt.Errorf("error updating ECS Service (%s) tags: %w", d.Id(), err)
+			return create.Error(ResNameService, create.ErrActionUpdatingTags, d.Id(), err)
 		}
 	}
 
@@ -1218,7 +1225,7 @@ func resourceServiceDelete(d *schema.ResourceData, meta interface{}) error {
 			log.Printf("[DEBUG] Removing ECS Service from state, %q is already gone", d.Id())
 			return nil
 		}
-		return err
+		return create.Error(ResNameService, create.ErrActionDeleting, d.Id(), err)
 	}
 
 	if len(output.Services) == 0 {
@@ -1241,7 +1248,7 @@ func resourceServiceDelete(d *schema.ResourceData, meta interface{}) error {
 			DesiredCount: aws.Int64(0),
 		})
 		if err != nil {
-			return err
+			return create.Error(ResNameService, create.ErrActionDeleting, d.Id(), err)
 		}
 	}
 
@@ -1273,11 +1280,11 @@ func resourceServiceDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("error deleting ECS service (%s): %w", d.Id(), err)
+		return create.Error(ResNameService, create.ErrActionDeleting, d.Id(), err)
 	}
 
 	if err := waitServiceInactive(conn, d.Id(), d.Get("cluster").(string)); err != nil {
-		return fmt.Errorf("error deleting ECS service (%s): %w", d.Id(), err)
+		return create.Error(ResNameService, create.ErrActionWaitingForDeletion, d.Id(), err)
 	}
 
 	log.Printf("[DEBUG] ECS service %s deleted.", d.Id())
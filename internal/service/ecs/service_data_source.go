@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 )
 
 func DataSourceService() *schema.Resource {
@@ -62,7 +63,7 @@ func dataSourceServiceRead(d *schema.ResourceData, meta interface{}) error {
 	desc, err := conn.DescribeServices(params)
 
 	if err != nil {
-		return err
+		return create.Error(ResNameService, create.ErrActionReading, serviceName, err)
 	}
 
 	if desc == nil || len(desc.Services) == 0 {
@@ -1,7 +1,10 @@
 package ecs
 
 import (
+	"fmt"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
@@ -21,6 +24,16 @@ const (
 
 	clusterStatusError = "ERROR"
 	clusterStatusNone  = "NONE"
+
+	serviceDeploymentStatusPrimary = "PRIMARY"
+
+	serviceStableStatusPending = "PENDING"
+	serviceStableStatusStable  = "STABLE"
+
+	taskStatusStopped = "STOPPED"
+
+	tasksStoppedStatusPending = "PENDING"
+	tasksStoppedStatusStopped = "STOPPED"
 )
 
 func statusCapacityProvider(conn *ecs.ECS, arn string) resource.StateRefreshFunc {
@@ -81,6 +94,104 @@ func statusService(conn *ecs.ECS, id, cluster string) resource.StateRefreshFunc
 	}
 }
 
+// statusServiceStable tracks the deployment that is PRIMARY on the first refresh
+// and reports the service as stable once that deployment's rollout completes.
+// If the rollout fails, for example because the deployment circuit breaker
+// tripped and triggered a rollback, an error carrying the failure reason and
+// recent service events is returned so that the apply fails.
+func statusServiceStable(conn *ecs.ECS, id, cluster string) resource.StateRefreshFunc {
+	var deploymentID string
+	startTime := time.Now()
+
+	return func() (interface{}, string, error) {
+		service, err := FindServiceByID(conn, id, cluster)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if deploymentID == "" {
+			deploymentID = primaryServiceDeploymentID(service)
+		}
+
+		status, err := serviceStableStatus(service, deploymentID, startTime)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return service, status, nil
+	}
+}
+
+func primaryServiceDeploymentID(service *ecs.Service) string {
+	for _, deployment := range service.Deployments {
+		if aws.StringValue(deployment.Status) == serviceDeploymentStatusPrimary {
+			return aws.StringValue(deployment.Id)
+		}
+	}
+
+	return ""
+}
+
+// serviceStableStatus returns whether the tracked deployment of the service has
+// finished rolling out. Service events created before since are not reported
+// when the deployment has failed.
+func serviceStableStatus(service *ecs.Service, deploymentID string, since time.Time) (string, error) {
+	if status := aws.StringValue(service.Status); status != serviceStatusActive {
+		return "", fmt.Errorf("unexpected service status: %s", status)
+	}
+
+	var deployment *ecs.Deployment
+	for _, v := range service.Deployments {
+		if aws.StringValue(v.Id) == deploymentID {
+			deployment = v
+			break
+		}
+	}
+
+	if deploymentID != "" && deployment == nil {
+		return "", serviceDeploymentFailedError(deploymentID, "deployment is no longer active", service.Events, since)
+	}
+
+	if deployment != nil {
+		switch aws.StringValue(deployment.RolloutState) {
+		case ecs.DeploymentRolloutStateFailed:
+			return "", serviceDeploymentFailedError(deploymentID, aws.StringValue(deployment.RolloutStateReason), service.Events, since)
+		case ecs.DeploymentRolloutStateCompleted:
+			return serviceStableStatusStable, nil
+		case ecs.DeploymentRolloutStateInProgress:
+			return serviceStableStatusPending, nil
+		}
+	}
+
+	// Deployments without a rollout state use the same conditions as the
+	// services-stable waiter.
+	if len(service.Deployments) == 1 && aws.Int64Value(service.RunningCount) == aws.Int64Value(service.DesiredCount) {
+		return serviceStableStatusStable, nil
+	}
+
+	return serviceStableStatusPending, nil
+}
+
+func serviceDeploymentFailedError(deploymentID, reason string, events []*ecs.ServiceEvent, since time.Time) error {
+	var messages []string
+
+	for _, event := range events {
+		if aws.TimeValue(event.CreatedAt).Before(since) {
+			continue
+		}
+
+		messages = append(messages, aws.StringValue(event.Message))
+	}
+
+	if len(messages) == 0 {
+		return fmt.Errorf("deployment (%s) failed: %s", deploymentID, reason)
+	}
+
+	return fmt.Errorf("deployment (%s) failed: %s\n\nRecent service events:\n%s", deploymentID, reason, strings.Join(messages, "\n"))
+}
+
 func statusCluster(conn *ecs.ECS, arn string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindClusterByARN(conn, arn)
@@ -100,3 +211,61 @@ func statusCluster(conn *ecs.ECS, arn string) resource.StateRefreshFunc {
 		return output, aws.StringValue(output.Clusters[0].Status), err
 	}
 }
+
+func statusTaskSetStability(conn *ecs.ECS, taskSetID, service, cluster string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindTaskSetByID(conn, taskSetID, service, cluster)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.StabilityStatus), nil
+	}
+}
+
+func statusTaskSet(conn *ecs.ECS, taskSetID, service, cluster string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindTaskSetByID(conn, taskSetID, service, cluster)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+// statusTasksStopped reports STOPPED once every one of the specified tasks has stopped.
+func statusTasksStopped(conn *ecs.ECS, cluster string, taskARNs []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeTasks(&ecs.DescribeTasksInput{
+			Cluster: aws.String(cluster),
+			Tasks:   aws.StringSlice(taskARNs),
+		})
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || len(output.Tasks) == 0 {
+			return nil, "", nil
+		}
+
+		for _, task := range output.Tasks {
+			if aws.StringValue(task.LastStatus) != taskStatusStopped {
+				return output.Tasks, tasksStoppedStatusPending, nil
+			}
+		}
+
+		return output.Tasks, tasksStoppedStatusStopped, nil
+	}
+}
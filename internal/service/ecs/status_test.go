@@ -0,0 +1,191 @@
+package ecs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+func TestServiceStableStatus(t *testing.T) {
+	since := time.Date(2021, 11, 1, 12, 0, 0, 0, time.UTC)
+	events := []*ecs.ServiceEvent{
+		{
+			CreatedAt: aws.Time(since.Add(2 * time.Minute)),
+			Message:   aws.String("(service test) deployment ecs-svc/2 deployment failed: tasks failed to start."),
+		},
+		{
+			CreatedAt: aws.Time(since.Add(-time.Hour)),
+			Message:   aws.String("(service test) has reached a steady state."),
+		},
+	}
+
+	cases := []struct {
+		Name          string
+		Service       *ecs.Service
+		DeploymentID  string
+		ExpectedState string
+		ExpectedError string
+	}{
+		{
+			Name: "draining",
+			Service: &ecs.Service{
+				Status: aws.String(serviceStatusDraining),
+			},
+			ExpectedError: "unexpected service status: DRAINING",
+		},
+		{
+			Name: "rollout in progress",
+			Service: &ecs.Service{
+				Status: aws.String(serviceStatusActive),
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/2"), Status: aws.String(serviceDeploymentStatusPrimary), RolloutState: aws.String(ecs.DeploymentRolloutStateInProgress)},
+					{Id: aws.String("ecs-svc/1"), Status: aws.String("ACTIVE"), RolloutState: aws.String(ecs.DeploymentRolloutStateCompleted)},
+				},
+			},
+			DeploymentID:  "ecs-svc/2",
+			ExpectedState: serviceStableStatusPending,
+		},
+		{
+			Name: "rollout completed",
+			Service: &ecs.Service{
+				Status: aws.String(serviceStatusActive),
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/2"), Status: aws.String(serviceDeploymentStatusPrimary), RolloutState: aws.String(ecs.DeploymentRolloutStateCompleted)},
+				},
+			},
+			DeploymentID:  "ecs-svc/2",
+			ExpectedState: serviceStableStatusStable,
+		},
+		{
+			Name: "rollout failed",
+			Service: &ecs.Service{
+				Status: aws.String(serviceStatusActive),
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/1"), Status: aws.String(serviceDeploymentStatusPrimary), RolloutState: aws.String(ecs.DeploymentRolloutStateInProgress)},
+					{Id: aws.String("ecs-svc/2"), Status: aws.String("ACTIVE"), RolloutState: aws.String(ecs.DeploymentRolloutStateFailed), RolloutStateReason: aws.String("ECS deployment circuit breaker: tasks failed to start.")},
+				},
+				Events: events,
+			},
+			DeploymentID:  "ecs-svc/2",
+			ExpectedError: "deployment (ecs-svc/2) failed: ECS deployment circuit breaker: tasks failed to start.\n\nRecent service events:\n(service test) deployment ecs-svc/2 deployment failed: tasks failed to start.",
+		},
+		{
+			Name: "deployment replaced",
+			Service: &ecs.Service{
+				Status: aws.String(serviceStatusActive),
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/1"), Status: aws.String(serviceDeploymentStatusPrimary), RolloutState: aws.String(ecs.DeploymentRolloutStateCompleted)},
+				},
+			},
+			DeploymentID:  "ecs-svc/2",
+			ExpectedError: "deployment (ecs-svc/2) failed: deployment is no longer active",
+		},
+		{
+			Name: "no rollout state steady",
+			Service: &ecs.Service{
+				Status:       aws.String(serviceStatusActive),
+				DesiredCount: aws.Int64(2),
+				RunningCount: aws.Int64(2),
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/1"), Status: aws.String(serviceDeploymentStatusPrimary)},
+				},
+			},
+			DeploymentID:  "ecs-svc/1",
+			ExpectedState: serviceStableStatusStable,
+		},
+		{
+			Name: "no rollout state scaling",
+			Service: &ecs.Service{
+				Status:       aws.String(serviceStatusActive),
+				DesiredCount: aws.Int64(2),
+				RunningCount: aws.Int64(1),
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/1"), Status: aws.String(serviceDeploymentStatusPrimary)},
+				},
+			},
+			DeploymentID:  "ecs-svc/1",
+			ExpectedState: serviceStableStatusPending,
+		},
+		{
+			Name: "no rollout state multiple deployments",
+			Service: &ecs.Service{
+				Status:       aws.String(serviceStatusActive),
+				DesiredCount: aws.Int64(1),
+				RunningCount: aws.Int64(1),
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/2"), Status: aws.String(serviceDeploymentStatusPrimary)},
+					{Id: aws.String("ecs-svc/1"), Status: aws.String("ACTIVE")},
+				},
+			},
+			DeploymentID:  "ecs-svc/2",
+			ExpectedState: serviceStableStatusPending,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			state, err := serviceStableStatus(tc.Service, tc.DeploymentID, since)
+
+			if tc.ExpectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error %q, got none", tc.ExpectedError)
+				}
+
+				if got := err.Error(); got != tc.ExpectedError {
+					t.Fatalf("got error %q, expected %q", got, tc.ExpectedError)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if state != tc.ExpectedState {
+				t.Errorf("got state %q, expected %q", state, tc.ExpectedState)
+			}
+		})
+	}
+}
+
+func TestPrimaryServiceDeploymentID(t *testing.T) {
+	service := &ecs.Service{
+		Deployments: []*ecs.Deployment{
+			{Id: aws.String("ecs-svc/1"), Status: aws.String("ACTIVE")},
+			{Id: aws.String("ecs-svc/2"), Status: aws.String(serviceDeploymentStatusPrimary)},
+		},
+	}
+
+	if got, expected := primaryServiceDeploymentID(service), "ecs-svc/2"; got != expected {
+		t.Errorf("got %q, expected %q", got, expected)
+	}
+
+	if got := primaryServiceDeploymentID(&ecs.Service{}); got != "" {
+		t.Errorf("got %q, expected no deployment", got)
+	}
+}
+
+func TestServiceDeploymentFailedError(t *testing.T) {
+	since := time.Date(2021, 11, 1, 12, 0, 0, 0, time.UTC)
+
+	err := serviceDeploymentFailedError("ecs-svc/2", "tasks failed to start", nil, since)
+
+	if got, expected := err.Error(), "deployment (ecs-svc/2) failed: tasks failed to start"; got != expected {
+		t.Errorf("got %q, expected %q", got, expected)
+	}
+
+	events := []*ecs.ServiceEvent{
+		{CreatedAt: aws.Time(since.Add(time.Minute)), Message: aws.String("event 2")},
+		{CreatedAt: aws.Time(since), Message: aws.String("event 1")},
+		{CreatedAt: aws.Time(since.Add(-time.Second)), Message: aws.String("event 0")},
+	}
+
+	err = serviceDeploymentFailedError("ecs-svc/2", "tasks failed to start", events, since)
+
+	if got, expected := err.Error(), "deployment (ecs-svc/2) failed: tasks failed to start\n\nRecent service events:\nevent 2\nevent 1"; got != expected {
+		t.Errorf("got %q, expected %q", got, expected)
+	}
+}
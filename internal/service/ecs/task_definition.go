@@ -2,6 +2,7 @@ package ecs
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -11,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -21,6 +23,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+const (
+	ResNameTaskDefinition = "ECS Task Definition"
+)
+
 func ResourceTaskDefinition() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
@@ -48,7 +54,10 @@ func ResourceTaskDefinition() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			ephemeralStorageCustomizeDiff,
+		),
 
 		SchemaVersion: 1,
 		MigrateState:  resourceTaskDefinitionMigrateState,
@@ -77,16 +86,20 @@ func ResourceTaskDefinition() *schema.Resource {
 				Computed: true,
 			},
 
+			"container_definition": containerDefinitionSchema(),
 			"container_definitions": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"container_definition", "container_definitions"},
 				StateFunc: func(v interface{}) string {
-					// Sort the lists of environment variables as they are serialized to state, so we won't get
+					// Sort the lists of environment variables and secrets as they are serialized to state, so we won't get
 					// spurious reorderings in plans (diff is suppressed if the environment variables haven't changed,
 					// but they still show in the plan if some other property changes).
 					orderedCDs, _ := expandEcsContainerDefinitions(v.(string))
 					containerDefinitions(orderedCDs).OrderEnvironmentVariables()
+					containerDefinitions(orderedCDs).OrderSecrets()
 					unnormalizedJson, _ := flattenEcsContainerDefinitions(orderedCDs)
 					json, _ := structure.NormalizeJsonString(unnormalizedJson)
 					return json
@@ -380,8 +393,18 @@ func ResourceTaskDefinition() *schema.Resource {
 				},
 			},
 
+			"skip_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			"track_latest": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"inference_accelerator": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -407,22 +430,50 @@ func ResourceTaskDefinition() *schema.Resource {
 
 func ValidTaskDefinitionContainerDefinitions(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
-	_, err := expandEcsContainerDefinitions(value)
-	if err != nil {
+	warnings, errs := validContainerDefinitions(value)
+	for _, w := range warnings {
+		ws = append(ws, fmt.Sprintf("ECS Task Definition container_definitions: %s", w))
+	}
+	for _, err := range errs {
 		errors = append(errors, fmt.Errorf("ECS Task Definition container_definitions is invalid: %s", err))
 	}
 	return
 }
 
+// ephemeralStorageCustomizeDiff rejects ephemeral storage on task definitions that
+// explicitly require only non-Fargate launch types, which RegisterTaskDefinition refuses.
+func ephemeralStorageCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := d.GetOk("ephemeral_storage"); !ok || len(v.([]interface{})) == 0 {
+		return nil
+	}
+
+	if !d.NewValueKnown("requires_compatibilities") {
+		return nil
+	}
+
+	compatibilities := d.Get("requires_compatibilities").(*schema.Set)
+
+	if compatibilities.Len() == 0 || compatibilities.Contains(ecs.CompatibilityFargate) {
+		return nil
+	}
+
+	return fmt.Errorf("ephemeral_storage is only supported for task definitions with %q in requires_compatibilities", ecs.CompatibilityFargate)
+}
+
 func resourceTaskDefinitionCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).ECSConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
-	rawDefinitions := d.Get("container_definitions").(string)
-	definitions, err := expandEcsContainerDefinitions(rawDefinitions)
-	if err != nil {
-		return err
+	var definitions []*ecs.ContainerDefinition
+	if v, ok := d.GetOk("container_definitions"); ok {
+		var err error
+		definitions, err = expandEcsContainerDefinitions(v.(string))
+		if err != nil {
+			return err
+		}
+	} else {
+		definitions = expandContainerDefinitionBlocks(d.Get("container_definition").([]interface{}))
 	}
 
 	input := ecs.RegisterTaskDefinitionInput{
@@ -497,7 +548,7 @@ func resourceTaskDefinitionCreate(d *schema.ResourceData, meta interface{}) erro
 	log.Printf("[DEBUG] Registering ECS task definition: %s", input)
 	out, err := conn.RegisterTaskDefinition(&input)
 	if err != nil {
-		return err
+		return create.Error(ResNameTaskDefinition, create.ErrActionCreating, d.Get("family").(string), err)
 	}
 
 	taskDefinition := *out.TaskDefinition // nosemgrep: prefer-aws-go-sdk-pointer-conversion-assignment // false positive
@@ -516,13 +567,20 @@ func resourceTaskDefinitionRead(d *schema.ResourceData, meta interface{}) error
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
+	// When tracking the latest revision, describe by family so that ECS returns the
+	// most recent ACTIVE revision, including any registered outside of Terraform.
+	trackedTaskDefinition := d.Get("arn").(string)
+	if d.Get("track_latest").(bool) {
+		trackedTaskDefinition = d.Get("family").(string)
+	}
+
 	log.Printf("[DEBUG] Reading task definition %s", d.Id())
 	out, err := conn.DescribeTaskDefinition(&ecs.DescribeTaskDefinitionInput{
-		TaskDefinition: aws.String(d.Get("arn").(string)),
+		TaskDefinition: aws.String(trackedTaskDefinition),
 		Include:        []*string{aws.String(ecs.TaskDefinitionFieldTags)},
 	})
 	if err != nil {
-		return err
+		return create.Error(ResNameTaskDefinition, create.ErrActionReading, d.Id(), err)
 	}
 	log.Printf("[DEBUG] Received task definition %s, status:%s\n %s", aws.StringValue(out.TaskDefinition.Family),
 		aws.StringValue(out.TaskDefinition.Status), out)
@@ -540,10 +598,11 @@ func resourceTaskDefinitionRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("family", taskDefinition.Family)
 	d.Set("revision", taskDefinition.Revision)
 
-	// Sort the lists of environment variables as they come in, so we won't get spurious reorderings in plans
+	// Sort the lists of environment variables and secrets as they come in, so we won't get spurious reorderings in plans
 	// (diff is suppressed if the environment variables haven't changed, but they still show in the plan if
 	// some other property changes).
 	containerDefinitions(taskDefinition.ContainerDefinitions).OrderEnvironmentVariables()
+	containerDefinitions(taskDefinition.ContainerDefinitions).OrderSecrets()
 
 	defs, err := flattenEcsContainerDefinitions(taskDefinition.ContainerDefinitions)
 	if err != nil {
@@ -554,6 +613,12 @@ func resourceTaskDefinitionRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	// The structured blocks are always set so that they are populated on import.
+	// They are computed, so configurations using the JSON document do not see a diff.
+	if err := d.Set("container_definition", flattenContainerDefinitionBlocks(taskDefinition.ContainerDefinitions)); err != nil {
+		return fmt.Errorf("error setting container_definition: %w", err)
+	}
+
 	d.Set("task_role_arn", taskDefinition.TaskRoleArn)
 	d.Set("execution_role_arn", taskDefinition.ExecutionRoleArn)
 	d.Set("cpu", taskDefinition.Cpu)
@@ -642,7 +707,7 @@ func resourceTaskDefinitionUpdate(d *schema.ResourceData, meta interface{}) erro
 		o, n := d.GetChange("tags_all")
 
 		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
-			return fmt.Errorf("error updating ECS Task Definition (%s) tags: %s", d.Id(), err)
+			return create.Error(ResNameTaskDefinition, create.ErrActionUpdatingTags, d.Id(), err)
 		}
 	}
 
@@ -650,13 +715,18 @@ func resourceTaskDefinitionUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceTaskDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
+	if v, ok := d.GetOk("skip_destroy"); ok && v.(bool) {
+		log.Printf("[DEBUG] Retaining ECS Task Definition revision %q", d.Id())
+		return nil
+	}
+
 	conn := meta.(*conns.AWSClient).ECSConn
 
 	_, err := conn.DeregisterTaskDefinition(&ecs.DeregisterTaskDefinitionInput{
 		TaskDefinition: aws.String(d.Get("arn").(string)),
 	})
 	if err != nil {
-		return err
+		return create.Error(ResNameTaskDefinition, create.ErrActionDeleting, d.Id(), err)
 	}
 
 	log.Printf("[DEBUG] Task definition %q deregistered.", d.Get("arn").(string))
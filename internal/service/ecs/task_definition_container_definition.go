@@ -0,0 +1,585 @@
+package ecs
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// containerDefinitionSchema returns the schema of the container_definition block,
+// a structured alternative to the container_definitions JSON document.
+func containerDefinitionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		ExactlyOneOf: []string{"container_definition", "container_definitions"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"command": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"cpu": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"depends_on": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"condition": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(ecs.ContainerCondition_Values(), false),
+							},
+							"container_name": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"entry_point": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"environment": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+							"value": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"essential": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+					Default:  true,
+				},
+				"health_check": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"command": {
+								Type:     schema.TypeList,
+								Required: true,
+								ForceNew: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"interval": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IntBetween(5, 300),
+							},
+							"retries": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IntBetween(1, 10),
+							},
+							"start_period": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IntBetween(0, 300),
+							},
+							"timeout": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IntBetween(2, 60),
+							},
+						},
+					},
+				},
+				"image": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"log_configuration": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"log_driver": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(ecs.LogDriver_Values(), false),
+							},
+							"options": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								ForceNew: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"secret_option": containerDefinitionSecretSchema(),
+						},
+					},
+				},
+				"memory": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(4),
+				},
+				"memory_reservation": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(4),
+				},
+				"mount_point": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"container_path": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+							"read_only": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								ForceNew: true,
+								Default:  false,
+							},
+							"source_volume": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringLenBetween(1, 255),
+				},
+				"port_mapping": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"container_port": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IsPortNumberOrZero,
+							},
+							"host_port": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IsPortNumberOrZero,
+							},
+							"protocol": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								Default:      ecs.TransportProtocolTcp,
+								ValidateFunc: validation.StringInSlice(ecs.TransportProtocol_Values(), false),
+							},
+						},
+					},
+				},
+				"privileged": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+				},
+				"readonly_root_filesystem": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+				},
+				"secret": containerDefinitionSecretSchema(),
+				"user": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+				"working_directory": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func containerDefinitionSecretSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"value_from": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func expandContainerDefinitionBlocks(tfList []interface{}) []*ecs.ContainerDefinition {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*ecs.ContainerDefinition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecs.ContainerDefinition{
+			Essential: aws.Bool(tfMap["essential"].(bool)),
+			Image:     aws.String(tfMap["image"].(string)),
+			Name:      aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["command"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Command = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["cpu"].(int); ok && v != 0 {
+			apiObject.Cpu = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["depends_on"].([]interface{}); ok && len(v) > 0 {
+			for _, tfMapRaw := range v {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				apiObject.DependsOn = append(apiObject.DependsOn, &ecs.ContainerDependency{
+					Condition:     aws.String(tfMap["condition"].(string)),
+					ContainerName: aws.String(tfMap["container_name"].(string)),
+				})
+			}
+		}
+
+		if v, ok := tfMap["entry_point"].([]interface{}); ok && len(v) > 0 {
+			apiObject.EntryPoint = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["environment"].(*schema.Set); ok && v.Len() > 0 {
+			for _, tfMapRaw := range v.List() {
+				tfMap := tfMapRaw.(map[string]interface{})
+
+				apiObject.Environment = append(apiObject.Environment, &ecs.KeyValuePair{
+					Name:  aws.String(tfMap["name"].(string)),
+					Value: aws.String(tfMap["value"].(string)),
+				})
+			}
+		}
+
+		if v, ok := tfMap["health_check"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.HealthCheck = expandContainerDefinitionHealthCheck(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["log_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.LogConfiguration = expandContainerDefinitionLogConfiguration(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["memory"].(int); ok && v != 0 {
+			apiObject.Memory = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["memory_reservation"].(int); ok && v != 0 {
+			apiObject.MemoryReservation = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["mount_point"].([]interface{}); ok && len(v) > 0 {
+			for _, tfMapRaw := range v {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				apiObject.MountPoints = append(apiObject.MountPoints, &ecs.MountPoint{
+					ContainerPath: aws.String(tfMap["container_path"].(string)),
+					ReadOnly:      aws.Bool(tfMap["read_only"].(bool)),
+					SourceVolume:  aws.String(tfMap["source_volume"].(string)),
+				})
+			}
+		}
+
+		if v, ok := tfMap["port_mapping"].([]interface{}); ok && len(v) > 0 {
+			for _, tfMapRaw := range v {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				portMapping := &ecs.PortMapping{
+					ContainerPort: aws.Int64(int64(tfMap["container_port"].(int))),
+					Protocol:      aws.String(tfMap["protocol"].(string)),
+				}
+
+				if v, ok := tfMap["host_port"].(int); ok && v != 0 {
+					portMapping.HostPort = aws.Int64(int64(v))
+				}
+
+				apiObject.PortMappings = append(apiObject.PortMappings, portMapping)
+			}
+		}
+
+		if v, ok := tfMap["privileged"].(bool); ok && v {
+			apiObject.Privileged = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["readonly_root_filesystem"].(bool); ok && v {
+			apiObject.ReadonlyRootFilesystem = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["secret"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Secrets = expandContainerDefinitionSecrets(v.List())
+		}
+
+		if v, ok := tfMap["user"].(string); ok && v != "" {
+			apiObject.User = aws.String(v)
+		}
+
+		if v, ok := tfMap["working_directory"].(string); ok && v != "" {
+			apiObject.WorkingDirectory = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandContainerDefinitionHealthCheck(tfMap map[string]interface{}) *ecs.HealthCheck {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ecs.HealthCheck{
+		Command: flex.ExpandStringList(tfMap["command"].([]interface{})),
+	}
+
+	if v, ok := tfMap["interval"].(int); ok && v != 0 {
+		apiObject.Interval = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["retries"].(int); ok && v != 0 {
+		apiObject.Retries = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["start_period"].(int); ok && v != 0 {
+		apiObject.StartPeriod = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["timeout"].(int); ok && v != 0 {
+		apiObject.Timeout = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandContainerDefinitionLogConfiguration(tfMap map[string]interface{}) *ecs.LogConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ecs.LogConfiguration{
+		LogDriver: aws.String(tfMap["log_driver"].(string)),
+	}
+
+	if v, ok := tfMap["options"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Options = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["secret_option"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SecretOptions = expandContainerDefinitionSecrets(v.List())
+	}
+
+	return apiObject
+}
+
+func expandContainerDefinitionSecrets(tfList []interface{}) []*ecs.Secret {
+	var apiObjects []*ecs.Secret
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.Secret{
+			Name:      aws.String(tfMap["name"].(string)),
+			ValueFrom: aws.String(tfMap["value_from"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenContainerDefinitionBlocks(apiObjects []*ecs.ContainerDefinition) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"command":                  aws.StringValueSlice(apiObject.Command),
+			"cpu":                      aws.Int64Value(apiObject.Cpu),
+			"entry_point":              aws.StringValueSlice(apiObject.EntryPoint),
+			"essential":                aws.BoolValue(apiObject.Essential),
+			"image":                    aws.StringValue(apiObject.Image),
+			"memory":                   aws.Int64Value(apiObject.Memory),
+			"memory_reservation":       aws.Int64Value(apiObject.MemoryReservation),
+			"name":                     aws.StringValue(apiObject.Name),
+			"privileged":               aws.BoolValue(apiObject.Privileged),
+			"readonly_root_filesystem": aws.BoolValue(apiObject.ReadonlyRootFilesystem),
+			"secret":                   flattenContainerDefinitionSecrets(apiObject.Secrets),
+			"user":                     aws.StringValue(apiObject.User),
+			"working_directory":        aws.StringValue(apiObject.WorkingDirectory),
+		}
+
+		var dependsOn []interface{}
+		for _, v := range apiObject.DependsOn {
+			dependsOn = append(dependsOn, map[string]interface{}{
+				"condition":      aws.StringValue(v.Condition),
+				"container_name": aws.StringValue(v.ContainerName),
+			})
+		}
+		tfMap["depends_on"] = dependsOn
+
+		var environment []interface{}
+		for _, v := range apiObject.Environment {
+			environment = append(environment, map[string]interface{}{
+				"name":  aws.StringValue(v.Name),
+				"value": aws.StringValue(v.Value),
+			})
+		}
+		tfMap["environment"] = environment
+
+		if v := apiObject.HealthCheck; v != nil {
+			tfMap["health_check"] = []interface{}{map[string]interface{}{
+				"command":      aws.StringValueSlice(v.Command),
+				"interval":     aws.Int64Value(v.Interval),
+				"retries":      aws.Int64Value(v.Retries),
+				"start_period": aws.Int64Value(v.StartPeriod),
+				"timeout":      aws.Int64Value(v.Timeout),
+			}}
+		}
+
+		if v := apiObject.LogConfiguration; v != nil {
+			tfMap["log_configuration"] = []interface{}{map[string]interface{}{
+				"log_driver":    aws.StringValue(v.LogDriver),
+				"options":       aws.StringValueMap(v.Options),
+				"secret_option": flattenContainerDefinitionSecrets(v.SecretOptions),
+			}}
+		}
+
+		var mountPoints []interface{}
+		for _, v := range apiObject.MountPoints {
+			mountPoints = append(mountPoints, map[string]interface{}{
+				"container_path": aws.StringValue(v.ContainerPath),
+				"read_only":      aws.BoolValue(v.ReadOnly),
+				"source_volume":  aws.StringValue(v.SourceVolume),
+			})
+		}
+		tfMap["mount_point"] = mountPoints
+
+		var portMappings []interface{}
+		for _, v := range apiObject.PortMappings {
+			portMappings = append(portMappings, map[string]interface{}{
+				"container_port": aws.Int64Value(v.ContainerPort),
+				"host_port":      aws.Int64Value(v.HostPort),
+				"protocol":       aws.StringValue(v.Protocol),
+			})
+		}
+		tfMap["port_mapping"] = portMappings
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenContainerDefinitionSecrets(apiObjects []*ecs.Secret) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":       aws.StringValue(apiObject.Name),
+			"value_from": aws.StringValue(apiObject.ValueFrom),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,129 @@
+package ecs
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+)
+
+func DataSourceTaskDefinitionContainerDefinition() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTaskDefinitionContainerDefinitionRead,
+
+		Schema: map[string]*schema.Schema{
+			"cpu": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"environment": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"essential": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"image": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"memory": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"memory_reservation": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"secrets": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"task_definition": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"task_definition_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceTaskDefinitionContainerDefinitionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ECSConn
+
+	taskDefinitionName := d.Get("task_definition").(string)
+	input := &ecs.DescribeTaskDefinitionInput{
+		TaskDefinition: aws.String(taskDefinitionName),
+	}
+
+	log.Printf("[DEBUG] Reading ECS Task Definition: %s", input)
+	output, err := conn.DescribeTaskDefinition(input)
+
+	if err != nil {
+		return create.Error(ResNameTaskDefinition, create.ErrActionReading, taskDefinitionName, err)
+	}
+
+	if output == nil || output.TaskDefinition == nil {
+		return create.Error(ResNameTaskDefinition, create.ErrActionReading, taskDefinitionName, errors.New("empty response"))
+	}
+
+	taskDefinition := output.TaskDefinition
+	name := d.Get("name").(string)
+
+	var container *ecs.ContainerDefinition
+
+	for _, v := range taskDefinition.ContainerDefinitions {
+		if aws.StringValue(v.Name) == name {
+			container = v
+			break
+		}
+	}
+
+	if container == nil {
+		return fmt.Errorf("container with name %q not found in ECS Task Definition (%s)", name, taskDefinitionName)
+	}
+
+	taskDefinitionARN := aws.StringValue(taskDefinition.TaskDefinitionArn)
+	d.SetId(fmt.Sprintf("%s/%s", taskDefinitionARN, name))
+	d.Set("cpu", container.Cpu)
+
+	environment := make(map[string]string, len(container.Environment))
+	for _, v := range container.Environment {
+		environment[aws.StringValue(v.Name)] = aws.StringValue(v.Value)
+	}
+	if err := d.Set("environment", environment); err != nil {
+		return fmt.Errorf("error setting environment: %w", err)
+	}
+
+	d.Set("essential", container.Essential)
+	d.Set("image", container.Image)
+	d.Set("memory", container.Memory)
+	d.Set("memory_reservation", container.MemoryReservation)
+
+	secrets := make(map[string]string, len(container.Secrets))
+	for _, v := range container.Secrets {
+		secrets[aws.StringValue(v.Name)] = aws.StringValue(v.ValueFrom)
+	}
+	if err := d.Set("secrets", secrets); err != nil {
+		return fmt.Errorf("error setting secrets: %w", err)
+	}
+
+	d.Set("task_definition_arn", taskDefinitionARN)
+
+	return nil
+}
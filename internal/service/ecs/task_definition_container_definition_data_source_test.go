@@ -0,0 +1,126 @@
+package ecs_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ecs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccECSTaskDefinitionContainerDefinitionDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ecs_task_definition_container_definition.test"
+	resourceName := "aws_ecs_task_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskDefinitionContainerDefinitionDataSourceConfig(rName, "sidecar"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cpu", "64"),
+					resource.TestCheckResourceAttr(dataSourceName, "environment.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "environment.MODE", "proxy"),
+					resource.TestCheckResourceAttr(dataSourceName, "essential", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "image", "envoyproxy/envoy:v1.20.0"),
+					resource.TestCheckResourceAttr(dataSourceName, "memory", "64"),
+					resource.TestCheckResourceAttr(dataSourceName, "memory_reservation", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "sidecar"),
+					resource.TestCheckResourceAttr(dataSourceName, "secrets.%", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "secrets.TOKEN", "aws_ssm_parameter.test", "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "task_definition_arn", resourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSTaskDefinitionContainerDefinitionDataSource_notFound(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTaskDefinitionContainerDefinitionDataSourceConfig(rName, "missing"),
+				ExpectError: regexp.MustCompile(`container with name "missing" not found`),
+			},
+		},
+	})
+}
+
+func testAccTaskDefinitionContainerDefinitionDataSourceConfig(rName, containerName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name  = %[1]q
+  type  = "SecureString"
+  value = "secret"
+}
+
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ecs-tasks.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family             = %[1]q
+  execution_role_arn = aws_iam_role.test.arn
+
+  container_definitions = jsonencode([
+    {
+      name      = "app"
+      image     = "nginx:latest"
+      cpu       = 128
+      memory    = 128
+      essential = true
+    },
+    {
+      name      = "sidecar"
+      image     = "envoyproxy/envoy:v1.20.0"
+      cpu       = 64
+      memory    = 64
+      essential = false
+
+      environment = [
+        {
+          name  = "MODE"
+          value = "proxy"
+        },
+      ]
+
+      secrets = [
+        {
+          name      = "TOKEN"
+          valueFrom = aws_ssm_parameter.test.arn
+        },
+      ]
+    },
+  ])
+}
+
+data "aws_ecs_task_definition_container_definition" "test" {
+  task_definition = aws_ecs_task_definition.test.arn
+  name            = %[2]q
+}
+`, rName, containerName)
+}
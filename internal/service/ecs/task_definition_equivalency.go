@@ -58,6 +58,7 @@ type containerDefinitions []*ecs.ContainerDefinition
 func (cd containerDefinitions) Reduce(isAWSVPC bool) error {
 	// Deal with fields which may be re-ordered in the API
 	cd.OrderEnvironmentVariables()
+	cd.OrderSecrets()
 
 	for i, def := range cd {
 		// Deal with special fields which have defaults
@@ -79,6 +80,31 @@ func (cd containerDefinitions) Reduce(isAWSVPC bool) error {
 			}
 		}
 
+		// ECS fills in the health check timings that were not specified
+		if hc := def.HealthCheck; hc != nil {
+			if hc.Interval == nil {
+				hc.Interval = aws.Int64(30)
+			}
+			if hc.Retries == nil {
+				hc.Retries = aws.Int64(3)
+			}
+			if hc.StartPeriod != nil && *hc.StartPeriod == 0 {
+				hc.StartPeriod = nil
+			}
+			if hc.Timeout == nil {
+				hc.Timeout = aws.Int64(5)
+			}
+		}
+
+		if lc := def.LogConfiguration; lc != nil {
+			if len(lc.Options) == 0 {
+				lc.Options = nil
+			}
+			if len(lc.SecretOptions) == 0 {
+				lc.SecretOptions = nil
+			}
+		}
+
 		// Create a mutable copy
 		defCopy, err := copystructure.Copy(def)
 		if err != nil {
@@ -89,8 +115,8 @@ func (cd containerDefinitions) Reduce(isAWSVPC bool) error {
 		for i := 0; i < definition.NumField(); i++ {
 			sf := definition.Field(i)
 
-			// Set all empty slices to nil
-			if sf.Kind() == reflect.Slice {
+			// Set all empty slices and maps to nil
+			if sf.Kind() == reflect.Slice || sf.Kind() == reflect.Map {
 				if sf.IsValid() && !sf.IsNil() && sf.Len() == 0 {
 					sf.Set(reflect.Zero(sf.Type()))
 				}
@@ -109,3 +135,11 @@ func (cd containerDefinitions) OrderEnvironmentVariables() {
 		})
 	}
 }
+
+func (cd containerDefinitions) OrderSecrets() {
+	for _, def := range cd {
+		sort.Slice(def.Secrets, func(i, j int) bool {
+			return aws.StringValue(def.Secrets[i].Name) < aws.StringValue(def.Secrets[j].Name)
+		})
+	}
+}
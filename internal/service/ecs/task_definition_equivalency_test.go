@@ -439,6 +439,109 @@ func TestContainerDefinitionsAreEquivalent_arrays(t *testing.T) {
 	}
 }
 
+func TestContainerDefinitionsAreEquivalent_apiDefaults(t *testing.T) {
+	cfgRepresention := `
+[
+    {
+      "name": "wordpress",
+      "image": "wordpress",
+      "memory": 500,
+      "dockerLabels": {},
+      "secrets": [
+        {"name": "SECRET_B", "valueFrom": "arn:aws:ssm:us-west-2:123456789012:parameter/b"},
+        {"name": "SECRET_A", "valueFrom": "arn:aws:ssm:us-west-2:123456789012:parameter/a"}
+      ],
+      "healthCheck": {
+        "command": ["CMD-SHELL", "exit 0"]
+      },
+      "logConfiguration": {
+        "logDriver": "awslogs",
+        "options": {
+          "awslogs-group": "wordpress"
+        },
+        "secretOptions": []
+      }
+    }
+]`
+
+	apiRepresentation := `
+[
+    {
+        "name": "wordpress",
+        "image": "wordpress",
+        "cpu": 0,
+        "memory": 500,
+        "essential": true,
+        "environment": [],
+        "mountPoints": [],
+        "volumesFrom": [],
+        "secrets": [
+            {"name": "SECRET_A", "valueFrom": "arn:aws:ssm:us-west-2:123456789012:parameter/a"},
+            {"name": "SECRET_B", "valueFrom": "arn:aws:ssm:us-west-2:123456789012:parameter/b"}
+        ],
+        "healthCheck": {
+            "command": ["CMD-SHELL", "exit 0"],
+            "interval": 30,
+            "timeout": 5,
+            "retries": 3
+        },
+        "logConfiguration": {
+            "logDriver": "awslogs",
+            "options": {
+                "awslogs-group": "wordpress"
+            }
+        }
+    }
+]`
+
+	equal, err := tfecs.ContainerDefinitionsAreEquivalent(cfgRepresention, apiRepresentation, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equal {
+		t.Fatal("Expected definitions to be equal.")
+	}
+}
+
+func TestContainerDefinitionsAreEquivalent_healthCheckNegative(t *testing.T) {
+	cfgRepresention := `
+[
+    {
+      "name": "wordpress",
+      "image": "wordpress",
+      "memory": 500,
+      "healthCheck": {
+        "command": ["CMD-SHELL", "exit 0"],
+        "interval": 10
+      }
+    }
+]`
+
+	apiRepresentation := `
+[
+    {
+        "name": "wordpress",
+        "image": "wordpress",
+        "memory": 500,
+        "essential": true,
+        "healthCheck": {
+            "command": ["CMD-SHELL", "exit 0"],
+            "interval": 30,
+            "timeout": 5,
+            "retries": 3
+        }
+    }
+]`
+
+	equal, err := tfecs.ContainerDefinitionsAreEquivalent(cfgRepresention, apiRepresentation, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if equal {
+		t.Fatal("Expected definitions to differ.")
+	}
+}
+
 func TestContainerDefinitionsAreEquivalent_negative(t *testing.T) {
 	cfgRepresention := `
 [
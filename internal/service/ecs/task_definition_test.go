@@ -711,6 +711,23 @@ func TestAccECSTaskDefinition_Fargate_ephemeralStorage(t *testing.T) {
 	})
 }
 
+func TestAccECSTaskDefinition_External_ephemeralStorage(t *testing.T) {
+	tdName := sdkacctest.RandomWithPrefix("tf-acc-td-external")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTaskDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTaskDefinitionExternalEphemeralStorage(tdName),
+				ExpectError: regexp.MustCompile(`ephemeral_storage is only supported for task definitions with "FARGATE"`),
+			},
+		},
+	})
+}
+
 func TestAccECSTaskDefinition_executionRole(t *testing.T) {
 	var conf ecs.TaskDefinition
 
@@ -883,6 +900,144 @@ func TestAccECSTaskDefinition_inferenceAccelerator(t *testing.T) {
 	})
 }
 
+func TestAccECSTaskDefinition_containerDefinitionBlock(t *testing.T) {
+	var def ecs.TaskDefinition
+
+	tdName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTaskDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskDefinitionContainerDefinitionBlockConfig(tdName, "nginx:latest"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.name", "web"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.image", "nginx:latest"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.cpu", "128"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.memory", "256"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.essential", "true"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.port_mapping.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.port_mapping.0.container_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.port_mapping.0.protocol", "tcp"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.environment.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "container_definition.0.environment.*", map[string]string{
+						"name":  "ENV",
+						"value": "test",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.depends_on.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.depends_on.0.container_name", "sidecar"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.depends_on.0.condition", "START"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.health_check.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.health_check.0.command.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.health_check.0.interval", "30"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.health_check.0.retries", "3"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.1.name", "sidecar"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.1.essential", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "container_definitions"),
+				),
+			},
+			{
+				Config: testAccTaskDefinitionContainerDefinitionBlockConfig(tdName, "nginx:stable"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.image", "nginx:stable"),
+					resource.TestCheckResourceAttr(resourceName, "revision", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccTaskDefinitionImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccECSTaskDefinition_trackLatest(t *testing.T) {
+	var def ecs.TaskDefinition
+
+	tdName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTaskDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskDefinitionTrackLatestConfig(tdName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "revision", "1"),
+					resource.TestCheckResourceAttr(resourceName, "track_latest", "true"),
+					testAccCheckTaskDefinitionRegisterRevision(&def),
+				),
+			},
+			{
+				Config: testAccTaskDefinitionTrackLatestConfig(tdName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "revision", "2"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "ecs", fmt.Sprintf("task-definition/%s:2", tdName)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"track_latest"},
+			},
+		},
+	})
+}
+
+func TestAccECSTaskDefinition_skipDestroy(t *testing.T) {
+	var def ecs.TaskDefinition
+
+	tdName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTaskDefinitionDestroySkipped,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskDefinitionSkipDestroyConfig(tdName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "skip_destroy", "true"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckTaskDefinitionRegisterRevision registers a new revision of the
+// task definition's family outside of Terraform, as a CI/CD pipeline would.
+func testAccCheckTaskDefinitionRegisterRevision(def *ecs.TaskDefinition) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECSConn
+
+		_, err := conn.RegisterTaskDefinition(&ecs.RegisterTaskDefinitionInput{
+			ContainerDefinitions: def.ContainerDefinitions,
+			Family:               def.Family,
+		})
+
+		return err
+	}
+}
+
 func testAccTaskDefinitionProxyConfigurationConfig(rName string, containerName string, proxyType string,
 	ignoredUid string, ignoredGid string, appPorts string, proxyIngressPort string, proxyEgressPort string,
 	egressIgnoredPorts string, egressIgnoredIPs string) string {
@@ -1047,6 +1202,40 @@ func testAccCheckTaskDefinitionDestroy(s *terraform.State) error {
 	return nil
 }
 
+// testAccCheckTaskDefinitionDestroySkipped verifies that task definition
+// revisions were left ACTIVE and then deregisters them to clean up.
+func testAccCheckTaskDefinitionDestroySkipped(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ECSConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ecs_task_definition" {
+			continue
+		}
+
+		out, err := conn.DescribeTaskDefinition(&ecs.DescribeTaskDefinitionInput{
+			TaskDefinition: aws.String(rs.Primary.Attributes["arn"]),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if status := aws.StringValue(out.TaskDefinition.Status); status != ecs.TaskDefinitionStatusActive {
+			return fmt.Errorf("ECS task definition (%s) status is %s, expected %s", rs.Primary.Attributes["arn"], status, ecs.TaskDefinitionStatusActive)
+		}
+
+		_, err = conn.DeregisterTaskDefinition(&ecs.DeregisterTaskDefinitionInput{
+			TaskDefinition: aws.String(rs.Primary.Attributes["arn"]),
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func testAccCheckTaskDefinitionExists(name string, def *ecs.TaskDefinition) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -1416,6 +1605,32 @@ TASK_DEFINITION
 `, tdName, portMappings)
 }
 
+func testAccTaskDefinitionExternalEphemeralStorage(tdName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test" {
+  family                   = %[1]q
+  requires_compatibilities = ["EXTERNAL"]
+
+  ephemeral_storage {
+    size_in_gib = 30
+  }
+
+  container_definitions = <<TASK_DEFINITION
+[
+  {
+    "name": "sleep",
+    "image": "busybox",
+    "cpu": 10,
+    "command": ["sleep","360"],
+    "memory": 10,
+    "essential": true
+  }
+]
+TASK_DEFINITION
+}
+`, tdName)
+}
+
 func testAccTaskDefinitionExecutionRole(roleName, policyName, tdName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "test" {
@@ -2273,6 +2488,73 @@ DEFINITION
 `, rName, rName, tag1Key, tag1Value, tag2Key, tag2Value)
 }
 
+func testAccTaskDefinitionContainerDefinitionBlockConfig(tdName, image string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definition {
+    name   = "web"
+    image  = %[2]q
+    cpu    = 128
+    memory = 256
+
+    port_mapping {
+      container_port = 80
+    }
+
+    environment {
+      name  = "ENV"
+      value = "test"
+    }
+
+    environment {
+      name  = "LOG_LEVEL"
+      value = "debug"
+    }
+
+    depends_on {
+      container_name = "sidecar"
+      condition      = "START"
+    }
+
+    health_check {
+      command = ["CMD-SHELL", "curl -f http://localhost/ || exit 1"]
+    }
+  }
+
+  container_definition {
+    name      = "sidecar"
+    image     = "busybox:latest"
+    essential = false
+    memory    = 64
+    command   = ["sleep", "3600"]
+  }
+}
+`, tdName, image)
+}
+
+func testAccTaskDefinitionTrackLatestConfig(tdName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test" {
+  family       = %[1]q
+  track_latest = true
+
+  container_definitions = <<TASK_DEFINITION
+[
+  {
+    "cpu": 10,
+    "essential": true,
+    "image": "jenkins",
+    "memory": 128,
+    "name": "jenkins"
+  }
+]
+TASK_DEFINITION
+}
+`, tdName)
+}
+
 func testAccTaskDefinitionInferenceAcceleratorConfig(tdName string) string {
 	return fmt.Sprintf(`
 resource "aws_ecs_task_definition" "test" {
@@ -2467,3 +2749,24 @@ resource "aws_fsx_windows_file_system" "test" {
 }
 `
 }
+
+func testAccTaskDefinitionSkipDestroyConfig(tdName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test" {
+  family       = %[1]q
+  skip_destroy = true
+
+  container_definitions = <<TASK_DEFINITION
+[
+  {
+    "cpu": 10,
+    "essential": true,
+    "image": "jenkins",
+    "memory": 128,
+    "name": "jenkins"
+  }
+]
+TASK_DEFINITION
+}
+`, tdName)
+}
@@ -0,0 +1,646 @@
+package ecs
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	ResNameTaskSet = "ECS Task Set"
+)
+
+func ResourceTaskSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTaskSetCreate,
+		Read:   resourceTaskSetRead,
+		Update: resourceTaskSetUpdate,
+		Delete: resourceTaskSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"capacity_provider_strategy": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"launch_type"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"base": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 100000),
+						},
+						"capacity_provider": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 1000),
+						},
+					},
+				},
+			},
+			"cluster": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"external_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"force_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"launch_type": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"capacity_provider_strategy"},
+				ValidateFunc:  validation.StringInSlice(ecs.LaunchType_Values(), false),
+			},
+			"load_balancer": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"container_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 65536),
+						},
+						"load_balancer_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"target_group_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"network_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"assign_public_ip": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  false,
+						},
+						"security_groups": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 5,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnets": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 16,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"platform_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"scale": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"unit": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      ecs.ScaleUnitPercent,
+							ValidateFunc: validation.StringInSlice(ecs.ScaleUnit_Values(), false),
+						},
+						"value": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatBetween(0.0, 100.0),
+						},
+					},
+				},
+			},
+			"service": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"service_registries": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"container_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 65536),
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 65536),
+						},
+						"registry_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"stability_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"task_definition": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"task_set_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"wait_until_stable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"wait_until_stable_timeout": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "10m",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					duration, err := time.ParseDuration(v.(string))
+					switch {
+					case err != nil:
+						errors = append(errors, err)
+					case duration < 0:
+						errors = append(errors, fmt.Errorf("%q must be greater than zero", k))
+					}
+					return
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceTaskSetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ECSConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	cluster := d.Get("cluster").(string)
+	service := d.Get("service").(string)
+	input := &ecs.CreateTaskSetInput{
+		ClientToken:    aws.String(resource.UniqueId()),
+		Cluster:        aws.String(cluster),
+		Service:        aws.String(service),
+		TaskDefinition: aws.String(d.Get("task_definition").(string)),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	if v, ok := d.GetOk("capacity_provider_strategy"); ok && v.(*schema.Set).Len() > 0 {
+		input.CapacityProviderStrategy = expandEcsCapacityProviderStrategy(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("external_id"); ok {
+		input.ExternalId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("launch_type"); ok {
+		input.LaunchType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("load_balancer"); ok && v.(*schema.Set).Len() > 0 {
+		input.LoadBalancers = expandTaskSetLoadBalancers(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("network_configuration"); ok {
+		input.NetworkConfiguration = expandEcsNetworkConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("platform_version"); ok {
+		input.PlatformVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("scale"); ok && len(v.([]interface{})) > 0 {
+		input.Scale = expandTaskSetScale(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("service_registries"); ok && len(v.([]interface{})) > 0 {
+		input.ServiceRegistries = expandTaskSetServiceRegistries(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating ECS Task Set: %s", input)
+
+	// Retry due to AWS IAM & ECS eventual consistency
+	var output *ecs.CreateTaskSetOutput
+	err := resource.Retry(tfiam.PropagationTimeout, func() *resource.RetryError {
+		var err error
+		output, err = conn.CreateTaskSet(input)
+
+		if tfawserr.ErrCodeEquals(err, ecs.ErrCodeClusterNotFoundException, ecs.ErrCodeServiceNotFoundException) {
+			return resource.RetryableError(err)
+		}
+
+		if tfawserr.ErrMessageContains(err, ecs.ErrCodeInvalidParameterException, "does not have an associated load balancer") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		output, err = conn.CreateTaskSet(input)
+	}
+
+	if err != nil {
+		return create.Error(ResNameTaskSet, create.ErrActionCreating, "", err)
+	}
+
+	if output == nil || output.TaskSet == nil {
+		return create.Error(ResNameTaskSet, create.ErrActionCreating, "", errors.New("empty response"))
+	}
+
+	taskSetID := aws.StringValue(output.TaskSet.Id)
+
+	d.SetId(TaskSetCreateResourceID(taskSetID, service, cluster))
+
+	if d.Get("wait_until_stable").(bool) {
+		timeout, _ := time.ParseDuration(d.Get("wait_until_stable_timeout").(string))
+
+		if _, err := waitTaskSetStable(conn, taskSetID, service, cluster, timeout); err != nil {
+			return create.Error(ResNameTaskSet, create.ErrActionWaitingForCreation, d.Id(), err)
+		}
+	}
+
+	return resourceTaskSetRead(d, meta)
+}
+
+func resourceTaskSetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ECSConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	taskSetID, service, cluster, err := TaskSetParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	taskSet, err := FindTaskSetByID(conn, taskSetID, service, cluster)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ECS Task Set (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.Error(ResNameTaskSet, create.ErrActionReading, d.Id(), err)
+	}
+
+	d.Set("arn", taskSet.TaskSetArn)
+	d.Set("cluster", cluster)
+	d.Set("external_id", taskSet.ExternalId)
+	d.Set("launch_type", taskSet.LaunchType)
+	d.Set("platform_version", taskSet.PlatformVersion)
+	d.Set("service", service)
+	d.Set("stability_status", taskSet.StabilityStatus)
+	d.Set("status", taskSet.Status)
+	d.Set("task_definition", taskSet.TaskDefinition)
+	d.Set("task_set_id", taskSet.Id)
+
+	if err := d.Set("capacity_provider_strategy", flattenEcsCapacityProviderStrategy(taskSet.CapacityProviderStrategy)); err != nil {
+		return fmt.Errorf("error setting capacity_provider_strategy: %w", err)
+	}
+
+	if err := d.Set("load_balancer", flattenTaskSetLoadBalancers(taskSet.LoadBalancers)); err != nil {
+		return fmt.Errorf("error setting load_balancer: %w", err)
+	}
+
+	if err := d.Set("network_configuration", flattenEcsNetworkConfiguration(taskSet.NetworkConfiguration)); err != nil {
+		return fmt.Errorf("error setting network_configuration: %w", err)
+	}
+
+	if err := d.Set("scale", flattenTaskSetScale(taskSet.Scale)); err != nil {
+		return fmt.Errorf("error setting scale: %w", err)
+	}
+
+	if err := d.Set("service_registries", flattenServiceRegistries(taskSet.ServiceRegistries)); err != nil {
+		return fmt.Errorf("error setting service_registries: %w", err)
+	}
+
+	tags := KeyValueTags(taskSet.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceTaskSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ECSConn
+
+	if d.HasChanges("scale") {
+		taskSetID, service, cluster, err := TaskSetParseResourceID(d.Id())
+
+		if err != nil {
+			return err
+		}
+
+		input := &ecs.UpdateTaskSetInput{
+			Cluster: aws.String(cluster),
+			Scale:   expandTaskSetScale(d.Get("scale").([]interface{})),
+			Service: aws.String(service),
+			TaskSet: aws.String(taskSetID),
+		}
+
+		log.Printf("[DEBUG] Updating ECS Task Set: %s", input)
+		_, err = conn.UpdateTaskSet(input)
+
+		if err != nil {
+			return create.Error(ResNameTaskSet, create.ErrActionUpdating, d.Id(), err)
+		}
+
+		if d.Get("wait_until_stable").(bool) {
+			timeout, _ := time.ParseDuration(d.Get("wait_until_stable_timeout").(string))
+
+			if _, err := waitTaskSetStable(conn, taskSetID, service, cluster, timeout); err != nil {
+				return create.Error(ResNameTaskSet, create.ErrActionWaitingForUpdate, d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return create.Error(ResNameTaskSet, create.ErrActionUpdatingTags, d.Id(), err)
+		}
+	}
+
+	return resourceTaskSetRead(d, meta)
+}
+
+func resourceTaskSetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ECSConn
+
+	taskSetID, service, cluster, err := TaskSetParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting ECS Task Set: %s", d.Id())
+	_, err = conn.DeleteTaskSet(&ecs.DeleteTaskSetInput{
+		Cluster: aws.String(cluster),
+		Force:   aws.Bool(d.Get("force_delete").(bool)),
+		Service: aws.String(service),
+		TaskSet: aws.String(taskSetID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ecs.ErrCodeClusterNotFoundException, ecs.ErrCodeServiceNotFoundException, ecs.ErrCodeTaskSetNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.Error(ResNameTaskSet, create.ErrActionDeleting, d.Id(), err)
+	}
+
+	if _, err := waitTaskSetDeleted(conn, taskSetID, service, cluster); err != nil {
+		return create.Error(ResNameTaskSet, create.ErrActionWaitingForDeletion, d.Id(), err)
+	}
+
+	return nil
+}
+
+const taskSetResourceIDSeparator = ","
+
+func TaskSetCreateResourceID(taskSetID, service, cluster string) string {
+	parts := []string{taskSetID, service, cluster}
+	id := strings.Join(parts, taskSetResourceIDSeparator)
+
+	return id
+}
+
+func TaskSetParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, taskSetResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected TASK_SET_ID%[2]sSERVICE%[2]sCLUSTER", id, taskSetResourceIDSeparator)
+}
+
+func expandTaskSetLoadBalancers(tfList []interface{}) []*ecs.LoadBalancer {
+	apiObjects := make([]*ecs.LoadBalancer, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecs.LoadBalancer{
+			ContainerName: aws.String(tfMap["container_name"].(string)),
+		}
+
+		if v, ok := tfMap["container_port"].(int); ok && v != 0 {
+			apiObject.ContainerPort = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["load_balancer_name"].(string); ok && v != "" {
+			apiObject.LoadBalancerName = aws.String(v)
+		}
+
+		if v, ok := tfMap["target_group_arn"].(string); ok && v != "" {
+			apiObject.TargetGroupArn = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenTaskSetLoadBalancers(apiObjects []*ecs.LoadBalancer) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"container_name":     aws.StringValue(apiObject.ContainerName),
+			"container_port":     aws.Int64Value(apiObject.ContainerPort),
+			"load_balancer_name": aws.StringValue(apiObject.LoadBalancerName),
+			"target_group_arn":   aws.StringValue(apiObject.TargetGroupArn),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func expandTaskSetScale(tfList []interface{}) *ecs.Scale {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &ecs.Scale{}
+
+	if v, ok := tfMap["unit"].(string); ok && v != "" {
+		apiObject.Unit = aws.String(v)
+	}
+
+	if v, ok := tfMap["value"].(float64); ok {
+		apiObject.Value = aws.Float64(v)
+	}
+
+	return apiObject
+}
+
+func flattenTaskSetScale(apiObject *ecs.Scale) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"unit":  aws.StringValue(apiObject.Unit),
+		"value": aws.Float64Value(apiObject.Value),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandTaskSetServiceRegistries(tfList []interface{}) []*ecs.ServiceRegistry {
+	apiObjects := make([]*ecs.ServiceRegistry, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecs.ServiceRegistry{
+			RegistryArn: aws.String(tfMap["registry_arn"].(string)),
+		}
+
+		if v, ok := tfMap["container_name"].(string); ok && v != "" {
+			apiObject.ContainerName = aws.String(v)
+		}
+
+		if v, ok := tfMap["container_port"].(int); ok && v != 0 {
+			apiObject.ContainerPort = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["port"].(int); ok && v != 0 {
+			apiObject.Port = aws.Int64(int64(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
@@ -0,0 +1,317 @@
+package ecs_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ecs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfecs "github.com/hashicorp/terraform-provider-aws/internal/service/ecs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccECSTaskSet_basic(t *testing.T) {
+	var taskSet ecs.TaskSet
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTaskSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskSetConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskSetExists(resourceName, &taskSet),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ecs", regexp.MustCompile(fmt.Sprintf("task-set/%[1]s/%[1]s/ecs-svc/.+", rName))),
+					resource.TestCheckResourceAttr(resourceName, "launch_type", ecs.LaunchTypeEc2),
+					resource.TestCheckResourceAttr(resourceName, "load_balancer.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "scale.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "service_registries.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"force_delete",
+					"wait_until_stable",
+					"wait_until_stable_timeout",
+				},
+			},
+		},
+	})
+}
+
+func TestAccECSTaskSet_disappears(t *testing.T) {
+	var taskSet ecs.TaskSet
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTaskSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskSetConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskSetExists(resourceName, &taskSet),
+					acctest.CheckResourceDisappears(acctest.Provider, tfecs.ResourceTaskSet(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccECSTaskSet_scale(t *testing.T) {
+	var taskSet ecs.TaskSet
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTaskSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskSetScaleConfig(rName, 0.0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskSetExists(resourceName, &taskSet),
+					resource.TestCheckResourceAttr(resourceName, "scale.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scale.0.unit", ecs.ScaleUnitPercent),
+					resource.TestCheckResourceAttr(resourceName, "scale.0.value", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"force_delete",
+					"wait_until_stable",
+					"wait_until_stable_timeout",
+				},
+			},
+			{
+				Config: testAccTaskSetScaleConfig(rName, 100.0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskSetExists(resourceName, &taskSet),
+					resource.TestCheckResourceAttr(resourceName, "scale.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scale.0.unit", ecs.ScaleUnitPercent),
+					resource.TestCheckResourceAttr(resourceName, "scale.0.value", "100"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSTaskSet_tags(t *testing.T) {
+	var taskSet ecs.TaskSet
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTaskSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskSetTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskSetExists(resourceName, &taskSet),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"force_delete",
+					"wait_until_stable",
+					"wait_until_stable_timeout",
+				},
+			},
+			{
+				Config: testAccTaskSetTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskSetExists(resourceName, &taskSet),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccTaskSetTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskSetExists(resourceName, &taskSet),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTaskSetExists(n string, v *ecs.TaskSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ECS Task Set ID is set")
+		}
+
+		taskSetID, service, cluster, err := tfecs.TaskSetParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECSConn
+
+		output, err := tfecs.FindTaskSetByID(conn, taskSetID, service, cluster)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckTaskSetDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ECSConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ecs_task_set" {
+			continue
+		}
+
+		taskSetID, service, cluster, err := tfecs.TaskSetParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfecs.FindTaskSetByID(conn, taskSetID, service, cluster)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("ECS Task Set %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccTaskSetBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definitions = <<DEFINITION
+[
+  {
+    "cpu": 128,
+    "essential": true,
+    "image": "mongo:latest",
+    "memory": 128,
+    "name": "mongodb"
+  }
+]
+DEFINITION
+}
+
+resource "aws_ecs_service" "test" {
+  name          = %[1]q
+  cluster       = aws_ecs_cluster.test.id
+  desired_count = 1
+
+  deployment_controller {
+    type = "EXTERNAL"
+  }
+}
+`, rName)
+}
+
+func testAccTaskSetConfig(rName string) string {
+	return acctest.ConfigCompose(testAccTaskSetBaseConfig(rName), `
+resource "aws_ecs_task_set" "test" {
+  service         = aws_ecs_service.test.id
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.test.arn
+}
+`)
+}
+
+func testAccTaskSetScaleConfig(rName string, value float64) string {
+	return acctest.ConfigCompose(testAccTaskSetBaseConfig(rName), fmt.Sprintf(`
+resource "aws_ecs_task_set" "test" {
+  service         = aws_ecs_service.test.id
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.test.arn
+
+  scale {
+    value = %[1]g
+  }
+}
+`, value))
+}
+
+func testAccTaskSetTags1Config(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccTaskSetBaseConfig(rName), fmt.Sprintf(`
+resource "aws_ecs_task_set" "test" {
+  service         = aws_ecs_service.test.id
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.test.arn
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccTaskSetTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccTaskSetBaseConfig(rName), fmt.Sprintf(`
+resource "aws_ecs_task_set" "test" {
+  service         = aws_ecs_service.test.id
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.test.arn
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -1,7 +1,15 @@
 package ecs
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ecs"
 )
 
 // Validates that ECS Placement Constraints are set correctly
@@ -47,3 +55,231 @@ func validPlacementStrategy(stratType, stratField string) error {
 	}
 	return nil
 }
+
+var (
+	containerDefinitionType = reflect.TypeOf(ecs.ContainerDefinition{})
+	secretType              = reflect.TypeOf(ecs.Secret{})
+)
+
+// validContainerDefinitions checks a container_definitions JSON document against the
+// shape of the ECS ContainerDefinition API type. Every violation is returned, prefixed
+// with the JSON path of the offending value, e.g. "[0].portMappings[1].containerPort".
+// Keys the API type does not know about are only warned about: they may be fields that
+// ECS added after the AWS SDK version in use.
+func validContainerDefinitions(document string) ([]string, []error) {
+	var raw interface{}
+
+	if err := json.Unmarshal([]byte(document), &raw); err != nil {
+		return nil, []error{fmt.Errorf("Error decoding JSON: %s", err)}
+	}
+
+	definitions, ok := raw.([]interface{})
+
+	if !ok {
+		return nil, []error{fmt.Errorf("expected an array of container definitions, got %s", jsonTypeName(raw))}
+	}
+
+	var warnings []string
+	var errs []error
+
+	for i, definition := range definitions {
+		ws, es := validContainerDefinitionValue(fmt.Sprintf("[%d]", i), definition, containerDefinitionType)
+		warnings = append(warnings, ws...)
+		errs = append(errs, es...)
+	}
+
+	return warnings, errs
+}
+
+func validContainerDefinitionValue(path string, v interface{}, t reflect.Type) ([]string, []error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		m, ok := v.(map[string]interface{})
+
+		if !ok {
+			return nil, []error{containerDefinitionTypeError(path, "object", v)}
+		}
+
+		keys := make([]string, 0, len(m))
+		for key := range m {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		var warnings []string
+		var errs []error
+
+		for _, key := range keys {
+			field, ok := containerDefinitionField(t, key)
+
+			if !ok {
+				warnings = append(warnings, fmt.Sprintf("%s: unknown key %q is not supported by this provider version and is ignored", path, key))
+				continue
+			}
+
+			ws, es := validContainerDefinitionValue(path+"."+key, m[key], field.Type)
+			warnings = append(warnings, ws...)
+			errs = append(errs, es...)
+		}
+
+		if t == secretType {
+			errs = append(errs, validSecretValueFrom(path, m["valueFrom"])...)
+		}
+
+		return warnings, errs
+
+	case reflect.Slice:
+		l, ok := v.([]interface{})
+
+		if !ok {
+			return nil, []error{containerDefinitionTypeError(path, "array", v)}
+		}
+
+		var warnings []string
+		var errs []error
+
+		for i, e := range l {
+			ws, es := validContainerDefinitionValue(fmt.Sprintf("%s[%d]", path, i), e, t.Elem())
+			warnings = append(warnings, ws...)
+			errs = append(errs, es...)
+		}
+
+		return warnings, errs
+
+	case reflect.Map:
+		m, ok := v.(map[string]interface{})
+
+		if !ok {
+			return nil, []error{containerDefinitionTypeError(path, "object", v)}
+		}
+
+		keys := make([]string, 0, len(m))
+		for key := range m {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		var warnings []string
+		var errs []error
+
+		for _, key := range keys {
+			ws, es := validContainerDefinitionValue(fmt.Sprintf("%s[%q]", path, key), m[key], t.Elem())
+			warnings = append(warnings, ws...)
+			errs = append(errs, es...)
+		}
+
+		return warnings, errs
+
+	case reflect.String:
+		if _, ok := v.(string); !ok {
+			return nil, []error{containerDefinitionTypeError(path, "string", v)}
+		}
+
+	case reflect.Bool:
+		if _, ok := v.(bool); !ok {
+			return nil, []error{containerDefinitionTypeError(path, "boolean", v)}
+		}
+
+	case reflect.Int64:
+		if f, ok := v.(float64); !ok || f != math.Trunc(f) {
+			return nil, []error{containerDefinitionTypeError(path, "integer", v)}
+		}
+
+	case reflect.Float64:
+		if _, ok := v.(float64); !ok {
+			return nil, []error{containerDefinitionTypeError(path, "number", v)}
+		}
+	}
+
+	return nil, nil
+}
+
+// containerDefinitionField returns the field of an API struct with the given JSON key.
+// Keys are matched case-insensitively as a fallback, as encoding/json does when the
+// document is later expanded.
+func containerDefinitionField(t reflect.Type, key string) (reflect.StructField, bool) {
+	var fallback *reflect.StructField
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		if field.PkgPath != "" || field.Name == "_" {
+			continue
+		}
+
+		name := field.Tag.Get("locationName")
+		if name == "" {
+			name = field.Name
+		}
+
+		if name == key {
+			return field, true
+		}
+
+		if fallback == nil && strings.EqualFold(name, key) {
+			fallback = &field
+		}
+	}
+
+	if fallback != nil {
+		return *fallback, true
+	}
+
+	return reflect.StructField{}, false
+}
+
+// validSecretValueFrom checks that a secret's valueFrom, when given as an ARN, refers to
+// a Secrets Manager secret or an SSM parameter. Bare SSM parameter names are allowed.
+func validSecretValueFrom(path string, v interface{}) []error {
+	valueFrom, ok := v.(string)
+
+	if !ok || !strings.HasPrefix(valueFrom, "arn:") {
+		return nil
+	}
+
+	parsedARN, err := arn.Parse(valueFrom)
+
+	if err != nil {
+		return []error{fmt.Errorf("%s.valueFrom: %q is not a valid ARN: %s", path, valueFrom, err)}
+	}
+
+	if parsedARN.Service != "secretsmanager" && parsedARN.Service != "ssm" {
+		return []error{fmt.Errorf("%s.valueFrom: %q must be a Secrets Manager secret or SSM parameter ARN", path, valueFrom)}
+	}
+
+	return nil
+}
+
+func containerDefinitionTypeError(path, expected string, v interface{}) error {
+	return fmt.Errorf("%s: expected %s, got %s", path, expected, jsonTypeName(v))
+}
+
+func jsonTypeName(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		if v == math.Trunc(v) {
+			return "integer"
+		}
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
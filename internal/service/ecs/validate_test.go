@@ -1,6 +1,7 @@
 package ecs
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -86,3 +87,93 @@ func TestValidPlacementStrategy(t *testing.T) {
 		}
 	}
 }
+
+func TestValidContainerDefinitions(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Document string
+		Warnings []string
+		Errors   []string
+	}{
+		{
+			Name:     "valid",
+			Document: `[{"name": "sleep", "image": "busybox", "cpu": 10, "command": ["sleep", "360"], "essential": true}]`,
+		},
+		{
+			Name:     "case-insensitive key",
+			Document: `[{"Name": "sleep", "Image": "busybox"}]`,
+		},
+		{
+			Name:     "null value",
+			Document: `[{"name": "sleep", "image": "busybox", "portMappings": null}]`,
+		},
+		{
+			Name:     "not an array",
+			Document: `{"name": "sleep"}`,
+			Errors:   []string{"expected an array of container definitions, got object"},
+		},
+		{
+			Name:     "unknown key",
+			Document: `[{"name": "sleep", "imag": "busybox"}]`,
+			Warnings: []string{`[0]: unknown key "imag" is not supported by this provider version and is ignored`},
+		},
+		{
+			Name:     "unknown key with invalid value",
+			Document: `[{"name": "sleep", "newField": {"enabled": true}, "essential": "true"}]`,
+			Warnings: []string{`[0]: unknown key "newField" is not supported by this provider version and is ignored`},
+			Errors:   []string{"[0].essential: expected boolean, got string"},
+		},
+		{
+			Name:     "port mappings",
+			Document: `[{"name": "web"}, {"name": "sleep", "portMappings": [{"containerPort": 80}, {"containerPort": "8080", "protocol": "tcp"}]}]`,
+			Errors:   []string{"[1].portMappings[1].containerPort: expected integer, got string"},
+		},
+		{
+			Name:     "ulimits",
+			Document: `[{"name": "sleep", "ulimits": {"name": "nofile", "softLimit": 1024, "hardLimit": 2048}}]`,
+			Errors:   []string{"[0].ulimits: expected array, got object"},
+		},
+		{
+			Name:     "log configuration",
+			Document: `[{"name": "sleep", "logConfiguration": {"logDriver": "awslogs", "options": {"awslogs-group": "test", "awslogs-create-group": true}}}]`,
+			Errors:   []string{`[0].logConfiguration.options["awslogs-create-group"]: expected string, got boolean`},
+		},
+		{
+			Name:     "secrets",
+			Document: `[{"name": "sleep", "secrets": [{"name": "A", "valueFrom": "arn:aws:secretsmanager:us-west-2:123456789012:secret:a"}, {"name": "B", "valueFrom": "/param/b"}, {"name": "C", "valueFrom": "arn:aws:s3:::bucket/c"}, {"name": "D", "valueFrom": "arn:aws:ssm"}]}]`,
+			Errors: []string{
+				`[0].secrets[2].valueFrom: "arn:aws:s3:::bucket/c" must be a Secrets Manager secret or SSM parameter ARN`,
+				`[0].secrets[3].valueFrom: "arn:aws:ssm" is not a valid ARN: arn: not enough sections`,
+			},
+		},
+		{
+			Name:     "multiple violations",
+			Document: `[{"name": "sleep", "cpu": 0.5, "essential": "true", "command": "sleep 360"}]`,
+			Errors: []string{
+				"[0].command: expected array, got string",
+				"[0].cpu: expected integer, got number",
+				"[0].essential: expected boolean, got string",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			warnings, errs := validContainerDefinitions(tc.Document)
+
+			var got []string
+
+			for _, err := range errs {
+				got = append(got, err.Error())
+			}
+
+			if !reflect.DeepEqual(warnings, tc.Warnings) {
+				t.Errorf("got warnings %q, expected %q", warnings, tc.Warnings)
+			}
+
+			if !reflect.DeepEqual(got, tc.Errors) {
+				t.Errorf("got errors %q, expected %q", got, tc.Errors)
+			}
+		})
+	}
+}
@@ -17,10 +17,20 @@ const (
 	serviceInactiveTimeoutMin = 1 * time.Second
 	serviceDescribeTimeout    = 2 * time.Minute
 	serviceUpdateTimeout      = 2 * time.Minute
+	serviceStableMinTimeout   = 15 * time.Second
 
 	clusterAvailableTimeout = 10 * time.Minute
 	clusterDeleteTimeout    = 10 * time.Minute
 	clusterAvailableDelay   = 10 * time.Second
+
+	taskSetDeleteTimeout = 10 * time.Minute
+
+	tasksStoppedMinTimeout = 10 * time.Second
+
+	// AWS does not export consts for task set statuses
+	taskSetStatusActive   = "ACTIVE"
+	taskSetStatusDraining = "DRAINING"
+	taskSetStatusPrimary  = "PRIMARY"
 )
 
 func waitCapacityProviderDeleted(conn *ecs.ECS, arn string) (*ecs.CapacityProvider, error) {
@@ -57,19 +67,22 @@ func waitCapacityProviderUpdated(conn *ecs.ECS, arn string) (*ecs.CapacityProvid
 	return nil, err
 }
 
-func waitServiceStable(conn *ecs.ECS, id, cluster string) error {
-	input := &ecs.DescribeServicesInput{
-		Services: aws.StringSlice([]string{id}),
+func waitServiceStable(conn *ecs.ECS, id, cluster string, timeout time.Duration) (*ecs.Service, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{serviceStableStatusPending},
+		Target:     []string{serviceStableStatusStable},
+		Refresh:    statusServiceStable(conn, id, cluster),
+		Timeout:    timeout,
+		MinTimeout: serviceStableMinTimeout,
 	}
 
-	if cluster != "" {
-		input.Cluster = aws.String(cluster)
-	}
+	outputRaw, err := stateConf.WaitForState()
 
-	if err := conn.WaitUntilServicesStable(input); err != nil {
-		return err
+	if v, ok := outputRaw.(*ecs.Service); ok {
+		return v, err
 	}
-	return nil
+
+	return nil, err
 }
 
 func waitServiceInactive(conn *ecs.ECS, id, cluster string) error {
@@ -153,3 +166,55 @@ func waitClusterDeleted(conn *ecs.ECS, arn string) (*ecs.Cluster, error) {
 
 	return nil, err
 }
+
+func waitTaskSetStable(conn *ecs.ECS, taskSetID, service, cluster string, timeout time.Duration) (*ecs.TaskSet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ecs.StabilityStatusStabilizing},
+		Target:  []string{ecs.StabilityStatusSteadyState},
+		Refresh: statusTaskSetStability(conn, taskSetID, service, cluster),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*ecs.TaskSet); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+func waitTaskSetDeleted(conn *ecs.ECS, taskSetID, service, cluster string) (*ecs.TaskSet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{taskSetStatusActive, taskSetStatusPrimary, taskSetStatusDraining},
+		Target:  []string{},
+		Refresh: statusTaskSet(conn, taskSetID, service, cluster),
+		Timeout: taskSetDeleteTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*ecs.TaskSet); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+func waitTasksStopped(conn *ecs.ECS, cluster string, taskARNs []string, timeout time.Duration) ([]*ecs.Task, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{tasksStoppedStatusPending},
+		Target:     []string{tasksStoppedStatusStopped},
+		Refresh:    statusTasksStopped(conn, cluster, taskARNs),
+		Timeout:    timeout,
+		MinTimeout: tasksStoppedMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.([]*ecs.Task); ok {
+		return v, err
+	}
+
+	return nil, err
+}
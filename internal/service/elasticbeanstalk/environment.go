@@ -722,7 +722,7 @@ func resourceEnvironmentSettingsRead(d *schema.ResourceData, meta interface{}) e
 
 	log.Printf("[DEBUG] Elastic Beanstalk updatedSettingsKeySet: %s", updatedSettingsKeySet.GoString())
 
-	updatedSettings := schema.NewSet(optionSettingValueHash, updatedSettingsKeySet.List())
+	updatedSettings := schema.NewSet(optionSettingValueHash, normalizeOptionSettingValues(updatedSettingsKeySet.List(), settings.List()))
 
 	log.Printf("[DEBUG] Elastic Beanstalk updatedSettings: %s", updatedSettings.GoString())
 
@@ -935,6 +935,35 @@ func optionSettingKeyHash(v interface{}) int {
 	return create.StringHashcode(hk)
 }
 
+// normalizeOptionSettingValues keeps the configured value of a setting when the
+// API only differs from it by case, e.g. "true" vs. "True" for boolean options
+// or "Sun:02:00" vs. "SUN:02:00" for the managed actions PreferredStartTime.
+func normalizeOptionSettingValues(apiSettings, configuredSettings []interface{}) []interface{} {
+	configuredValues := make(map[int]string, len(configuredSettings))
+	for _, v := range configuredSettings {
+		m := v.(map[string]interface{})
+		configuredValues[optionSettingKeyHash(m)] = m["value"].(string)
+	}
+
+	normalized := make([]interface{}, 0, len(apiSettings))
+	for _, v := range apiSettings {
+		m := v.(map[string]interface{})
+		if configuredValue, ok := configuredValues[optionSettingKeyHash(m)]; ok {
+			if value, _ := m["value"].(string); value != configuredValue && strings.EqualFold(value, configuredValue) {
+				setting := make(map[string]interface{}, len(m))
+				for k, v := range m {
+					setting[k] = v
+				}
+				setting["value"] = configuredValue
+				m = setting
+			}
+		}
+		normalized = append(normalized, m)
+	}
+
+	return normalized
+}
+
 func sortValues(v string) string {
 	values := strings.Split(v, ",")
 	sort.Strings(values)
@@ -0,0 +1,145 @@
+package elasticbeanstalk
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeOptionSettingValues(t *testing.T) {
+	setting := func(namespace, name, value string) map[string]interface{} {
+		return map[string]interface{}{
+			"namespace": namespace,
+			"name":      name,
+			"resource":  "",
+			"value":     value,
+		}
+	}
+
+	testCases := []struct {
+		Name               string
+		APISettings        []interface{}
+		ConfiguredSettings []interface{}
+		Expected           []interface{}
+	}{
+		{
+			Name: "no configured settings",
+			APISettings: []interface{}{
+				setting("aws:ec2:vpc", "AssociatePublicIpAddress", "True"),
+			},
+			Expected: []interface{}{
+				setting("aws:ec2:vpc", "AssociatePublicIpAddress", "True"),
+			},
+		},
+		{
+			Name: "identical values",
+			APISettings: []interface{}{
+				setting("aws:ec2:vpc", "AssociatePublicIpAddress", "true"),
+			},
+			ConfiguredSettings: []interface{}{
+				setting("aws:ec2:vpc", "AssociatePublicIpAddress", "true"),
+			},
+			Expected: []interface{}{
+				setting("aws:ec2:vpc", "AssociatePublicIpAddress", "true"),
+			},
+		},
+		{
+			Name: "boolean case only",
+			APISettings: []interface{}{
+				setting("aws:ec2:vpc", "AssociatePublicIpAddress", "True"),
+			},
+			ConfiguredSettings: []interface{}{
+				setting("aws:ec2:vpc", "AssociatePublicIpAddress", "true"),
+			},
+			Expected: []interface{}{
+				setting("aws:ec2:vpc", "AssociatePublicIpAddress", "true"),
+			},
+		},
+		{
+			Name: "preferred start time case only",
+			APISettings: []interface{}{
+				setting("aws:elasticbeanstalk:managedactions", "PreferredStartTime", "SUN:02:00"),
+			},
+			ConfiguredSettings: []interface{}{
+				setting("aws:elasticbeanstalk:managedactions", "PreferredStartTime", "Sun:02:00"),
+			},
+			Expected: []interface{}{
+				setting("aws:elasticbeanstalk:managedactions", "PreferredStartTime", "Sun:02:00"),
+			},
+		},
+		{
+			Name: "different value",
+			APISettings: []interface{}{
+				setting("aws:elasticbeanstalk:managedactions", "PreferredStartTime", "TUE:09:30"),
+			},
+			ConfiguredSettings: []interface{}{
+				setting("aws:elasticbeanstalk:managedactions", "PreferredStartTime", "Sun:02:00"),
+			},
+			Expected: []interface{}{
+				setting("aws:elasticbeanstalk:managedactions", "PreferredStartTime", "TUE:09:30"),
+			},
+		},
+		{
+			Name: "same value in another namespace",
+			APISettings: []interface{}{
+				setting("aws:autoscaling:launchconfiguration", "AssociatePublicIpAddress", "True"),
+			},
+			ConfiguredSettings: []interface{}{
+				setting("aws:ec2:vpc", "AssociatePublicIpAddress", "true"),
+			},
+			Expected: []interface{}{
+				setting("aws:autoscaling:launchconfiguration", "AssociatePublicIpAddress", "True"),
+			},
+		},
+		{
+			Name: "mixed",
+			APISettings: []interface{}{
+				setting("aws:ec2:vpc", "AssociatePublicIpAddress", "True"),
+				setting("aws:elasticbeanstalk:managedactions", "ManagedActionsEnabled", "true"),
+				setting("aws:elasticbeanstalk:managedactions", "PreferredStartTime", "SUN:02:00"),
+				setting("aws:elasticbeanstalk:managedactions:platformupdate", "UpdateLevel", "patch"),
+				setting("aws:autoscaling:asg", "MinSize", "1"),
+			},
+			ConfiguredSettings: []interface{}{
+				setting("aws:ec2:vpc", "AssociatePublicIpAddress", "true"),
+				setting("aws:elasticbeanstalk:managedactions", "ManagedActionsEnabled", "true"),
+				setting("aws:elasticbeanstalk:managedactions", "PreferredStartTime", "Sun:02:00"),
+				setting("aws:elasticbeanstalk:managedactions:platformupdate", "UpdateLevel", "minor"),
+			},
+			Expected: []interface{}{
+				setting("aws:ec2:vpc", "AssociatePublicIpAddress", "true"),
+				setting("aws:elasticbeanstalk:managedactions", "ManagedActionsEnabled", "true"),
+				setting("aws:elasticbeanstalk:managedactions", "PreferredStartTime", "Sun:02:00"),
+				setting("aws:elasticbeanstalk:managedactions:platformupdate", "UpdateLevel", "patch"),
+				setting("aws:autoscaling:asg", "MinSize", "1"),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			apiSettings := make([]interface{}, len(testCase.APISettings))
+			for i, v := range testCase.APISettings {
+				apiSettings[i] = copyOptionSetting(v.(map[string]interface{}))
+			}
+
+			got := normalizeOptionSettingValues(apiSettings, testCase.ConfiguredSettings)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+
+			if !reflect.DeepEqual(apiSettings, testCase.APISettings) {
+				t.Errorf("API settings were modified: got %v, expected %v", apiSettings, testCase.APISettings)
+			}
+		})
+	}
+}
+
+func copyOptionSetting(m map[string]interface{}) map[string]interface{} {
+	setting := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		setting[k] = v
+	}
+
+	return setting
+}
@@ -207,6 +207,54 @@ func TestAccElasticBeanstalkEnvironment_BeanstalkEnv_resource(t *testing.T) {
 	})
 }
 
+func TestAccElasticBeanstalkEnvironment_BeanstalkEnv_managedActions(t *testing.T) {
+	var app elasticbeanstalk.EnvironmentDescription
+
+	resourceName := "aws_elastic_beanstalk_environment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, elasticbeanstalk.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBeanstalkEnvDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBeanstalkEnvManagedActionsConfig(rName, "Sun:02:00", "minor"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBeanstalkEnvExists(resourceName, &app),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "setting.*", map[string]string{
+						"namespace": "aws:elasticbeanstalk:managedactions",
+						"name":      "PreferredStartTime",
+						"value":     "Sun:02:00",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "setting.*", map[string]string{
+						"namespace": "aws:elasticbeanstalk:managedactions:platformupdate",
+						"name":      "UpdateLevel",
+						"value":     "minor",
+					}),
+				),
+			},
+			{
+				Config: testAccBeanstalkEnvManagedActionsConfig(rName, "Tue:09:30", "patch"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBeanstalkEnvExists(resourceName, &app),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "setting.*", map[string]string{
+						"namespace": "aws:elasticbeanstalk:managedactions",
+						"name":      "PreferredStartTime",
+						"value":     "Tue:09:30",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "setting.*", map[string]string{
+						"namespace": "aws:elasticbeanstalk:managedactions:platformupdate",
+						"name":      "UpdateLevel",
+						"value":     "patch",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccElasticBeanstalkEnvironment_BeanstalkEnv_tags(t *testing.T) {
 	var app elasticbeanstalk.EnvironmentDescription
 
@@ -1215,6 +1263,76 @@ resource "aws_elastic_beanstalk_environment" "test" {
 `, rName)
 }
 
+func testAccBeanstalkEnvManagedActionsConfig(rName, preferredStartTime, updateLevel string) string {
+	return testAccBeanstalkEnvConfigBase(rName) + fmt.Sprintf(`
+resource "aws_elastic_beanstalk_environment" "test" {
+  application         = aws_elastic_beanstalk_application.test.name
+  name                = %[1]q
+  solution_stack_name = data.aws_elastic_beanstalk_solution_stack.test.name
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "VPCId"
+    value     = aws_vpc.test.id
+  }
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "Subnets"
+    value     = aws_subnet.test.id
+  }
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "AssociatePublicIpAddress"
+    value     = "true"
+  }
+
+  setting {
+    namespace = "aws:autoscaling:launchconfiguration"
+    name      = "SecurityGroups"
+    value     = aws_security_group.test.id
+  }
+
+  setting {
+    namespace = "aws:autoscaling:launchconfiguration"
+    name      = "IamInstanceProfile"
+    value     = aws_iam_instance_profile.test.name
+  }
+
+  setting {
+    namespace = "aws:elasticbeanstalk:environment"
+    name      = "ServiceRole"
+    value     = aws_iam_role.service_role.name
+  }
+
+  setting {
+    namespace = "aws:elasticbeanstalk:healthreporting:system"
+    name      = "SystemType"
+    value     = "enhanced"
+  }
+
+  setting {
+    namespace = "aws:elasticbeanstalk:managedactions"
+    name      = "ManagedActionsEnabled"
+    value     = "true"
+  }
+
+  setting {
+    namespace = "aws:elasticbeanstalk:managedactions"
+    name      = "PreferredStartTime"
+    value     = %[2]q
+  }
+
+  setting {
+    namespace = "aws:elasticbeanstalk:managedactions:platformupdate"
+    name      = "UpdateLevel"
+    value     = %[3]q
+  }
+}
+`, rName, preferredStartTime, updateLevel)
+}
+
 func testAccBeanstalkTagsTemplate(rName, firstTag, secondTag string) string {
 	return testAccBeanstalkEnvConfigBase(rName) + fmt.Sprintf(`
 resource "aws_elastic_beanstalk_environment" "test" {
@@ -62,6 +62,7 @@ func ResourceDomain() *schema.Resource {
 				}
 				return true
 			}),
+			resourceDomainClusterConfigCustomizeDiff,
 			verify.SetTagsDiff,
 		),
 
@@ -265,6 +266,21 @@ func ResourceDomain() *schema.Resource {
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"cold_storage_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
 						"dedicated_master_count": {
 							Type:             schema.TypeInt,
 							Optional:         true,
@@ -1106,6 +1122,10 @@ func flattenESNodeToNodeEncryptionOptions(o *elasticsearch.NodeToNodeEncryptionO
 func expandESClusterConfig(m map[string]interface{}) *elasticsearch.ElasticsearchClusterConfig {
 	config := elasticsearch.ElasticsearchClusterConfig{}
 
+	if v, ok := m["cold_storage_options"].([]interface{}); ok && len(v) > 0 {
+		config.ColdStorageOptions = expandColdStorageOptions(v)
+	}
+
 	if v, ok := m["dedicated_master_enabled"]; ok {
 		isEnabled := v.(bool)
 		config.DedicatedMasterEnabled = aws.Bool(isEnabled)
@@ -1156,6 +1176,22 @@ func expandESClusterConfig(m map[string]interface{}) *elasticsearch.Elasticsearc
 	return &config
 }
 
+func expandColdStorageOptions(l []interface{}) *elasticsearch.ColdStorageOptions {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	coldStorageOptions := &elasticsearch.ColdStorageOptions{}
+
+	if v, ok := m["enabled"].(bool); ok {
+		coldStorageOptions.Enabled = aws.Bool(v)
+	}
+
+	return coldStorageOptions
+}
+
 func expandElasticsearchZoneAwarenessConfig(l []interface{}) *elasticsearch.ZoneAwarenessConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -1174,6 +1210,7 @@ func expandElasticsearchZoneAwarenessConfig(l []interface{}) *elasticsearch.Zone
 
 func flattenESClusterConfig(c *elasticsearch.ElasticsearchClusterConfig) []map[string]interface{} {
 	m := map[string]interface{}{
+		"cold_storage_options":   flattenColdStorageOptions(c.ColdStorageOptions),
 		"zone_awareness_config":  flattenElasticsearchZoneAwarenessConfig(c.ZoneAwarenessConfig),
 		"zone_awareness_enabled": aws.BoolValue(c.ZoneAwarenessEnabled),
 	}
@@ -1206,6 +1243,18 @@ func flattenESClusterConfig(c *elasticsearch.ElasticsearchClusterConfig) []map[s
 	return []map[string]interface{}{m}
 }
 
+func flattenColdStorageOptions(coldStorageOptions *elasticsearch.ColdStorageOptions) []interface{} {
+	if coldStorageOptions == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"enabled": aws.BoolValue(coldStorageOptions.Enabled),
+	}
+
+	return []interface{}{m}
+}
+
 func flattenElasticsearchZoneAwarenessConfig(zoneAwarenessConfig *elasticsearch.ZoneAwarenessConfig) []interface{} {
 	if zoneAwarenessConfig == nil {
 		return []interface{}{}
@@ -1217,3 +1266,48 @@ func flattenElasticsearchZoneAwarenessConfig(zoneAwarenessConfig *elasticsearch.
 
 	return []interface{}{m}
 }
+
+// resourceDomainClusterConfigCustomizeDiff validates the warm (UltraWarm) and cold storage settings,
+// which the API otherwise only rejects part way through an apply.
+func resourceDomainClusterConfigCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("cluster_config") {
+		return nil
+	}
+
+	v, ok := diff.Get("cluster_config").([]interface{})
+
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	// Values that are not known yet read as their zero value, so they are skipped
+	// here and left for the API to validate.
+	known := func(key string) bool {
+		return diff.NewValueKnown("cluster_config.0." + key)
+	}
+
+	m := v[0].(map[string]interface{})
+	warmEnabled := m["warm_enabled"].(bool)
+
+	if warmEnabled && known("warm_enabled") {
+		if v, ok := m["warm_count"].(int); known("warm_count") && (!ok || v == 0) {
+			return fmt.Errorf("cluster_config.0.warm_count must be set when cluster_config.0.warm_enabled is true")
+		}
+
+		if v, ok := m["warm_type"].(string); known("warm_type") && (!ok || v == "") {
+			return fmt.Errorf("cluster_config.0.warm_type must be set when cluster_config.0.warm_enabled is true")
+		}
+
+		if known("dedicated_master_enabled") && !m["dedicated_master_enabled"].(bool) {
+			return fmt.Errorf("cluster_config.0.dedicated_master_enabled must be true when cluster_config.0.warm_enabled is true")
+		}
+	}
+
+	if v, ok := m["cold_storage_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil && known("cold_storage_options.0.enabled") && known("warm_enabled") {
+		if enabled, ok := v[0].(map[string]interface{})["enabled"].(bool); ok && enabled && !warmEnabled {
+			return fmt.Errorf("cluster_config.0.warm_enabled must be true when cluster_config.0.cold_storage_options.0.enabled is true")
+		}
+	}
+
+	return nil
+}
@@ -8,9 +8,11 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -22,6 +24,12 @@ func ResourceDomainPolicy() *schema.Resource {
 		Update: resourceDomainPolicyUpsert,
 		Delete: resourceDomainPolicyDelete,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(50 * time.Minute),
+			Update: schema.DefaultTimeout(50 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"domain_name": {
 				Type:     schema.TypeString,
@@ -62,12 +70,27 @@ func resourceDomainPolicyRead(d *schema.ResourceData, meta interface{}) error {
 func resourceDomainPolicyUpsert(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).ElasticsearchConn
 	domainName := d.Get("domain_name").(string)
-	_, err := conn.UpdateElasticsearchDomainConfig(&elasticsearch.UpdateElasticsearchDomainConfigInput{
-		DomainName:     aws.String(domainName),
-		AccessPolicies: aws.String(d.Get("access_policies").(string)),
+
+	// IAM Roles can take some time to propagate if referenced in the access policy and created in the same terraform
+	_, err := tfresource.RetryWhen(tfiam.PropagationTimeout, func() (interface{}, error) {
+		return conn.UpdateElasticsearchDomainConfig(&elasticsearch.UpdateElasticsearchDomainConfigInput{
+			DomainName:     aws.String(domainName),
+			AccessPolicies: aws.String(d.Get("access_policies").(string)),
+		})
+	}, func(err error) (bool, error) {
+		if tfawserr.ErrMessageContains(err, "InvalidTypeException", "Error setting policy") {
+			return true, err
+		}
+
+		return false, err
 	})
 	if err != nil {
-		return err
+		return fmt.Errorf("Error updating Elasticsearch domain (%s) policy: %w", domainName, err)
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
 	}
 
 	d.SetId("esd-policy-" + domainName)
@@ -75,7 +98,7 @@ func resourceDomainPolicyUpsert(d *schema.ResourceData, meta interface{}) error
 		DomainName: aws.String(d.Get("domain_name").(string)),
 	}
 	var out *elasticsearch.DescribeElasticsearchDomainOutput
-	err = resource.Retry(50*time.Minute, func() *resource.RetryError {
+	err = resource.Retry(timeout, func() *resource.RetryError {
 		var err error
 		out, err = conn.DescribeElasticsearchDomain(input)
 		if err != nil {
@@ -118,7 +141,7 @@ func resourceDomainPolicyDelete(d *schema.ResourceData, meta interface{}) error
 		DomainName: aws.String(d.Get("domain_name").(string)),
 	}
 	var out *elasticsearch.DescribeElasticsearchDomainOutput
-	err = resource.Retry(60*time.Minute, func() *resource.RetryError {
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		var err error
 		out, err = conn.DescribeElasticsearchDomain(input)
 		if err != nil {
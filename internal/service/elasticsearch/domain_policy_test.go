@@ -72,6 +72,28 @@ func TestAccElasticsearchDomainPolicy_basic(t *testing.T) {
 	})
 }
 
+func TestAccElasticsearchDomainPolicy_iamRole(t *testing.T) {
+	var domain elasticsearch.ElasticsearchDomainStatus
+	ri := sdkacctest.RandInt()
+	resourceName := "aws_elasticsearch_domain_policy.main"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, elasticsearch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckESDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccESDomainPolicyIAMRoleConfig(ri),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckESDomainExists("aws_elasticsearch_domain.example", &domain),
+					resource.TestCheckResourceAttrSet(resourceName, "access_policies"),
+				),
+			},
+		},
+	})
+}
+
 func buildESDomainArn(name, partition, accId, region string) (string, error) {
 	if partition == "" {
 		return "", fmt.Errorf("Unable to construct ES Domain ARN because of missing AWS partition")
@@ -108,3 +130,54 @@ POLICIES
 }
 `, randInt, policy)
 }
+
+func testAccESDomainPolicyIAMRoleConfig(randInt int) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = "tf-test-%[1]d"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_elasticsearch_domain" "example" {
+  domain_name           = "tf-test-%[1]d"
+  elasticsearch_version = "2.3"
+
+  cluster_config {
+    instance_type = "t2.micro.elasticsearch"
+  }
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+}
+
+resource "aws_elasticsearch_domain_policy" "main" {
+  domain_name = aws_elasticsearch_domain.example.domain_name
+
+  access_policies = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "es:*"
+      Effect = "Allow"
+      Principal = {
+        AWS = aws_iam_role.test.arn
+      }
+      Resource = "${aws_elasticsearch_domain.example.arn}/*"
+    }]
+  })
+}
+`, randInt)
+}
@@ -3,6 +3,8 @@ package elasticsearch
 import (
 	"fmt"
 	"log"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -56,9 +58,10 @@ func ResourceDomainSAMLOptions() *schema.Resource {
 										Required: true,
 									},
 									"metadata_content": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateFunc:     validation.StringIsNotEmpty,
+										DiffSuppressFunc: elasticsearchDomainSamlMetadataContentDiffSuppress,
 									},
 								},
 							},
@@ -106,6 +109,23 @@ func elasticsearchDomainSamlOptionsDiffSupress(k, old, new string, d *schema.Res
 	return false
 }
 
+// elasticsearchDomainSamlOptionsMetadataWhitespaceRegexp matches insignificant whitespace between XML elements.
+var elasticsearchDomainSamlOptionsMetadataWhitespaceRegexp = regexp.MustCompile(`>\s+<`)
+
+// elasticsearchDomainSamlMetadataContentDiffSuppress suppresses differences in IdP metadata XML
+// that only consist of line endings or whitespace between elements, as may be introduced by
+// editors or by the API normalizing the document.
+func elasticsearchDomainSamlMetadataContentDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	normalize := func(s string) string {
+		s = strings.ReplaceAll(s, "\r\n", "\n")
+		s = elasticsearchDomainSamlOptionsMetadataWhitespaceRegexp.ReplaceAllString(s, "><")
+
+		return strings.TrimSpace(s)
+	}
+
+	return normalize(old) == normalize(new)
+}
+
 func resourceDomainSAMLOptionsRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).ElasticsearchConn
 
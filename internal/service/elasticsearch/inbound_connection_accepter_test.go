@@ -0,0 +1,100 @@
+package elasticsearch_test
+
+import (
+	"fmt"
+	"testing"
+
+	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfelasticsearch "github.com/hashicorp/terraform-provider-aws/internal/service/elasticsearch"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccElasticsearchInboundConnectionAccepter_basic(t *testing.T) {
+	var v elasticsearch.InboundCrossClusterSearchConnection
+	resourceName := "aws_elasticsearch_inbound_connection_accepter.test"
+	rName := fmt.Sprintf("tf-test-%s", sdkacctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, elasticsearch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInboundConnectionAccepterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInboundConnectionAccepterConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInboundConnectionAccepterExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "connection_id", "aws_elasticsearch_outbound_connection.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "connection_status", "APPROVED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckInboundConnectionAccepterExists(n string, v *elasticsearch.InboundCrossClusterSearchConnection) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Elasticsearch Inbound Connection ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticsearchConn
+
+		output, err := tfelasticsearch.FindInboundConnectionByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckInboundConnectionAccepterDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticsearchConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_elasticsearch_inbound_connection_accepter" {
+			continue
+		}
+
+		_, err := tfelasticsearch.FindInboundConnectionByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Elasticsearch Inbound Connection %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccInboundConnectionAccepterConfig(rName string) string {
+	return acctest.ConfigCompose(testAccOutboundConnectionConfig(rName), `
+resource "aws_elasticsearch_inbound_connection_accepter" "test" {
+  connection_id = aws_elasticsearch_outbound_connection.test.id
+}
+`)
+}
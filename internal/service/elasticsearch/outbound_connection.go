@@ -0,0 +1,188 @@
+package elasticsearch
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceOutboundConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOutboundConnectionCreate,
+		Read:   resourceOutboundConnectionRead,
+		Delete: resourceOutboundConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(OutboundConnectionCreatedTimeout),
+			Delete: schema.DefaultTimeout(OutboundConnectionDeletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"connection_alias": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"connection_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"local_domain_info":  outboundConnectionDomainInfoSchema(),
+			"remote_domain_info": outboundConnectionDomainInfoSchema(),
+		},
+	}
+}
+
+func outboundConnectionDomainInfoSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"domain_name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"owner_id": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: verify.ValidAccountID,
+				},
+				"region": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceOutboundConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ElasticsearchConn
+
+	input := &elasticsearch.CreateOutboundCrossClusterSearchConnectionInput{
+		ConnectionAlias:       aws.String(d.Get("connection_alias").(string)),
+		DestinationDomainInfo: expandOutboundConnectionDomainInfo(d.Get("remote_domain_info").([]interface{})),
+		SourceDomainInfo:      expandOutboundConnectionDomainInfo(d.Get("local_domain_info").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Creating Elasticsearch Outbound Connection: %s", input)
+	output, err := conn.CreateOutboundCrossClusterSearchConnection(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Elasticsearch Outbound Connection: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.CrossClusterSearchConnectionId))
+
+	if _, err := waitOutboundConnectionCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Elasticsearch Outbound Connection (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceOutboundConnectionRead(d, meta)
+}
+
+func resourceOutboundConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ElasticsearchConn
+
+	connection, err := FindOutboundConnectionByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Elasticsearch Outbound Connection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Elasticsearch Outbound Connection (%s): %w", d.Id(), err)
+	}
+
+	d.Set("connection_alias", connection.ConnectionAlias)
+	d.Set("connection_status", connection.ConnectionStatus.StatusCode)
+
+	if err := d.Set("local_domain_info", flattenOutboundConnectionDomainInfo(connection.SourceDomainInfo)); err != nil {
+		return fmt.Errorf("error setting local_domain_info: %w", err)
+	}
+
+	if err := d.Set("remote_domain_info", flattenOutboundConnectionDomainInfo(connection.DestinationDomainInfo)); err != nil {
+		return fmt.Errorf("error setting remote_domain_info: %w", err)
+	}
+
+	return nil
+}
+
+func resourceOutboundConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ElasticsearchConn
+
+	log.Printf("[DEBUG] Deleting Elasticsearch Outbound Connection: %s", d.Id())
+	_, err := conn.DeleteOutboundCrossClusterSearchConnection(&elasticsearch.DeleteOutboundCrossClusterSearchConnectionInput{
+		CrossClusterSearchConnectionId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, elasticsearch.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Elasticsearch Outbound Connection (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitOutboundConnectionDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Elasticsearch Outbound Connection (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandOutboundConnectionDomainInfo(tfList []interface{}) *elasticsearch.DomainInformation {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &elasticsearch.DomainInformation{}
+
+	if v, ok := tfMap["domain_name"].(string); ok && v != "" {
+		apiObject.DomainName = aws.String(v)
+	}
+
+	if v, ok := tfMap["owner_id"].(string); ok && v != "" {
+		apiObject.OwnerId = aws.String(v)
+	}
+
+	if v, ok := tfMap["region"].(string); ok && v != "" {
+		apiObject.Region = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenOutboundConnectionDomainInfo(apiObject *elasticsearch.DomainInformation) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"domain_name": aws.StringValue(apiObject.DomainName),
+		"owner_id":    aws.StringValue(apiObject.OwnerId),
+		"region":      aws.StringValue(apiObject.Region),
+	}
+
+	return []interface{}{tfMap}
+}
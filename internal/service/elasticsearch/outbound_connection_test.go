@@ -0,0 +1,178 @@
+package elasticsearch_test
+
+import (
+	"fmt"
+	"testing"
+
+	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfelasticsearch "github.com/hashicorp/terraform-provider-aws/internal/service/elasticsearch"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccElasticsearchOutboundConnection_basic(t *testing.T) {
+	var v elasticsearch.OutboundCrossClusterSearchConnection
+	resourceName := "aws_elasticsearch_outbound_connection.test"
+	rName := fmt.Sprintf("tf-test-%s", sdkacctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, elasticsearch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckOutboundConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutboundConnectionConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOutboundConnectionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "connection_alias", rName),
+					resource.TestCheckResourceAttr(resourceName, "connection_status", "PENDING_ACCEPTANCE"),
+					resource.TestCheckResourceAttr(resourceName, "local_domain_info.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "local_domain_info.0.domain_name", "aws_elasticsearch_domain.local", "domain_name"),
+					resource.TestCheckResourceAttr(resourceName, "remote_domain_info.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "remote_domain_info.0.domain_name", "aws_elasticsearch_domain.remote", "domain_name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckOutboundConnectionExists(n string, v *elasticsearch.OutboundCrossClusterSearchConnection) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Elasticsearch Outbound Connection ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticsearchConn
+
+		output, err := tfelasticsearch.FindOutboundConnectionByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckOutboundConnectionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticsearchConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_elasticsearch_outbound_connection" {
+			continue
+		}
+
+		_, err := tfelasticsearch.FindOutboundConnectionByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Elasticsearch Outbound Connection %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccOutboundConnectionBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_elasticsearch_domain" "local" {
+  domain_name           = "%[1]s-l"
+  elasticsearch_version = "7.10"
+
+  cluster_config {
+    instance_type = "t3.small.elasticsearch"
+  }
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+
+  encrypt_at_rest {
+    enabled = true
+  }
+
+  node_to_node_encryption {
+    enabled = true
+  }
+
+  domain_endpoint_options {
+    enforce_https       = true
+    tls_security_policy = "Policy-Min-TLS-1-2-2019-07"
+  }
+}
+
+resource "aws_elasticsearch_domain" "remote" {
+  domain_name           = "%[1]s-r"
+  elasticsearch_version = "7.10"
+
+  cluster_config {
+    instance_type = "t3.small.elasticsearch"
+  }
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+
+  encrypt_at_rest {
+    enabled = true
+  }
+
+  node_to_node_encryption {
+    enabled = true
+  }
+
+  domain_endpoint_options {
+    enforce_https       = true
+    tls_security_policy = "Policy-Min-TLS-1-2-2019-07"
+  }
+}
+`, rName)
+}
+
+func testAccOutboundConnectionConfig(rName string) string {
+	return acctest.ConfigCompose(testAccOutboundConnectionBaseConfig(rName), fmt.Sprintf(`
+resource "aws_elasticsearch_outbound_connection" "test" {
+  connection_alias = %[1]q
+
+  local_domain_info {
+    owner_id    = data.aws_caller_identity.current.account_id
+    region      = data.aws_region.current.name
+    domain_name = aws_elasticsearch_domain.local.domain_name
+  }
+
+  remote_domain_info {
+    owner_id    = data.aws_caller_identity.current.account_id
+    region      = data.aws_region.current.name
+    domain_name = aws_elasticsearch_domain.remote.domain_name
+  }
+}
+`, rName))
+}
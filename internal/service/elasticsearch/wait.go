@@ -0,0 +1,110 @@
+package elasticsearch
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	OutboundConnectionCreatedTimeout = 1 * time.Minute
+	OutboundConnectionDeletedTimeout = 1 * time.Minute
+
+	InboundConnectionAcceptedTimeout = 1 * time.Minute
+	InboundConnectionDeletedTimeout  = 1 * time.Minute
+)
+
+func waitOutboundConnectionCreated(conn *elasticsearch.ElasticsearchService, id string, timeout time.Duration) (*elasticsearch.OutboundCrossClusterSearchConnection, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			elasticsearch.OutboundCrossClusterSearchConnectionStatusCodeValidating,
+			elasticsearch.OutboundCrossClusterSearchConnectionStatusCodeProvisioning,
+		},
+		Target: []string{
+			elasticsearch.OutboundCrossClusterSearchConnectionStatusCodePendingAcceptance,
+			elasticsearch.OutboundCrossClusterSearchConnectionStatusCodeActive,
+		},
+		Refresh: statusOutboundConnection(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*elasticsearch.OutboundCrossClusterSearchConnection); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ConnectionStatus.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitOutboundConnectionDeleted(conn *elasticsearch.ElasticsearchService, id string, timeout time.Duration) (*elasticsearch.OutboundCrossClusterSearchConnection, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			elasticsearch.OutboundCrossClusterSearchConnectionStatusCodeDeleting,
+		},
+		Target:  []string{},
+		Refresh: statusOutboundConnection(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*elasticsearch.OutboundCrossClusterSearchConnection); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ConnectionStatus.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitInboundConnectionAccepted(conn *elasticsearch.ElasticsearchService, id string, timeout time.Duration) (*elasticsearch.InboundCrossClusterSearchConnection, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			elasticsearch.InboundCrossClusterSearchConnectionStatusCodePendingAcceptance,
+		},
+		Target: []string{
+			elasticsearch.InboundCrossClusterSearchConnectionStatusCodeApproved,
+		},
+		Refresh: statusInboundConnection(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*elasticsearch.InboundCrossClusterSearchConnection); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ConnectionStatus.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitInboundConnectionDeleted(conn *elasticsearch.ElasticsearchService, id string, timeout time.Duration) (*elasticsearch.InboundCrossClusterSearchConnection, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			elasticsearch.InboundCrossClusterSearchConnectionStatusCodeDeleting,
+			elasticsearch.InboundCrossClusterSearchConnectionStatusCodeRejecting,
+		},
+		Target:  []string{},
+		Refresh: statusInboundConnection(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*elasticsearch.InboundCrossClusterSearchConnection); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ConnectionStatus.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
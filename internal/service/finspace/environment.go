@@ -0,0 +1,397 @@
+package finspace
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/finspace"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceEnvironment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEnvironmentCreate,
+		Read:   resourceEnvironmentRead,
+		Update: resourceEnvironmentUpdate,
+		Delete: resourceEnvironmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(environmentCreatedTimeout),
+			Delete: schema.DefaultTimeout(environmentDeletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"aws_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_bundles": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"dedicated_service_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"environment_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"federation_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(finspace.FederationMode_Values(), false),
+			},
+			"federation_parameters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"application_call_back_url": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1000),
+						},
+						"attribute_map": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"federation_provider_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 32),
+						},
+						"federation_urn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"saml_metadata_document": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1000, 10000000),
+						},
+						"saml_metadata_url": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1000),
+						},
+					},
+				},
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9]+[a-zA-Z0-9-]*[a-zA-Z0-9]$`), "must contain only alphanumeric characters and hyphens, and must start and end with an alphanumeric character"),
+				),
+			},
+			"sagemaker_studio_domain_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"superuser_parameters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"email_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"first_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 50),
+						},
+						"last_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 50),
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FinSpaceConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &finspace.CreateEnvironmentInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("data_bundles"); ok && len(v.([]interface{})) > 0 {
+		input.DataBundles = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("federation_mode"); ok {
+		input.FederationMode = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("federation_parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.FederationParameters = expandFederationParameters(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("superuser_parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SuperuserParameters = expandSuperuserParameters(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating FinSpace Environment: %s", input)
+	output, err := conn.CreateEnvironment(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating FinSpace Environment (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.EnvironmentId))
+
+	if _, err := waitEnvironmentCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for FinSpace Environment (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceEnvironmentRead(d, meta)
+}
+
+func resourceEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FinSpaceConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	environment, err := FindEnvironmentByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] FinSpace Environment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading FinSpace Environment (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(environment.EnvironmentArn)
+	d.Set("arn", arn)
+	d.Set("aws_account_id", environment.AwsAccountId)
+	d.Set("dedicated_service_account_id", environment.DedicatedServiceAccountId)
+	d.Set("description", environment.Description)
+	d.Set("environment_url", environment.EnvironmentUrl)
+	d.Set("federation_mode", environment.FederationMode)
+	if environment.FederationParameters != nil {
+		if err := d.Set("federation_parameters", []interface{}{flattenFederationParameters(environment.FederationParameters)}); err != nil {
+			return fmt.Errorf("error setting federation_parameters: %w", err)
+		}
+	} else {
+		d.Set("federation_parameters", nil)
+	}
+	d.Set("kms_key_id", environment.KmsKeyId)
+	d.Set("name", environment.Name)
+	d.Set("sagemaker_studio_domain_url", environment.SageMakerStudioDomainUrl)
+	d.Set("status", environment.Status)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for FinSpace Environment (%s): %w", arn, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FinSpaceConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &finspace.UpdateEnvironmentInput{
+			EnvironmentId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("federation_mode") {
+			input.FederationMode = aws.String(d.Get("federation_mode").(string))
+		}
+
+		if d.HasChange("federation_parameters") {
+			if v, ok := d.GetOk("federation_parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.FederationParameters = expandFederationParameters(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		log.Printf("[DEBUG] Updating FinSpace Environment: %s", input)
+		_, err := conn.UpdateEnvironment(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating FinSpace Environment (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating FinSpace Environment (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceEnvironmentRead(d, meta)
+}
+
+func resourceEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FinSpaceConn
+
+	log.Printf("[DEBUG] Deleting FinSpace Environment: %s", d.Id())
+	_, err := conn.DeleteEnvironment(&finspace.DeleteEnvironmentInput{
+		EnvironmentId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, finspace.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting FinSpace Environment (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitEnvironmentDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for FinSpace Environment (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandFederationParameters(tfMap map[string]interface{}) *finspace.FederationParameters {
+	apiObject := &finspace.FederationParameters{}
+
+	if v, ok := tfMap["application_call_back_url"].(string); ok && v != "" {
+		apiObject.ApplicationCallBackURL = aws.String(v)
+	}
+
+	if v, ok := tfMap["attribute_map"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.AttributeMap = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["federation_provider_name"].(string); ok && v != "" {
+		apiObject.FederationProviderName = aws.String(v)
+	}
+
+	if v, ok := tfMap["federation_urn"].(string); ok && v != "" {
+		apiObject.FederationURN = aws.String(v)
+	}
+
+	if v, ok := tfMap["saml_metadata_document"].(string); ok && v != "" {
+		apiObject.SamlMetadataDocument = aws.String(v)
+	}
+
+	if v, ok := tfMap["saml_metadata_url"].(string); ok && v != "" {
+		apiObject.SamlMetadataURL = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenFederationParameters(apiObject *finspace.FederationParameters) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"application_call_back_url": aws.StringValue(apiObject.ApplicationCallBackURL),
+		"attribute_map":             aws.StringValueMap(apiObject.AttributeMap),
+		"federation_provider_name":  aws.StringValue(apiObject.FederationProviderName),
+		"federation_urn":            aws.StringValue(apiObject.FederationURN),
+		"saml_metadata_document":    aws.StringValue(apiObject.SamlMetadataDocument),
+		"saml_metadata_url":         aws.StringValue(apiObject.SamlMetadataURL),
+	}
+
+	return tfMap
+}
+
+func expandSuperuserParameters(tfMap map[string]interface{}) *finspace.SuperuserParameters {
+	return &finspace.SuperuserParameters{
+		EmailAddress: aws.String(tfMap["email_address"].(string)),
+		FirstName:    aws.String(tfMap["first_name"].(string)),
+		LastName:     aws.String(tfMap["last_name"].(string)),
+	}
+}
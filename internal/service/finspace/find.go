@@ -0,0 +1,43 @@
+package finspace
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/finspace"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindEnvironmentByID(conn *finspace.Finspace, id string) (*finspace.Environment, error) {
+	input := &finspace.GetEnvironmentInput{
+		EnvironmentId: aws.String(id),
+	}
+
+	output, err := conn.GetEnvironment(input)
+
+	if tfawserr.ErrCodeEquals(err, finspace.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Environment == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	environment := output.Environment
+
+	if status := aws.StringValue(environment.Status); status == finspace.EnvironmentStatusDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return environment, nil
+}
@@ -0,0 +1,47 @@
+package finspace
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/finspace"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	environmentCreatedTimeout = 60 * time.Minute
+	environmentDeletedTimeout = 60 * time.Minute
+)
+
+func waitEnvironmentCreated(conn *finspace.Finspace, id string, timeout time.Duration) (*finspace.Environment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{finspace.EnvironmentStatusCreateRequested, finspace.EnvironmentStatusCreating},
+		Target:  []string{finspace.EnvironmentStatusCreated},
+		Refresh: statusEnvironment(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*finspace.Environment); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEnvironmentDeleted(conn *finspace.Finspace, id string, timeout time.Duration) (*finspace.Environment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{finspace.EnvironmentStatusCreated, finspace.EnvironmentStatusDeleteRequested, finspace.EnvironmentStatusDeleting},
+		Target:  []string{},
+		Refresh: statusEnvironment(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*finspace.Environment); ok {
+		return output, err
+	}
+
+	return nil, err
+}
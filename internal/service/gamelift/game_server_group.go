@@ -0,0 +1,512 @@
+package gamelift
+
+import ( // nosemgrep: aws-sdk-go-multiple-service-imports
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceGameServerGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGameServerGroupCreate,
+		Read:   resourceGameServerGroupRead,
+		Update: resourceGameServerGroupUpdate,
+		Delete: resourceGameServerGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(gameServerGroupCreatedDefaultTimeout),
+			Delete: schema.DefaultTimeout(gameServerGroupDeletedDefaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_scaling_group_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_scaling_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"estimated_instance_warmup": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"target_tracking_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"target_value": {
+										Type:         schema.TypeFloat,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.FloatAtLeast(0),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"balancing_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(gamelift.BalancingStrategy_Values(), false),
+			},
+			"game_server_protection_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(gamelift.GameServerProtectionPolicy_Values(), false),
+			},
+			"instance_definition": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 2,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(gamelift.GameServerGroupInstanceType_Values(), false),
+						},
+						"weighted_capacity": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"launch_template": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ExactlyOneOf: []string{"launch_template.0.id", "launch_template.0.name"},
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ExactlyOneOf: []string{"launch_template.0.id", "launch_template.0.name"},
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"max_size": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"min_size": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-\.]+$`), "must contain only alphanumeric characters, hyphens and periods"),
+				),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"vpc_subnets": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 20,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceGameServerGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GameLiftConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &gamelift.CreateGameServerGroupInput{
+		GameServerGroupName: aws.String(name),
+		InstanceDefinitions: expandGameliftInstanceDefinitions(d.Get("instance_definition").(*schema.Set).List()),
+		LaunchTemplate:      expandGameliftLaunchTemplateSpecification(d.Get("launch_template").([]interface{})),
+		MaxSize:             aws.Int64(int64(d.Get("max_size").(int))),
+		MinSize:             aws.Int64(int64(d.Get("min_size").(int))),
+		RoleArn:             aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("auto_scaling_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AutoScalingPolicy = expandGameliftGameServerGroupAutoScalingPolicy(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("balancing_strategy"); ok {
+		input.BalancingStrategy = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("game_server_protection_policy"); ok {
+		input.GameServerProtectionPolicy = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("vpc_subnets"); ok && v.(*schema.Set).Len() > 0 {
+		input.VpcSubnets = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating GameLift Game Server Group: %s", input)
+	_, err := tfresource.RetryWhen(
+		tfiam.PropagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateGameServerGroup(input)
+		},
+		func(err error) (bool, error) {
+			// The IAM role may not yet be assumable by GameLift.
+			if tfawserr.ErrMessageContains(err, gamelift.ErrCodeAccessDeniedException, "GameLift is not authorized to perform") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("error creating GameLift Game Server Group (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitGameServerGroupActive(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for GameLift Game Server Group (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceGameServerGroupRead(d, meta)
+}
+
+func resourceGameServerGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GameLiftConn
+	autoscalingConn := meta.(*conns.AWSClient).AutoScalingConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	gameServerGroup, err := FindGameServerGroupByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] GameLift Game Server Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading GameLift Game Server Group (%s): %w", d.Id(), err)
+	}
+
+	autoScalingGroupName := GameServerGroupAutoScalingGroupName(gameServerGroup)
+	output, err := autoscalingConn.DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: aws.StringSlice([]string{autoScalingGroupName}),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading GameLift Game Server Group (%s) Auto Scaling Group (%s): %w", d.Id(), autoScalingGroupName, err)
+	}
+
+	if len(output.AutoScalingGroups) == 0 || output.AutoScalingGroups[0] == nil {
+		return fmt.Errorf("error reading GameLift Game Server Group (%s) Auto Scaling Group (%s): not found", d.Id(), autoScalingGroupName)
+	}
+
+	asg := output.AutoScalingGroups[0]
+
+	arn := aws.StringValue(gameServerGroup.GameServerGroupArn)
+	d.Set("arn", arn)
+	d.Set("auto_scaling_group_arn", gameServerGroup.AutoScalingGroupArn)
+	d.Set("balancing_strategy", gameServerGroup.BalancingStrategy)
+	d.Set("game_server_protection_policy", gameServerGroup.GameServerProtectionPolicy)
+
+	if err := d.Set("instance_definition", flattenGameliftInstanceDefinitions(gameServerGroup.InstanceDefinitions)); err != nil {
+		return fmt.Errorf("error setting instance_definition: %w", err)
+	}
+
+	if asg.MixedInstancesPolicy != nil && asg.MixedInstancesPolicy.LaunchTemplate != nil {
+		if err := d.Set("launch_template", flattenGameliftAutoScalingLaunchTemplateSpecification(asg.MixedInstancesPolicy.LaunchTemplate.LaunchTemplateSpecification)); err != nil {
+			return fmt.Errorf("error setting launch_template: %w", err)
+		}
+	} else {
+		d.Set("launch_template", nil)
+	}
+
+	d.Set("max_size", asg.MaxSize)
+	d.Set("min_size", asg.MinSize)
+	d.Set("name", gameServerGroup.GameServerGroupName)
+	d.Set("role_arn", gameServerGroup.RoleArn)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for GameLift Game Server Group (%s): %w", arn, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceGameServerGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GameLiftConn
+	autoscalingConn := meta.(*conns.AWSClient).AutoScalingConn
+
+	if d.HasChanges("balancing_strategy", "game_server_protection_policy", "instance_definition", "role_arn") {
+		input := &gamelift.UpdateGameServerGroupInput{
+			GameServerGroupName: aws.String(d.Id()),
+			InstanceDefinitions: expandGameliftInstanceDefinitions(d.Get("instance_definition").(*schema.Set).List()),
+			RoleArn:             aws.String(d.Get("role_arn").(string)),
+		}
+
+		if v, ok := d.GetOk("balancing_strategy"); ok {
+			input.BalancingStrategy = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("game_server_protection_policy"); ok {
+			input.GameServerProtectionPolicy = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating GameLift Game Server Group: %s", input)
+		_, err := conn.UpdateGameServerGroup(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating GameLift Game Server Group (%s): %w", d.Id(), err)
+		}
+	}
+
+	// The size of the group is managed on the underlying Auto Scaling group.
+	if d.HasChanges("max_size", "min_size") {
+		gameServerGroup, err := FindGameServerGroupByName(conn, d.Id())
+
+		if err != nil {
+			return fmt.Errorf("error reading GameLift Game Server Group (%s): %w", d.Id(), err)
+		}
+
+		autoScalingGroupName := GameServerGroupAutoScalingGroupName(gameServerGroup)
+		input := &autoscaling.UpdateAutoScalingGroupInput{
+			AutoScalingGroupName: aws.String(autoScalingGroupName),
+			MaxSize:              aws.Int64(int64(d.Get("max_size").(int))),
+			MinSize:              aws.Int64(int64(d.Get("min_size").(int))),
+		}
+
+		log.Printf("[DEBUG] Updating GameLift Game Server Group Auto Scaling Group: %s", input)
+		_, err = autoscalingConn.UpdateAutoScalingGroup(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating GameLift Game Server Group (%s) Auto Scaling Group (%s): %w", d.Id(), autoScalingGroupName, err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating GameLift Game Server Group (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceGameServerGroupRead(d, meta)
+}
+
+func resourceGameServerGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GameLiftConn
+
+	log.Printf("[DEBUG] Deleting GameLift Game Server Group: %s", d.Id())
+	_, err := conn.DeleteGameServerGroup(&gamelift.DeleteGameServerGroupInput{
+		GameServerGroupName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, gamelift.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting GameLift Game Server Group (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitGameServerGroupDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for GameLift Game Server Group (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// GameServerGroupAutoScalingGroupName returns the name of the Auto Scaling group backing the game server group.
+func GameServerGroupAutoScalingGroupName(gameServerGroup *gamelift.GameServerGroup) string {
+	autoScalingGroupARN := aws.StringValue(gameServerGroup.AutoScalingGroupArn)
+
+	if i := strings.LastIndex(autoScalingGroupARN, "/"); i >= 0 {
+		return autoScalingGroupARN[i+1:]
+	}
+
+	return autoScalingGroupARN
+}
+
+func expandGameliftGameServerGroupAutoScalingPolicy(tfMap map[string]interface{}) *gamelift.GameServerGroupAutoScalingPolicy {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &gamelift.GameServerGroupAutoScalingPolicy{}
+
+	if v, ok := tfMap["estimated_instance_warmup"].(int); ok && v != 0 {
+		apiObject.EstimatedInstanceWarmup = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["target_tracking_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.TargetTrackingConfiguration = &gamelift.TargetTrackingConfiguration{
+			TargetValue: aws.Float64(v[0].(map[string]interface{})["target_value"].(float64)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandGameliftInstanceDefinitions(tfList []interface{}) []*gamelift.InstanceDefinition {
+	var apiObjects []*gamelift.InstanceDefinition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &gamelift.InstanceDefinition{
+			InstanceType: aws.String(tfMap["instance_type"].(string)),
+		}
+
+		if v, ok := tfMap["weighted_capacity"].(string); ok && v != "" {
+			apiObject.WeightedCapacity = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandGameliftLaunchTemplateSpecification(tfList []interface{}) *gamelift.LaunchTemplateSpecification {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &gamelift.LaunchTemplateSpecification{}
+
+	if v, ok := tfMap["id"].(string); ok && v != "" {
+		apiObject.LaunchTemplateId = aws.String(v)
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.LaunchTemplateName = aws.String(v)
+	}
+
+	if v, ok := tfMap["version"].(string); ok && v != "" {
+		apiObject.Version = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenGameliftInstanceDefinitions(apiObjects []*gamelift.InstanceDefinition) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"instance_type":     aws.StringValue(apiObject.InstanceType),
+			"weighted_capacity": aws.StringValue(apiObject.WeightedCapacity),
+		})
+	}
+
+	return tfList
+}
+
+func flattenGameliftAutoScalingLaunchTemplateSpecification(apiObject *autoscaling.LaunchTemplateSpecification) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"id":      aws.StringValue(apiObject.LaunchTemplateId),
+		"name":    aws.StringValue(apiObject.LaunchTemplateName),
+		"version": aws.StringValue(apiObject.Version),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,169 @@
+package gamelift_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgamelift "github.com/hashicorp/terraform-provider-aws/internal/service/gamelift"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGameLiftGameServerGroup_basic(t *testing.T) {
+	var v gamelift.GameServerGroup
+	resourceName := "aws_gamelift_game_server_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(gamelift.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, gamelift.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckGameServerGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGameServerGroupConfig(rName, 1, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGameServerGroupExists(resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "gamelift", fmt.Sprintf("gameservergroup/%s", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "auto_scaling_group_arn"),
+					resource.TestCheckResourceAttr(resourceName, "balancing_strategy", "SPOT_PREFERRED"),
+					resource.TestCheckResourceAttr(resourceName, "game_server_protection_policy", "NO_PROTECTION"),
+					resource.TestCheckResourceAttr(resourceName, "instance_definition.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_template.0.id", "aws_launch_template.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "max_size", "2"),
+					resource.TestCheckResourceAttr(resourceName, "min_size", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGameServerGroupConfig(rName, 2, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGameServerGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "max_size", "3"),
+					resource.TestCheckResourceAttr(resourceName, "min_size", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGameServerGroupExists(n string, v *gamelift.GameServerGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No GameLift Game Server Group ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn
+
+		output, err := tfgamelift.FindGameServerGroupByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckGameServerGroupDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_gamelift_game_server_group" {
+			continue
+		}
+
+		_, err := tfgamelift.FindGameServerGroupByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("GameLift Game Server Group %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccGameServerGroupConfig(rName string, minSize, maxSize int) string {
+	return acctest.ConfigCompose(acctest.ConfigLatestAmazonLinuxHvmEbsAmi(), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = [
+          "autoscaling.${data.aws_partition.current.dns_suffix}",
+          "gamelift.${data.aws_partition.current.dns_suffix}",
+        ]
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/GameLiftGameServerGroupPolicy"
+  role       = aws_iam_role.test.name
+}
+
+resource "aws_launch_template" "test" {
+  image_id = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  name     = %[1]q
+}
+
+resource "aws_gamelift_game_server_group" "test" {
+  name     = %[1]q
+  max_size = %[3]d
+  min_size = %[2]d
+  role_arn = aws_iam_role.test.arn
+
+  instance_definition {
+    instance_type = "c5.large"
+  }
+
+  instance_definition {
+    instance_type = "c5a.large"
+  }
+
+  launch_template {
+    id = aws_launch_template.test.id
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, minSize, maxSize))
+}
@@ -0,0 +1,60 @@
+package gamelift
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	gameServerGroupCreatedDefaultTimeout = 10 * time.Minute
+	gameServerGroupDeletedDefaultTimeout = 30 * time.Minute
+)
+
+func waitGameServerGroupActive(conn *gamelift.GameLift, name string, timeout time.Duration) (*gamelift.GameServerGroup, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			gamelift.GameServerGroupStatusNew,
+			gamelift.GameServerGroupStatusActivating,
+		},
+		Target:  []string{gamelift.GameServerGroupStatusActive},
+		Refresh: statusGameServerGroup(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*gamelift.GameServerGroup); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitGameServerGroupDeleted(conn *gamelift.GameLift, name string, timeout time.Duration) (*gamelift.GameServerGroup, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			gamelift.GameServerGroupStatusDeleteScheduled,
+			gamelift.GameServerGroupStatusDeleting,
+		},
+		Target:  []string{},
+		Refresh: statusGameServerGroup(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*gamelift.GameServerGroup); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
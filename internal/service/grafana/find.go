@@ -0,0 +1,61 @@
+package grafana
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedgrafana"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindWorkspaceByID(ctx context.Context, conn *managedgrafana.ManagedGrafana, id string) (*managedgrafana.WorkspaceDescription, error) {
+	input := &managedgrafana.DescribeWorkspaceInput{
+		WorkspaceId: aws.String(id),
+	}
+
+	output, err := conn.DescribeWorkspaceWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, managedgrafana.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Workspace == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Workspace, nil
+}
+
+func FindSAMLConfigurationByWorkspaceID(ctx context.Context, conn *managedgrafana.ManagedGrafana, id string) (*managedgrafana.SamlAuthentication, error) {
+	input := &managedgrafana.DescribeWorkspaceAuthenticationInput{
+		WorkspaceId: aws.String(id),
+	}
+
+	output, err := conn.DescribeWorkspaceAuthenticationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, managedgrafana.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Authentication == nil || output.Authentication.Saml == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Authentication.Saml, nil
+}
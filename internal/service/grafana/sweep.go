@@ -0,0 +1,65 @@
+//go:build sweep
+// +build sweep
+
+package grafana
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedgrafana"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_grafana_workspace", &resource.Sweeper{
+		Name: "aws_grafana_workspace",
+		F:    sweepWorkspaces,
+	})
+}
+
+func sweepWorkspaces(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).GrafanaConn
+	input := &managedgrafana.ListWorkspacesInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListWorkspacesPages(input, func(page *managedgrafana.ListWorkspacesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Workspaces {
+			r := ResourceWorkspace()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Grafana Workspace sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Grafana Workspaces (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Grafana Workspaces (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package grafana
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/managedgrafana"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	workspaceCreatedTimeout = 30 * time.Minute
+	workspaceUpdatedTimeout = 30 * time.Minute
+	workspaceDeletedTimeout = 30 * time.Minute
+
+	samlConfigurationCreatedTimeout = 2 * time.Minute
+)
+
+func waitWorkspaceCreated(ctx context.Context, conn *managedgrafana.ManagedGrafana, id string) (*managedgrafana.WorkspaceDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{managedgrafana.WorkspaceStatusCreating},
+		Target:  []string{managedgrafana.WorkspaceStatusActive},
+		Refresh: statusWorkspace(ctx, conn, id),
+		Timeout: workspaceCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*managedgrafana.WorkspaceDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitWorkspaceUpdated(ctx context.Context, conn *managedgrafana.ManagedGrafana, id string) (*managedgrafana.WorkspaceDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{managedgrafana.WorkspaceStatusUpdating, managedgrafana.WorkspaceStatusUpgrading},
+		Target:  []string{managedgrafana.WorkspaceStatusActive},
+		Refresh: statusWorkspace(ctx, conn, id),
+		Timeout: workspaceUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*managedgrafana.WorkspaceDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitWorkspaceDeleted(ctx context.Context, conn *managedgrafana.ManagedGrafana, id string) (*managedgrafana.WorkspaceDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{managedgrafana.WorkspaceStatusDeleting},
+		Target:  []string{},
+		Refresh: statusWorkspace(ctx, conn, id),
+		Timeout: workspaceDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*managedgrafana.WorkspaceDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSAMLConfigurationCreated(ctx context.Context, conn *managedgrafana.ManagedGrafana, id string) (*managedgrafana.SamlAuthentication, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{managedgrafana.SamlConfigurationStatusNotConfigured},
+		Target:  []string{managedgrafana.SamlConfigurationStatusConfigured},
+		Refresh: statusSAMLConfiguration(ctx, conn, id),
+		Timeout: samlConfigurationCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*managedgrafana.SamlAuthentication); ok {
+		return output, err
+	}
+
+	return nil, err
+}
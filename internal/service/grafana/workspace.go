@@ -0,0 +1,318 @@
+package grafana
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/managedgrafana"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceWorkspace() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWorkspaceCreate,
+		ReadContext:   resourceWorkspaceRead,
+		UpdateContext: resourceWorkspaceUpdate,
+		DeleteContext: resourceWorkspaceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_access_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(managedgrafana.AccountAccessType_Values(), false),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authentication_providers": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(managedgrafana.AuthenticationProviderTypes_Values(), false),
+				},
+			},
+			"data_sources": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(managedgrafana.DataSourceType_Values(), false),
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"grafana_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"notification_destinations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(managedgrafana.NotificationDestinationType_Values(), false),
+				},
+			},
+			"organization_role_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"organizational_units": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"permission_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(managedgrafana.PermissionType_Values(), false),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"saml_configuration_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"stack_set_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceWorkspaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GrafanaConn
+
+	input := &managedgrafana.CreateWorkspaceInput{
+		AccountAccessType:       aws.String(d.Get("account_access_type").(string)),
+		AuthenticationProviders: flex.ExpandStringList(d.Get("authentication_providers").([]interface{})),
+		PermissionType:          aws.String(d.Get("permission_type").(string)),
+	}
+
+	if v, ok := d.GetOk("data_sources"); ok {
+		input.WorkspaceDataSources = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.WorkspaceDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		input.WorkspaceName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("notification_destinations"); ok {
+		input.WorkspaceNotificationDestinations = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("organization_role_name"); ok {
+		input.OrganizationRoleName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("organizational_units"); ok {
+		input.WorkspaceOrganizationalUnits = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.WorkspaceRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("stack_set_name"); ok {
+		input.StackSetName = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Grafana Workspace: %s", input)
+	output, err := conn.CreateWorkspaceWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Grafana Workspace: %w", err))
+	}
+
+	d.SetId(aws.StringValue(output.Workspace.Id))
+
+	if _, err := waitWorkspaceCreated(ctx, conn, d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for Grafana Workspace (%s) create: %w", d.Id(), err))
+	}
+
+	return resourceWorkspaceRead(ctx, d, meta)
+}
+
+func resourceWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GrafanaConn
+
+	workspace, err := FindWorkspaceByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Grafana Workspace (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Grafana Workspace (%s): %w", d.Id(), err))
+	}
+
+	d.Set("account_access_type", workspace.AccountAccessType)
+	workspaceARN := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "grafana",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("/workspaces/%s", d.Id()),
+	}.String()
+	d.Set("arn", workspaceARN)
+	if workspace.Authentication != nil {
+		d.Set("authentication_providers", aws.StringValueSlice(workspace.Authentication.Providers))
+		d.Set("saml_configuration_status", workspace.Authentication.SamlConfigurationStatus)
+	} else {
+		d.Set("authentication_providers", nil)
+		d.Set("saml_configuration_status", nil)
+	}
+	d.Set("data_sources", aws.StringValueSlice(workspace.DataSources))
+	d.Set("description", workspace.Description)
+	d.Set("endpoint", workspace.Endpoint)
+	d.Set("grafana_version", workspace.GrafanaVersion)
+	d.Set("name", workspace.Name)
+	d.Set("notification_destinations", aws.StringValueSlice(workspace.NotificationDestinations))
+	d.Set("organization_role_name", workspace.OrganizationRoleName)
+	d.Set("organizational_units", aws.StringValueSlice(workspace.OrganizationalUnits))
+	d.Set("permission_type", workspace.PermissionType)
+	d.Set("role_arn", workspace.WorkspaceRoleArn)
+	d.Set("stack_set_name", workspace.StackSetName)
+
+	return nil
+}
+
+func resourceWorkspaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GrafanaConn
+
+	if d.HasChangesExcept("authentication_providers") {
+		input := &managedgrafana.UpdateWorkspaceInput{
+			WorkspaceId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("account_access_type") {
+			input.AccountAccessType = aws.String(d.Get("account_access_type").(string))
+		}
+
+		if d.HasChange("data_sources") {
+			input.WorkspaceDataSources = flex.ExpandStringList(d.Get("data_sources").([]interface{}))
+		}
+
+		if d.HasChange("description") {
+			input.WorkspaceDescription = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("name") {
+			input.WorkspaceName = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("notification_destinations") {
+			input.WorkspaceNotificationDestinations = flex.ExpandStringList(d.Get("notification_destinations").([]interface{}))
+		}
+
+		if d.HasChange("organization_role_name") {
+			input.OrganizationRoleName = aws.String(d.Get("organization_role_name").(string))
+		}
+
+		if d.HasChange("organizational_units") {
+			input.WorkspaceOrganizationalUnits = flex.ExpandStringList(d.Get("organizational_units").([]interface{}))
+		}
+
+		if d.HasChange("role_arn") {
+			input.WorkspaceRoleArn = aws.String(d.Get("role_arn").(string))
+		}
+
+		if d.HasChange("stack_set_name") {
+			input.StackSetName = aws.String(d.Get("stack_set_name").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Grafana Workspace: %s", input)
+		_, err := conn.UpdateWorkspaceWithContext(ctx, input)
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Grafana Workspace (%s): %w", d.Id(), err))
+		}
+
+		if _, err := waitWorkspaceUpdated(ctx, conn, d.Id()); err != nil {
+			return diag.FromErr(fmt.Errorf("error waiting for Grafana Workspace (%s) update: %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("authentication_providers") {
+		input := &managedgrafana.UpdateWorkspaceAuthenticationInput{
+			AuthenticationProviders: flex.ExpandStringList(d.Get("authentication_providers").([]interface{})),
+			WorkspaceId:             aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Grafana Workspace authentication: %s", input)
+		_, err := conn.UpdateWorkspaceAuthenticationWithContext(ctx, input)
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Grafana Workspace (%s) authentication providers: %w", d.Id(), err))
+		}
+
+		if _, err := waitWorkspaceUpdated(ctx, conn, d.Id()); err != nil {
+			return diag.FromErr(fmt.Errorf("error waiting for Grafana Workspace (%s) update: %w", d.Id(), err))
+		}
+	}
+
+	return resourceWorkspaceRead(ctx, d, meta)
+}
+
+func resourceWorkspaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GrafanaConn
+
+	log.Printf("[DEBUG] Deleting Grafana Workspace: %s", d.Id())
+	_, err := conn.DeleteWorkspaceWithContext(ctx, &managedgrafana.DeleteWorkspaceInput{
+		WorkspaceId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, managedgrafana.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Grafana Workspace (%s): %w", d.Id(), err))
+	}
+
+	if _, err := waitWorkspaceDeleted(ctx, conn, d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for Grafana Workspace (%s) delete: %w", d.Id(), err))
+	}
+
+	return nil
+}
@@ -0,0 +1,240 @@
+package grafana
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedgrafana"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceWorkspaceSAMLConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWorkspaceSAMLConfigurationUpsert,
+		ReadContext:   resourceWorkspaceSAMLConfigurationRead,
+		UpdateContext: resourceWorkspaceSAMLConfigurationUpsert,
+		DeleteContext: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"admin_role_values": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"allowed_organizations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"editor_role_values": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"email_assertion": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"groups_assertion": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"idp_metadata_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"idp_metadata_url", "idp_metadata_xml"},
+			},
+			"idp_metadata_xml": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"idp_metadata_url", "idp_metadata_xml"},
+			},
+			"login_assertion": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"login_validity_duration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"name_assertion": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"org_assertion": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"role_assertion": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceWorkspaceSAMLConfigurationUpsert(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GrafanaConn
+
+	workspaceID := d.Get("workspace_id").(string)
+	workspace, err := FindWorkspaceByID(ctx, conn, workspaceID)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Grafana Workspace (%s): %w", workspaceID, err))
+	}
+
+	// The workspace's authentication providers must be passed on every update.
+	var authenticationProviders []*string
+	if workspace.Authentication != nil {
+		authenticationProviders = workspace.Authentication.Providers
+	}
+
+	samlConfiguration := &managedgrafana.SamlConfiguration{
+		AssertionAttributes: &managedgrafana.AssertionAttributes{},
+		IdpMetadata:         &managedgrafana.IdpMetadata{},
+		RoleValues: &managedgrafana.RoleValues{
+			Editor: flex.ExpandStringList(d.Get("editor_role_values").([]interface{})),
+		},
+	}
+
+	if v, ok := d.GetOk("admin_role_values"); ok {
+		samlConfiguration.RoleValues.Admin = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("allowed_organizations"); ok {
+		samlConfiguration.AllowedOrganizations = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("email_assertion"); ok {
+		samlConfiguration.AssertionAttributes.Email = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("groups_assertion"); ok {
+		samlConfiguration.AssertionAttributes.Groups = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("idp_metadata_url"); ok {
+		samlConfiguration.IdpMetadata.Url = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("idp_metadata_xml"); ok {
+		samlConfiguration.IdpMetadata.Xml = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("login_assertion"); ok {
+		samlConfiguration.AssertionAttributes.Login = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("login_validity_duration"); ok {
+		samlConfiguration.LoginValidityDuration = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("name_assertion"); ok {
+		samlConfiguration.AssertionAttributes.Name = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("org_assertion"); ok {
+		samlConfiguration.AssertionAttributes.Org = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("role_assertion"); ok {
+		samlConfiguration.AssertionAttributes.Role = aws.String(v.(string))
+	}
+
+	input := &managedgrafana.UpdateWorkspaceAuthenticationInput{
+		AuthenticationProviders: authenticationProviders,
+		SamlConfiguration:       samlConfiguration,
+		WorkspaceId:             aws.String(workspaceID),
+	}
+
+	log.Printf("[DEBUG] Updating Grafana Workspace SAML Configuration: %s", input)
+	_, err = conn.UpdateWorkspaceAuthenticationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Grafana Workspace (%s) SAML Configuration: %w", workspaceID, err))
+	}
+
+	d.SetId(workspaceID)
+
+	if _, err := waitSAMLConfigurationCreated(ctx, conn, d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for Grafana Workspace (%s) SAML Configuration: %w", d.Id(), err))
+	}
+
+	return resourceWorkspaceSAMLConfigurationRead(ctx, d, meta)
+}
+
+func resourceWorkspaceSAMLConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GrafanaConn
+
+	saml, err := FindSAMLConfigurationByWorkspaceID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Grafana Workspace SAML Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Grafana Workspace (%s) SAML Configuration: %w", d.Id(), err))
+	}
+
+	if !d.IsNewResource() && aws.StringValue(saml.Status) == managedgrafana.SamlConfigurationStatusNotConfigured {
+		log.Printf("[WARN] Grafana Workspace SAML Configuration (%s) not configured, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("status", saml.Status)
+	d.Set("workspace_id", d.Id())
+
+	if configuration := saml.Configuration; configuration != nil {
+		d.Set("allowed_organizations", aws.StringValueSlice(configuration.AllowedOrganizations))
+		d.Set("login_validity_duration", configuration.LoginValidityDuration)
+
+		if v := configuration.AssertionAttributes; v != nil {
+			d.Set("email_assertion", v.Email)
+			d.Set("groups_assertion", v.Groups)
+			d.Set("login_assertion", v.Login)
+			d.Set("name_assertion", v.Name)
+			d.Set("org_assertion", v.Org)
+			d.Set("role_assertion", v.Role)
+		}
+
+		if v := configuration.IdpMetadata; v != nil {
+			d.Set("idp_metadata_url", v.Url)
+			d.Set("idp_metadata_xml", v.Xml)
+		}
+
+		if v := configuration.RoleValues; v != nil {
+			d.Set("admin_role_values", aws.StringValueSlice(v.Admin))
+			d.Set("editor_role_values", aws.StringValueSlice(v.Editor))
+		}
+	}
+
+	return nil
+}
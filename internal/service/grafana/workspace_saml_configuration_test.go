@@ -0,0 +1,83 @@
+package grafana_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/managedgrafana"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgrafana "github.com/hashicorp/terraform-provider-aws/internal/service/grafana"
+)
+
+func TestAccGrafanaWorkspaceSAMLConfiguration_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_grafana_workspace_saml_configuration.test"
+	workspaceResourceName := "aws_grafana_workspace.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(managedgrafana.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, managedgrafana.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkspaceSAMLConfigurationConfig(rName, "editor"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkspaceSAMLConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "editor_role_values.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "editor_role_values.0", "editor"),
+					resource.TestCheckResourceAttrSet(resourceName, "idp_metadata_url"),
+					resource.TestCheckResourceAttr(resourceName, "status", managedgrafana.SamlConfigurationStatusConfigured),
+					resource.TestCheckResourceAttrPair(resourceName, "workspace_id", workspaceResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccWorkspaceSAMLConfigurationConfig(rName, "editor2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkspaceSAMLConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "editor_role_values.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "editor_role_values.0", "editor2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckWorkspaceSAMLConfigurationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Grafana Workspace ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GrafanaConn
+
+		_, err := tfgrafana.FindSAMLConfigurationByWorkspaceID(context.TODO(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccWorkspaceSAMLConfigurationConfig(rName, editorRole string) string {
+	return acctest.ConfigCompose(testAccWorkspaceConfig(rName), fmt.Sprintf(`
+resource "aws_grafana_workspace_saml_configuration" "test" {
+  editor_role_values = [%[1]q]
+  idp_metadata_url   = "https://portal.sso.us-east-1.amazonaws.com/saml/metadata/NjMwMDg2NDQ1OTk4X2lucy1jY2E2ZjM3M2ZiOTdkZGZl"
+  workspace_id       = aws_grafana_workspace.test.id
+}
+`, editorRole))
+}
@@ -0,0 +1,233 @@
+package greengrassv2
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/greengrassv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+func ResourceComponentVersion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComponentVersionCreate,
+		Read:   resourceComponentVersionRead,
+		Update: resourceComponentVersionUpdate,
+		Delete: resourceComponentVersionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(componentVersionCreatedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"component_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"component_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"inline_recipe": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"inline_recipe", "recipe_file"},
+				ValidateFunc: validation.StringLenBetween(1, 16000),
+			},
+			"publisher": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"recipe_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"inline_recipe", "recipe_file"},
+			},
+			// The recipe file is only read on create, so changes to its contents are detected via its hash.
+			"recipe_hash": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceComponentVersionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	var recipe []byte
+
+	if v, ok := d.GetOk("inline_recipe"); ok {
+		recipe = []byte(v.(string))
+	} else {
+		filename := d.Get("recipe_file").(string)
+		var err error
+
+		recipe, err = loadRecipeFile(filename)
+
+		if err != nil {
+			return fmt.Errorf("error reading Greengrass V2 Component recipe file (%s): %w", filename, err)
+		}
+	}
+
+	input := &greengrassv2.CreateComponentVersionInput{
+		InlineRecipe: recipe,
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Greengrass V2 Component Version: %s", input)
+	output, err := conn.CreateComponentVersion(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Greengrass V2 Component Version: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.Arn))
+
+	if _, ok := d.GetOk("recipe_hash"); !ok {
+		d.Set("recipe_hash", recipeHash(recipe))
+	}
+
+	if _, err := waitComponentVersionCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Greengrass V2 Component Version (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceComponentVersionRead(d, meta)
+}
+
+func resourceComponentVersionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindComponentVersionByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Greengrass V2 Component Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Greengrass V2 Component Version (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("component_name", output.ComponentName)
+	d.Set("component_version", output.ComponentVersion)
+	if output.CreationTimestamp != nil {
+		d.Set("creation_timestamp", aws.TimeValue(output.CreationTimestamp).Format(time.RFC3339))
+	} else {
+		d.Set("creation_timestamp", nil)
+	}
+	d.Set("description", output.Description)
+	d.Set("publisher", output.Publisher)
+	d.Set("status", output.Status.ComponentState)
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceComponentVersionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating Greengrass V2 Component Version (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceComponentVersionRead(d, meta)
+}
+
+func resourceComponentVersionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn
+
+	log.Printf("[DEBUG] Deleting Greengrass V2 Component Version: %s", d.Id())
+	_, err := conn.DeleteComponent(&greengrassv2.DeleteComponentInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, greengrassv2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Greengrass V2 Component Version (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func loadRecipeFile(v string) ([]byte, error) {
+	filename, err := homedir.Expand(v)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return os.ReadFile(filename)
+}
+
+// recipeHash returns the base64-encoded SHA256 hash of a recipe, the same value filebase64sha256() produces.
+func recipeHash(recipe []byte) string {
+	hash := sha256.Sum256(recipe)
+
+	return base64.StdEncoding.EncodeToString(hash[:])
+}
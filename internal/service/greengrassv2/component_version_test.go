@@ -0,0 +1,252 @@
+package greengrassv2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/greengrassv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgreengrassv2 "github.com/hashicorp/terraform-provider-aws/internal/service/greengrassv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGreengrassV2ComponentVersion_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_greengrassv2_component_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckComponentVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentVersionConfig(rName, "1.0.0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentVersionExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "greengrass", regexp.MustCompile(fmt.Sprintf(`components:%s:versions:1\.0\.0$`, rName))),
+					resource.TestCheckResourceAttr(resourceName, "component_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "component_version", "1.0.0"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_timestamp"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Terraform acceptance test component"),
+					resource.TestCheckResourceAttr(resourceName, "publisher", "Terraform"),
+					resource.TestCheckResourceAttrSet(resourceName, "recipe_hash"),
+					resource.TestCheckResourceAttr(resourceName, "status", greengrassv2.CloudComponentStateDeployable),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"inline_recipe", "recipe_hash"},
+			},
+			{
+				Config: testAccComponentVersionConfig(rName, "1.0.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "component_version", "1.0.1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGreengrassV2ComponentVersion_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_greengrassv2_component_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckComponentVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentVersionConfig(rName, "1.0.0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentVersionExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfgreengrassv2.ResourceComponentVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGreengrassV2ComponentVersion_recipeFile(t *testing.T) {
+	resourceName := "aws_greengrassv2_component_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckComponentVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentVersionRecipeFileConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "component_name", "com.example.HelloWorldFile"),
+					resource.TestCheckResourceAttr(resourceName, "component_version", "1.0.0"),
+					resource.TestCheckResourceAttr(resourceName, "recipe_file", "test-fixtures/recipe.yaml"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGreengrassV2ComponentVersion_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_greengrassv2_component_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckComponentVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentVersionTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"inline_recipe", "recipe_hash"},
+			},
+			{
+				Config: testAccComponentVersionTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccComponentVersionTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComponentVersionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Greengrass V2 Component Version ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassV2Conn
+
+		_, err := tfgreengrassv2.FindComponentVersionByARN(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckComponentVersionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassV2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_greengrassv2_component_version" {
+			continue
+		}
+
+		_, err := tfgreengrassv2.FindComponentVersionByARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Greengrass V2 Component Version %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccComponentVersionRecipe(rName, version string) string {
+	return fmt.Sprintf(`
+  inline_recipe = jsonencode({
+    RecipeFormatVersion  = "2020-01-25"
+    ComponentName        = %[1]q
+    ComponentVersion     = %[2]q
+    ComponentDescription = "Terraform acceptance test component"
+    ComponentPublisher   = "Terraform"
+    Manifests = [{
+      Platform = {
+        os = "linux"
+      }
+      Lifecycle = {
+        Run = "echo 'Hello, world!'"
+      }
+    }]
+  })
+`, rName, version)
+}
+
+func testAccComponentVersionConfig(rName, version string) string {
+	return fmt.Sprintf(`
+resource "aws_greengrassv2_component_version" "test" {
+%[1]s
+}
+`, testAccComponentVersionRecipe(rName, version))
+}
+
+func testAccComponentVersionRecipeFileConfig() string {
+	return `
+resource "aws_greengrassv2_component_version" "test" {
+  recipe_file = "test-fixtures/recipe.yaml"
+  recipe_hash = filebase64sha256("test-fixtures/recipe.yaml")
+}
+`
+}
+
+func testAccComponentVersionTags1Config(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_greengrassv2_component_version" "test" {
+%[1]s
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, testAccComponentVersionRecipe(rName, "1.0.0"), tagKey1, tagValue1)
+}
+
+func testAccComponentVersionTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_greengrassv2_component_version" "test" {
+%[1]s
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, testAccComponentVersionRecipe(rName, "1.0.0"), tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,703 @@
+package greengrassv2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/greengrassv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDeployment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDeploymentCreate,
+		Read:   resourceDeploymentRead,
+		Update: resourceDeploymentUpdate,
+		Delete: resourceDeploymentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"component": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"configuration_update": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"merge": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateFunc:     validation.StringIsJSON,
+										DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+										StateFunc: func(v interface{}) string {
+											json, _ := structure.NormalizeJsonString(v)
+											return json
+										},
+									},
+									"reset": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"posix_user": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"deployment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deployment_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"deployment_policies": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"component_update_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"action": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(greengrassv2.DeploymentComponentUpdatePolicyAction_Values(), false),
+									},
+									"timeout_in_seconds": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"configuration_validation_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"timeout_in_seconds": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"failure_handling_policy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(greengrassv2.DeploymentFailureHandlingPolicy_Values(), false),
+						},
+					},
+				},
+			},
+			"iot_job_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"iot_job_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"abort_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"criteria": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"action": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice(greengrassv2.IoTJobAbortAction_Values(), false),
+												},
+												"failure_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice(greengrassv2.IoTJobExecutionFailureType_Values(), false),
+												},
+												"min_number_of_executed_things": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"threshold_percentage": {
+													Type:         schema.TypeFloat,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.FloatBetween(0, 100),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"job_executions_rollout_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"exponential_rate": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"base_rate_per_minute": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IntBetween(1, 1000),
+												},
+												"increment_factor": {
+													Type:         schema.TypeFloat,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.FloatBetween(1.1, 5),
+												},
+												"rate_increase_criteria": {
+													Type:     schema.TypeList,
+													Required: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"number_of_notified_things": {
+																Type:         schema.TypeInt,
+																Optional:     true,
+																ForceNew:     true,
+																ValidateFunc: validation.IntAtLeast(1),
+															},
+															"number_of_succeeded_things": {
+																Type:         schema.TypeInt,
+																Optional:     true,
+																ForceNew:     true,
+																ValidateFunc: validation.IntAtLeast(1),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"maximum_per_minute": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(1, 1000),
+									},
+								},
+							},
+						},
+						"timeout_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"in_progress_timeout_in_minutes": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"iot_job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"target_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDeploymentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	targetARN := d.Get("target_arn").(string)
+	input := &greengrassv2.CreateDeploymentInput{
+		TargetArn: aws.String(targetARN),
+	}
+
+	if v, ok := d.GetOk("component"); ok && v.(*schema.Set).Len() > 0 {
+		input.Components = expandComponentDeploymentSpecifications(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("deployment_name"); ok {
+		input.DeploymentName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("deployment_policies"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DeploymentPolicies = expandDeploymentPolicies(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("iot_job_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.IotJobConfiguration = expandDeploymentIoTJobConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Greengrass V2 Deployment: %s", input)
+	output, err := conn.CreateDeployment(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Greengrass V2 Deployment (%s): %w", targetARN, err)
+	}
+
+	d.SetId(aws.StringValue(output.DeploymentId))
+
+	return resourceDeploymentRead(d, meta)
+}
+
+func resourceDeploymentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindDeploymentByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Greengrass V2 Deployment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Greengrass V2 Deployment (%s): %w", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "greengrass",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("deployments:%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	if err := d.Set("component", flattenComponentDeploymentSpecifications(output.Components)); err != nil {
+		return fmt.Errorf("error setting component: %w", err)
+	}
+	d.Set("deployment_id", output.DeploymentId)
+	d.Set("deployment_name", output.DeploymentName)
+	if output.DeploymentPolicies != nil {
+		if err := d.Set("deployment_policies", []interface{}{flattenDeploymentPolicies(output.DeploymentPolicies)}); err != nil {
+			return fmt.Errorf("error setting deployment_policies: %w", err)
+		}
+	} else {
+		d.Set("deployment_policies", nil)
+	}
+	d.Set("iot_job_arn", output.IotJobArn)
+	if output.IotJobConfiguration != nil {
+		if err := d.Set("iot_job_configuration", flattenDeploymentIoTJobConfiguration(output.IotJobConfiguration)); err != nil {
+			return fmt.Errorf("error setting iot_job_configuration: %w", err)
+		}
+	} else {
+		d.Set("iot_job_configuration", nil)
+	}
+	d.Set("iot_job_id", output.IotJobId)
+	d.Set("status", output.DeploymentStatus)
+	d.Set("target_arn", output.TargetArn)
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDeploymentUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Greengrass V2 Deployment (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceDeploymentRead(d, meta)
+}
+
+func resourceDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn
+
+	// Only active deployments can be canceled. Completed, failed and canceled ones remain
+	// in the deployment history and are simply removed from state.
+	if status := d.Get("status").(string); status != greengrassv2.DeploymentStatusActive {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Canceling Greengrass V2 Deployment: %s", d.Id())
+	_, err := conn.CancelDeployment(&greengrassv2.CancelDeploymentInput{
+		DeploymentId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, greengrassv2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error canceling Greengrass V2 Deployment (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandComponentDeploymentSpecifications(tfList []interface{}) map[string]*greengrassv2.ComponentDeploymentSpecification {
+	apiObjects := make(map[string]*greengrassv2.ComponentDeploymentSpecification, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &greengrassv2.ComponentDeploymentSpecification{
+			ComponentVersion: aws.String(tfMap["version"].(string)),
+		}
+
+		if v, ok := tfMap["configuration_update"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.ConfigurationUpdate = &greengrassv2.ComponentConfigurationUpdate{}
+
+			if v, ok := tfMap["merge"].(string); ok && v != "" {
+				apiObject.ConfigurationUpdate.Merge = aws.String(v)
+			}
+
+			if v, ok := tfMap["reset"].([]interface{}); ok && len(v) > 0 {
+				apiObject.ConfigurationUpdate.Reset = flex.ExpandStringList(v)
+			}
+		}
+
+		if v, ok := tfMap["posix_user"].(string); ok && v != "" {
+			apiObject.RunWith = &greengrassv2.ComponentRunWith{
+				PosixUser: aws.String(v),
+			}
+		}
+
+		apiObjects[tfMap["name"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func expandDeploymentPolicies(tfMap map[string]interface{}) *greengrassv2.DeploymentPolicies {
+	apiObject := &greengrassv2.DeploymentPolicies{}
+
+	if v, ok := tfMap["component_update_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.ComponentUpdatePolicy = &greengrassv2.DeploymentComponentUpdatePolicy{}
+
+		if v, ok := tfMap["action"].(string); ok && v != "" {
+			apiObject.ComponentUpdatePolicy.Action = aws.String(v)
+		}
+
+		if v, ok := tfMap["timeout_in_seconds"].(int); ok && v != 0 {
+			apiObject.ComponentUpdatePolicy.TimeoutInSeconds = aws.Int64(int64(v))
+		}
+	}
+
+	if v, ok := tfMap["configuration_validation_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.ConfigurationValidationPolicy = &greengrassv2.DeploymentConfigurationValidationPolicy{}
+
+		if v, ok := tfMap["timeout_in_seconds"].(int); ok && v != 0 {
+			apiObject.ConfigurationValidationPolicy.TimeoutInSeconds = aws.Int64(int64(v))
+		}
+	}
+
+	if v, ok := tfMap["failure_handling_policy"].(string); ok && v != "" {
+		apiObject.FailureHandlingPolicy = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDeploymentIoTJobConfiguration(tfMap map[string]interface{}) *greengrassv2.DeploymentIoTJobConfiguration {
+	apiObject := &greengrassv2.DeploymentIoTJobConfiguration{}
+
+	if v, ok := tfMap["abort_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.AbortConfig = &greengrassv2.IoTJobAbortConfig{}
+
+		for _, tfMapRaw := range tfMap["criteria"].([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.AbortConfig.CriteriaList = append(apiObject.AbortConfig.CriteriaList, &greengrassv2.IoTJobAbortCriteria{
+				Action:                    aws.String(tfMap["action"].(string)),
+				FailureType:               aws.String(tfMap["failure_type"].(string)),
+				MinNumberOfExecutedThings: aws.Int64(int64(tfMap["min_number_of_executed_things"].(int))),
+				ThresholdPercentage:       aws.Float64(tfMap["threshold_percentage"].(float64)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["job_executions_rollout_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.JobExecutionsRolloutConfig = &greengrassv2.IoTJobExecutionsRolloutConfig{}
+
+		if v, ok := tfMap["exponential_rate"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.JobExecutionsRolloutConfig.ExponentialRate = &greengrassv2.IoTJobExponentialRolloutRate{
+				BaseRatePerMinute:    aws.Int64(int64(tfMap["base_rate_per_minute"].(int))),
+				IncrementFactor:      aws.Float64(tfMap["increment_factor"].(float64)),
+				RateIncreaseCriteria: &greengrassv2.IoTJobRateIncreaseCriteria{},
+			}
+
+			if v, ok := tfMap["rate_increase_criteria"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+				criteria := apiObject.JobExecutionsRolloutConfig.ExponentialRate.RateIncreaseCriteria
+
+				if v, ok := tfMap["number_of_notified_things"].(int); ok && v != 0 {
+					criteria.NumberOfNotifiedThings = aws.Int64(int64(v))
+				}
+
+				if v, ok := tfMap["number_of_succeeded_things"].(int); ok && v != 0 {
+					criteria.NumberOfSucceededThings = aws.Int64(int64(v))
+				}
+			}
+		}
+
+		if v, ok := tfMap["maximum_per_minute"].(int); ok && v != 0 {
+			apiObject.JobExecutionsRolloutConfig.MaximumPerMinute = aws.Int64(int64(v))
+		}
+	}
+
+	if v, ok := tfMap["timeout_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.TimeoutConfig = &greengrassv2.IoTJobTimeoutConfig{}
+
+		if v, ok := tfMap["in_progress_timeout_in_minutes"].(int); ok && v != 0 {
+			apiObject.TimeoutConfig.InProgressTimeoutInMinutes = aws.Int64(int64(v))
+		}
+	}
+
+	return apiObject
+}
+
+func flattenComponentDeploymentSpecifications(apiObjects map[string]*greengrassv2.ComponentDeploymentSpecification) []interface{} {
+	var tfList []interface{}
+
+	for name, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"name":    name,
+			"version": aws.StringValue(apiObject.ComponentVersion),
+		}
+
+		if v := apiObject.ConfigurationUpdate; v != nil {
+			tfMap["configuration_update"] = []interface{}{map[string]interface{}{
+				"merge": aws.StringValue(v.Merge),
+				"reset": aws.StringValueSlice(v.Reset),
+			}}
+		}
+
+		if v := apiObject.RunWith; v != nil {
+			tfMap["posix_user"] = aws.StringValue(v.PosixUser)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenDeploymentPolicies(apiObject *greengrassv2.DeploymentPolicies) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"failure_handling_policy": aws.StringValue(apiObject.FailureHandlingPolicy),
+	}
+
+	if v := apiObject.ComponentUpdatePolicy; v != nil {
+		tfMap["component_update_policy"] = []interface{}{map[string]interface{}{
+			"action":             aws.StringValue(v.Action),
+			"timeout_in_seconds": aws.Int64Value(v.TimeoutInSeconds),
+		}}
+	}
+
+	if v := apiObject.ConfigurationValidationPolicy; v != nil {
+		tfMap["configuration_validation_policy"] = []interface{}{map[string]interface{}{
+			"timeout_in_seconds": aws.Int64Value(v.TimeoutInSeconds),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenDeploymentIoTJobConfiguration(apiObject *greengrassv2.DeploymentIoTJobConfiguration) []interface{} {
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AbortConfig; v != nil && len(v.CriteriaList) > 0 {
+		var criteria []interface{}
+
+		for _, v := range v.CriteriaList {
+			if v == nil {
+				continue
+			}
+
+			criteria = append(criteria, map[string]interface{}{
+				"action":                        aws.StringValue(v.Action),
+				"failure_type":                  aws.StringValue(v.FailureType),
+				"min_number_of_executed_things": aws.Int64Value(v.MinNumberOfExecutedThings),
+				"threshold_percentage":          aws.Float64Value(v.ThresholdPercentage),
+			})
+		}
+
+		tfMap["abort_config"] = []interface{}{map[string]interface{}{
+			"criteria": criteria,
+		}}
+	}
+
+	if v := apiObject.JobExecutionsRolloutConfig; v != nil {
+		rolloutConfig := map[string]interface{}{
+			"maximum_per_minute": aws.Int64Value(v.MaximumPerMinute),
+		}
+
+		if v := v.ExponentialRate; v != nil {
+			exponentialRate := map[string]interface{}{
+				"base_rate_per_minute": aws.Int64Value(v.BaseRatePerMinute),
+				"increment_factor":     aws.Float64Value(v.IncrementFactor),
+			}
+
+			if v := v.RateIncreaseCriteria; v != nil {
+				exponentialRate["rate_increase_criteria"] = []interface{}{map[string]interface{}{
+					"number_of_notified_things":  aws.Int64Value(v.NumberOfNotifiedThings),
+					"number_of_succeeded_things": aws.Int64Value(v.NumberOfSucceededThings),
+				}}
+			}
+
+			rolloutConfig["exponential_rate"] = []interface{}{exponentialRate}
+		}
+
+		tfMap["job_executions_rollout_config"] = []interface{}{rolloutConfig}
+	}
+
+	if v := apiObject.TimeoutConfig; v != nil {
+		tfMap["timeout_config"] = []interface{}{map[string]interface{}{
+			"in_progress_timeout_in_minutes": aws.Int64Value(v.InProgressTimeoutInMinutes),
+		}}
+	}
+
+	if len(tfMap) == 0 {
+		return nil
+	}
+
+	return []interface{}{tfMap}
+}
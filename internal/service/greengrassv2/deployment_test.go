@@ -0,0 +1,321 @@
+package greengrassv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/greengrassv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgreengrassv2 "github.com/hashicorp/terraform-provider-aws/internal/service/greengrassv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGreengrassV2Deployment_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_greengrassv2_deployment.test"
+	thingGroupResourceName := "aws_iot_thing_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "component.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "component.*", map[string]string{
+						"name":    rName,
+						"version": "1.0.0",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "deployment_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "deployment_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "iot_job_arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", greengrassv2.DeploymentStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_arn", thingGroupResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGreengrassV2Deployment_iotJobConfiguration(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_greengrassv2_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentIoTJobConfigurationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "deployment_policies.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_policies.0.component_update_policy.0.action", greengrassv2.DeploymentComponentUpdatePolicyActionSkipNotifyComponents),
+					resource.TestCheckResourceAttr(resourceName, "deployment_policies.0.component_update_policy.0.timeout_in_seconds", "120"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_policies.0.failure_handling_policy", greengrassv2.DeploymentFailureHandlingPolicyRollback),
+					resource.TestCheckResourceAttr(resourceName, "iot_job_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "iot_job_configuration.0.abort_config.0.criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "iot_job_configuration.0.abort_config.0.criteria.0.action", greengrassv2.IoTJobAbortActionCancel),
+					resource.TestCheckResourceAttr(resourceName, "iot_job_configuration.0.abort_config.0.criteria.0.failure_type", greengrassv2.IoTJobExecutionFailureTypeFailed),
+					resource.TestCheckResourceAttr(resourceName, "iot_job_configuration.0.abort_config.0.criteria.0.min_number_of_executed_things", "2"),
+					resource.TestCheckResourceAttr(resourceName, "iot_job_configuration.0.abort_config.0.criteria.0.threshold_percentage", "50"),
+					resource.TestCheckResourceAttr(resourceName, "iot_job_configuration.0.job_executions_rollout_config.0.exponential_rate.0.base_rate_per_minute", "5"),
+					resource.TestCheckResourceAttr(resourceName, "iot_job_configuration.0.job_executions_rollout_config.0.exponential_rate.0.increment_factor", "2"),
+					resource.TestCheckResourceAttr(resourceName, "iot_job_configuration.0.job_executions_rollout_config.0.exponential_rate.0.rate_increase_criteria.0.number_of_succeeded_things", "10"),
+					resource.TestCheckResourceAttr(resourceName, "iot_job_configuration.0.job_executions_rollout_config.0.maximum_per_minute", "50"),
+					resource.TestCheckResourceAttr(resourceName, "iot_job_configuration.0.timeout_config.0.in_progress_timeout_in_minutes", "60"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGreengrassV2Deployment_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_greengrassv2_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDeploymentTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccDeploymentTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDeploymentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Greengrass V2 Deployment ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassV2Conn
+
+		_, err := tfgreengrassv2.FindDeploymentByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+// Deployments remain in the target's deployment history after being canceled,
+// so a destroyed deployment is one that is no longer active.
+func testAccCheckDeploymentDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassV2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_greengrassv2_deployment" {
+			continue
+		}
+
+		output, err := tfgreengrassv2.FindDeploymentByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.DeploymentStatus) != greengrassv2.DeploymentStatusActive {
+			continue
+		}
+
+		return fmt.Errorf("Greengrass V2 Deployment %s still active", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccDeploymentBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_thing_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_greengrassv2_component_version" "test" {
+  inline_recipe = jsonencode({
+    RecipeFormatVersion = "2020-01-25"
+    ComponentName       = %[1]q
+    ComponentVersion    = "1.0.0"
+    Manifests = [{
+      Platform = {
+        os = "linux"
+      }
+      Lifecycle = {
+        Run = "echo 'Hello, world!'"
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccDeploymentConfig(rName string) string {
+	return acctest.ConfigCompose(testAccDeploymentBaseConfig(rName), fmt.Sprintf(`
+resource "aws_greengrassv2_deployment" "test" {
+  target_arn      = aws_iot_thing_group.test.arn
+  deployment_name = %[1]q
+
+  component {
+    name    = aws_greengrassv2_component_version.test.component_name
+    version = aws_greengrassv2_component_version.test.component_version
+  }
+}
+`, rName))
+}
+
+func testAccDeploymentIoTJobConfigurationConfig(rName string) string {
+	return acctest.ConfigCompose(testAccDeploymentBaseConfig(rName), fmt.Sprintf(`
+resource "aws_greengrassv2_deployment" "test" {
+  target_arn      = aws_iot_thing_group.test.arn
+  deployment_name = %[1]q
+
+  component {
+    name    = aws_greengrassv2_component_version.test.component_name
+    version = aws_greengrassv2_component_version.test.component_version
+
+    configuration_update {
+      merge = jsonencode({
+        Message = "Hello from Terraform"
+      })
+    }
+  }
+
+  deployment_policies {
+    failure_handling_policy = "ROLLBACK"
+
+    component_update_policy {
+      action             = "SKIP_NOTIFY_COMPONENTS"
+      timeout_in_seconds = 120
+    }
+
+    configuration_validation_policy {
+      timeout_in_seconds = 60
+    }
+  }
+
+  iot_job_configuration {
+    abort_config {
+      criteria {
+        action                        = "CANCEL"
+        failure_type                  = "FAILED"
+        min_number_of_executed_things = 2
+        threshold_percentage          = 50
+      }
+    }
+
+    job_executions_rollout_config {
+      maximum_per_minute = 50
+
+      exponential_rate {
+        base_rate_per_minute = 5
+        increment_factor     = 2
+
+        rate_increase_criteria {
+          number_of_succeeded_things = 10
+        }
+      }
+    }
+
+    timeout_config {
+      in_progress_timeout_in_minutes = 60
+    }
+  }
+}
+`, rName))
+}
+
+func testAccDeploymentTags1Config(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccDeploymentBaseConfig(rName), fmt.Sprintf(`
+resource "aws_greengrassv2_deployment" "test" {
+  target_arn = aws_iot_thing_group.test.arn
+
+  component {
+    name    = aws_greengrassv2_component_version.test.component_name
+    version = aws_greengrassv2_component_version.test.component_version
+  }
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccDeploymentTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccDeploymentBaseConfig(rName), fmt.Sprintf(`
+resource "aws_greengrassv2_deployment" "test" {
+  target_arn = aws_iot_thing_group.test.arn
+
+  component {
+    name    = aws_greengrassv2_component_version.test.component_name
+    version = aws_greengrassv2_component_version.test.component_version
+  }
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
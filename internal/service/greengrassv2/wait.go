@@ -0,0 +1,34 @@
+package greengrassv2
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/greengrassv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	componentVersionCreatedTimeout = 10 * time.Minute
+)
+
+func waitComponentVersionCreated(conn *greengrassv2.GreengrassV2, arn string, timeout time.Duration) (*greengrassv2.DescribeComponentOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{greengrassv2.CloudComponentStateRequested, greengrassv2.CloudComponentStateInitiated},
+		Target:  []string{greengrassv2.CloudComponentStateDeployable},
+		Refresh: statusComponentVersion(conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*greengrassv2.DescribeComponentOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
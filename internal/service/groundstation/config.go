@@ -0,0 +1,709 @@
+package groundstation
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/groundstation"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const configResourceIDSeparator = ","
+
+var configDataKeys = []string{
+	"config_data.0.antenna_downlink",
+	"config_data.0.antenna_downlink_demod_decode",
+	"config_data.0.antenna_uplink",
+	"config_data.0.dataflow_endpoint",
+	"config_data.0.s3_recording",
+	"config_data.0.tracking",
+	"config_data.0.uplink_echo",
+}
+
+func ResourceConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceConfigCreate,
+		Read:   resourceConfigRead,
+		Update: resourceConfigUpdate,
+		Delete: resourceConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// Each kind of config data is a different config type, which can't be changed in place.
+			"config_data": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"antenna_downlink": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: configDataKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"spectrum_config": spectrumConfigSchema(),
+								},
+							},
+						},
+						"antenna_downlink_demod_decode": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: configDataKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"decode_config":       unvalidatedJSONConfigSchema(),
+									"demodulation_config": unvalidatedJSONConfigSchema(),
+									"spectrum_config":     spectrumConfigSchema(),
+								},
+							},
+						},
+						"antenna_uplink": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: configDataKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"spectrum_config": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"center_frequency": frequencySchema(groundstation.FrequencyUnits_Values()),
+												"polarization": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.StringInSlice(groundstation.Polarization_Values(), false),
+												},
+											},
+										},
+									},
+									"target_eirp": frequencySchema(groundstation.EirpUnits_Values()),
+									"transmit_disabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"dataflow_endpoint": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: configDataKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dataflow_endpoint_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"dataflow_endpoint_region": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+								},
+							},
+						},
+						"s3_recording": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: configDataKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"prefix": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+									"role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"tracking": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: configDataKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"autotrack": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(groundstation.Criticality_Values(), false),
+									},
+								},
+							},
+						},
+						"uplink_echo": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: configDataKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"antenna_uplink_config_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"config_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"config_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexp.MustCompile(`^[ a-zA-Z0-9_:-]+$`), "must contain only alphanumeric characters, spaces, underscores, colons and hyphens"),
+				),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+// frequencySchema returns the schema for a value with units, e.g. a frequency, bandwidth or EIRP.
+func frequencySchema(units []string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"units": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(units, false),
+				},
+				"value": {
+					Type:     schema.TypeFloat,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func spectrumConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"bandwidth":        frequencySchema(groundstation.BandwidthUnits_Values()),
+				"center_frequency": frequencySchema(groundstation.FrequencyUnits_Values()),
+				"polarization": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(groundstation.Polarization_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func unvalidatedJSONConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"unvalidated_json": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateFunc:     validation.StringIsJSON,
+					DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+					StateFunc: func(v interface{}) string {
+						json, _ := structure.NormalizeJsonString(v)
+						return json
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceConfigCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GroundStationConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &groundstation.CreateConfigInput{
+		ConfigData: expandConfigTypeData(d.Get("config_data").([]interface{})),
+		Name:       aws.String(name),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Ground Station Config: %s", input)
+	output, err := conn.CreateConfig(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Ground Station Config (%s): %w", name, err)
+	}
+
+	d.SetId(ConfigCreateResourceID(aws.StringValue(output.ConfigId), aws.StringValue(output.ConfigType)))
+
+	return resourceConfigRead(d, meta)
+}
+
+func resourceConfigRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GroundStationConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	configID, configType, err := ConfigParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindConfigByTwoPartKey(conn, configID, configType)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Ground Station Config (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Ground Station Config (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", output.ConfigArn)
+	if err := d.Set("config_data", flattenConfigTypeData(output.ConfigData)); err != nil {
+		return fmt.Errorf("error setting config_data: %w", err)
+	}
+	d.Set("config_id", output.ConfigId)
+	d.Set("config_type", output.ConfigType)
+	d.Set("name", output.Name)
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GroundStationConn
+
+	if d.HasChanges("config_data", "name") {
+		configID, configType, err := ConfigParseResourceID(d.Id())
+
+		if err != nil {
+			return err
+		}
+
+		input := &groundstation.UpdateConfigInput{
+			ConfigData: expandConfigTypeData(d.Get("config_data").([]interface{})),
+			ConfigId:   aws.String(configID),
+			ConfigType: aws.String(configType),
+			Name:       aws.String(d.Get("name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Ground Station Config: %s", input)
+		_, err = conn.UpdateConfig(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Ground Station Config (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Ground Station Config (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceConfigRead(d, meta)
+}
+
+func resourceConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GroundStationConn
+
+	configID, configType, err := ConfigParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Ground Station Config: %s", d.Id())
+	_, err = conn.DeleteConfig(&groundstation.DeleteConfigInput{
+		ConfigId:   aws.String(configID),
+		ConfigType: aws.String(configType),
+	})
+
+	if tfawserr.ErrCodeEquals(err, groundstation.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Ground Station Config (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func ConfigCreateResourceID(configID, configType string) string {
+	parts := []string{configID, configType}
+	id := strings.Join(parts, configResourceIDSeparator)
+
+	return id
+}
+
+func ConfigParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, configResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected CONFIG_ID%[2]sCONFIG_TYPE", id, configResourceIDSeparator)
+}
+
+func expandConfigTypeData(tfList []interface{}) *groundstation.ConfigTypeData {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &groundstation.ConfigTypeData{}
+
+	if v, ok := tfMap["antenna_downlink"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.AntennaDownlinkConfig = &groundstation.AntennaDownlinkConfig{
+			SpectrumConfig: expandSpectrumConfig(tfMap["spectrum_config"].([]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["antenna_downlink_demod_decode"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.AntennaDownlinkDemodDecodeConfig = &groundstation.AntennaDownlinkDemodDecodeConfig{
+			DecodeConfig: &groundstation.DecodeConfig{
+				UnvalidatedJSON: expandUnvalidatedJSON(tfMap["decode_config"].([]interface{})),
+			},
+			DemodulationConfig: &groundstation.DemodulationConfig{
+				UnvalidatedJSON: expandUnvalidatedJSON(tfMap["demodulation_config"].([]interface{})),
+			},
+			SpectrumConfig: expandSpectrumConfig(tfMap["spectrum_config"].([]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["antenna_uplink"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		uplinkConfig := &groundstation.AntennaUplinkConfig{
+			TransmitDisabled: aws.Bool(tfMap["transmit_disabled"].(bool)),
+		}
+
+		if v, ok := tfMap["spectrum_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			uplinkConfig.SpectrumConfig = &groundstation.UplinkSpectrumConfig{}
+
+			if v, ok := tfMap["center_frequency"].([]interface{}); ok {
+				units, value := expandValueWithUnits(v)
+				uplinkConfig.SpectrumConfig.CenterFrequency = &groundstation.Frequency{Units: units, Value: value}
+			}
+
+			if v, ok := tfMap["polarization"].(string); ok && v != "" {
+				uplinkConfig.SpectrumConfig.Polarization = aws.String(v)
+			}
+		}
+
+		if v, ok := tfMap["target_eirp"].([]interface{}); ok {
+			units, value := expandValueWithUnits(v)
+			uplinkConfig.TargetEirp = &groundstation.Eirp{Units: units, Value: value}
+		}
+
+		apiObject.AntennaUplinkConfig = uplinkConfig
+	}
+
+	if v, ok := tfMap["dataflow_endpoint"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		dataflowEndpointConfig := &groundstation.DataflowEndpointConfig{
+			DataflowEndpointName: aws.String(tfMap["dataflow_endpoint_name"].(string)),
+		}
+
+		if v, ok := tfMap["dataflow_endpoint_region"].(string); ok && v != "" {
+			dataflowEndpointConfig.DataflowEndpointRegion = aws.String(v)
+		}
+
+		apiObject.DataflowEndpointConfig = dataflowEndpointConfig
+	}
+
+	if v, ok := tfMap["s3_recording"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		s3RecordingConfig := &groundstation.S3RecordingConfig{
+			BucketArn: aws.String(tfMap["bucket_arn"].(string)),
+			RoleArn:   aws.String(tfMap["role_arn"].(string)),
+		}
+
+		if v, ok := tfMap["prefix"].(string); ok && v != "" {
+			s3RecordingConfig.Prefix = aws.String(v)
+		}
+
+		apiObject.S3RecordingConfig = s3RecordingConfig
+	}
+
+	if v, ok := tfMap["tracking"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.TrackingConfig = &groundstation.TrackingConfig{
+			Autotrack: aws.String(tfMap["autotrack"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["uplink_echo"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.UplinkEchoConfig = &groundstation.UplinkEchoConfig{
+			AntennaUplinkConfigArn: aws.String(tfMap["antenna_uplink_config_arn"].(string)),
+			Enabled:                aws.Bool(tfMap["enabled"].(bool)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandSpectrumConfig(tfList []interface{}) *groundstation.SpectrumConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &groundstation.SpectrumConfig{}
+
+	if v, ok := tfMap["bandwidth"].([]interface{}); ok {
+		units, value := expandValueWithUnits(v)
+		apiObject.Bandwidth = &groundstation.FrequencyBandwidth{Units: units, Value: value}
+	}
+
+	if v, ok := tfMap["center_frequency"].([]interface{}); ok {
+		units, value := expandValueWithUnits(v)
+		apiObject.CenterFrequency = &groundstation.Frequency{Units: units, Value: value}
+	}
+
+	if v, ok := tfMap["polarization"].(string); ok && v != "" {
+		apiObject.Polarization = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandUnvalidatedJSON(tfList []interface{}) *string {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return aws.String(tfMap["unvalidated_json"].(string))
+}
+
+func expandValueWithUnits(tfList []interface{}) (*string, *float64) {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil, nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return aws.String(tfMap["units"].(string)), aws.Float64(tfMap["value"].(float64))
+}
+
+func flattenConfigTypeData(apiObject *groundstation.ConfigTypeData) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AntennaDownlinkConfig; v != nil {
+		tfMap["antenna_downlink"] = []interface{}{map[string]interface{}{
+			"spectrum_config": flattenSpectrumConfig(v.SpectrumConfig),
+		}}
+	}
+
+	if v := apiObject.AntennaDownlinkDemodDecodeConfig; v != nil {
+		m := map[string]interface{}{
+			"spectrum_config": flattenSpectrumConfig(v.SpectrumConfig),
+		}
+
+		if v := v.DecodeConfig; v != nil {
+			m["decode_config"] = flattenUnvalidatedJSON(v.UnvalidatedJSON)
+		}
+
+		if v := v.DemodulationConfig; v != nil {
+			m["demodulation_config"] = flattenUnvalidatedJSON(v.UnvalidatedJSON)
+		}
+
+		tfMap["antenna_downlink_demod_decode"] = []interface{}{m}
+	}
+
+	if v := apiObject.AntennaUplinkConfig; v != nil {
+		m := map[string]interface{}{
+			"transmit_disabled": aws.BoolValue(v.TransmitDisabled),
+		}
+
+		if v := v.SpectrumConfig; v != nil {
+			spectrumConfig := map[string]interface{}{
+				"polarization": aws.StringValue(v.Polarization),
+			}
+
+			if v := v.CenterFrequency; v != nil {
+				spectrumConfig["center_frequency"] = flattenValueWithUnits(v.Units, v.Value)
+			}
+
+			m["spectrum_config"] = []interface{}{spectrumConfig}
+		}
+
+		if v := v.TargetEirp; v != nil {
+			m["target_eirp"] = flattenValueWithUnits(v.Units, v.Value)
+		}
+
+		tfMap["antenna_uplink"] = []interface{}{m}
+	}
+
+	if v := apiObject.DataflowEndpointConfig; v != nil {
+		tfMap["dataflow_endpoint"] = []interface{}{map[string]interface{}{
+			"dataflow_endpoint_name":   aws.StringValue(v.DataflowEndpointName),
+			"dataflow_endpoint_region": aws.StringValue(v.DataflowEndpointRegion),
+		}}
+	}
+
+	if v := apiObject.S3RecordingConfig; v != nil {
+		tfMap["s3_recording"] = []interface{}{map[string]interface{}{
+			"bucket_arn": aws.StringValue(v.BucketArn),
+			"prefix":     aws.StringValue(v.Prefix),
+			"role_arn":   aws.StringValue(v.RoleArn),
+		}}
+	}
+
+	if v := apiObject.TrackingConfig; v != nil {
+		tfMap["tracking"] = []interface{}{map[string]interface{}{
+			"autotrack": aws.StringValue(v.Autotrack),
+		}}
+	}
+
+	if v := apiObject.UplinkEchoConfig; v != nil {
+		tfMap["uplink_echo"] = []interface{}{map[string]interface{}{
+			"antenna_uplink_config_arn": aws.StringValue(v.AntennaUplinkConfigArn),
+			"enabled":                   aws.BoolValue(v.Enabled),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSpectrumConfig(apiObject *groundstation.SpectrumConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"polarization": aws.StringValue(apiObject.Polarization),
+	}
+
+	if v := apiObject.Bandwidth; v != nil {
+		tfMap["bandwidth"] = flattenValueWithUnits(v.Units, v.Value)
+	}
+
+	if v := apiObject.CenterFrequency; v != nil {
+		tfMap["center_frequency"] = flattenValueWithUnits(v.Units, v.Value)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenUnvalidatedJSON(v *string) []interface{} {
+	if v == nil {
+		return nil
+	}
+
+	json, _ := structure.NormalizeJsonString(aws.StringValue(v))
+
+	return []interface{}{map[string]interface{}{
+		"unvalidated_json": json,
+	}}
+}
+
+func flattenValueWithUnits(units *string, value *float64) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"units": aws.StringValue(units),
+		"value": aws.Float64Value(value),
+	}}
+}
@@ -0,0 +1,427 @@
+package groundstation_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/groundstation"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgroundstation "github.com/hashicorp/terraform-provider-aws/internal/service/groundstation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGroundStationConfig_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_groundstation_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, groundstation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "groundstation", regexp.MustCompile(`config/tracking/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "config_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.tracking.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.tracking.0.autotrack", groundstation.CriticalityPreferred),
+					resource.TestCheckResourceAttrSet(resourceName, "config_id"),
+					resource.TestCheckResourceAttr(resourceName, "config_type", groundstation.ConfigCapabilityTypeTracking),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGroundStationConfig_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_groundstation_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, groundstation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfgroundstation.ResourceConfig(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGroundStationConfig_antennaDownlink(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_groundstation_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, groundstation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigAntennaDownlinkConfig(rName, 7812.5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "config_type", groundstation.ConfigCapabilityTypeAntennaDownlink),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.antenna_downlink.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.antenna_downlink.0.spectrum_config.0.bandwidth.0.units", groundstation.BandwidthUnitsMhz),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.antenna_downlink.0.spectrum_config.0.bandwidth.0.value", "30"),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.antenna_downlink.0.spectrum_config.0.center_frequency.0.units", groundstation.FrequencyUnitsMhz),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.antenna_downlink.0.spectrum_config.0.center_frequency.0.value", "7812.5"),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.antenna_downlink.0.spectrum_config.0.polarization", groundstation.PolarizationRightHand),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigAntennaDownlinkConfig(rName, 8212.5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.antenna_downlink.0.spectrum_config.0.center_frequency.0.value", "8212.5"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGroundStationConfig_dataflowEndpoint(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_groundstation_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, groundstation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigDataflowEndpointConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "config_type", groundstation.ConfigCapabilityTypeDataflowEndpoint),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.dataflow_endpoint.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.dataflow_endpoint.0.dataflow_endpoint_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "config_data.0.dataflow_endpoint.0.dataflow_endpoint_region", "data.aws_region.current", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGroundStationConfig_s3Recording(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_groundstation_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, groundstation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigS3RecordingConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "config_type", groundstation.ConfigCapabilityTypeS3Recording),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.s3_recording.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "config_data.0.s3_recording.0.bucket_arn", "aws_s3_bucket.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.s3_recording.0.prefix", "recordings/"),
+					resource.TestCheckResourceAttrPair(resourceName, "config_data.0.s3_recording.0.role_arn", "aws_iam_role.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGroundStationConfig_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_groundstation_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, groundstation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccConfigTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckConfigExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Ground Station Config ID is set")
+		}
+
+		configID, configType, err := tfgroundstation.ConfigParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GroundStationConn
+
+		_, err = tfgroundstation.FindConfigByTwoPartKey(conn, configID, configType)
+
+		return err
+	}
+}
+
+func testAccCheckConfigDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GroundStationConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_groundstation_config" {
+			continue
+		}
+
+		configID, configType, err := tfgroundstation.ConfigParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfgroundstation.FindConfigByTwoPartKey(conn, configID, configType)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Ground Station Config %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccConfigConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_groundstation_config" "test" {
+  name = %[1]q
+
+  config_data {
+    tracking {
+      autotrack = "PREFERRED"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccConfigAntennaDownlinkConfig(rName string, centerFrequency float64) string {
+	return fmt.Sprintf(`
+resource "aws_groundstation_config" "test" {
+  name = %[1]q
+
+  config_data {
+    antenna_downlink {
+      spectrum_config {
+        bandwidth {
+          units = "MHz"
+          value = 30
+        }
+
+        center_frequency {
+          units = "MHz"
+          value = %[2]g
+        }
+
+        polarization = "RIGHT_HAND"
+      }
+    }
+  }
+}
+`, rName, centerFrequency)
+}
+
+func testAccConfigDataflowEndpointConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_groundstation_config" "test" {
+  name = %[1]q
+
+  config_data {
+    dataflow_endpoint {
+      dataflow_endpoint_name   = %[1]q
+      dataflow_endpoint_region = data.aws_region.current.name
+    }
+  }
+}
+`, rName)
+}
+
+func testAccConfigS3RecordingConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+# Ground Station only records to buckets whose names start with "aws-groundstation".
+resource "aws_s3_bucket" "test" {
+  bucket        = "aws-groundstation-%[1]s"
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {
+      "Service": "groundstation.${data.aws_partition.current.dns_suffix}"
+    },
+    "Action": "sts:AssumeRole"
+  }]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Action": [
+      "s3:GetBucketLocation",
+      "s3:PutObject"
+    ],
+    "Resource": [
+      "${aws_s3_bucket.test.arn}",
+      "${aws_s3_bucket.test.arn}/*"
+    ]
+  }]
+}
+EOF
+}
+
+resource "aws_groundstation_config" "test" {
+  name = %[1]q
+
+  config_data {
+    s3_recording {
+      bucket_arn = aws_s3_bucket.test.arn
+      prefix     = "recordings/"
+      role_arn   = aws_iam_role.test.arn
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName)
+}
+
+func testAccConfigTags1Config(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_groundstation_config" "test" {
+  name = %[1]q
+
+  config_data {
+    tracking {
+      autotrack = "PREFERRED"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccConfigTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_groundstation_config" "test" {
+  name = %[1]q
+
+  config_data {
+    tracking {
+      autotrack = "PREFERRED"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
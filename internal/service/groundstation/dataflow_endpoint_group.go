@@ -0,0 +1,311 @@
+package groundstation
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/groundstation"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDataflowEndpointGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDataflowEndpointGroupCreate,
+		Read:   resourceDataflowEndpointGroupRead,
+		Update: resourceDataflowEndpointGroupUpdate,
+		Delete: resourceDataflowEndpointGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoint_details": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"address": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+												"port": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IsPortNumber,
+												},
+											},
+										},
+									},
+									"mtu": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(1400, 1500),
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"security_details": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"security_group_ids": {
+										Type:     schema.TypeSet,
+										Required: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"subnet_ids": {
+										Type:     schema.TypeSet,
+										Required: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDataflowEndpointGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GroundStationConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &groundstation.CreateDataflowEndpointGroupInput{
+		EndpointDetails: expandEndpointDetails(d.Get("endpoint_details").(*schema.Set).List()),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Ground Station Dataflow Endpoint Group: %s", input)
+	output, err := conn.CreateDataflowEndpointGroup(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Ground Station Dataflow Endpoint Group: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.DataflowEndpointGroupId))
+
+	return resourceDataflowEndpointGroupRead(d, meta)
+}
+
+func resourceDataflowEndpointGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GroundStationConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindDataflowEndpointGroupByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Ground Station Dataflow Endpoint Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Ground Station Dataflow Endpoint Group (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", output.DataflowEndpointGroupArn)
+	if err := d.Set("endpoint_details", flattenEndpointDetails(output.EndpointsDetails)); err != nil {
+		return fmt.Errorf("error setting endpoint_details: %w", err)
+	}
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDataflowEndpointGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GroundStationConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Ground Station Dataflow Endpoint Group (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceDataflowEndpointGroupRead(d, meta)
+}
+
+func resourceDataflowEndpointGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GroundStationConn
+
+	log.Printf("[DEBUG] Deleting Ground Station Dataflow Endpoint Group: %s", d.Id())
+	_, err := conn.DeleteDataflowEndpointGroup(&groundstation.DeleteDataflowEndpointGroupInput{
+		DataflowEndpointGroupId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, groundstation.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Ground Station Dataflow Endpoint Group (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandEndpointDetails(tfList []interface{}) []*groundstation.EndpointDetails {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*groundstation.EndpointDetails
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &groundstation.EndpointDetails{}
+
+		if v, ok := tfMap["endpoint"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.Endpoint = &groundstation.DataflowEndpoint{
+				Name: aws.String(tfMap["name"].(string)),
+			}
+
+			if v, ok := tfMap["address"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+
+				apiObject.Endpoint.Address = &groundstation.SocketAddress{
+					Name: aws.String(tfMap["name"].(string)),
+					Port: aws.Int64(int64(tfMap["port"].(int))),
+				}
+			}
+
+			if v, ok := tfMap["mtu"].(int); ok && v != 0 {
+				apiObject.Endpoint.Mtu = aws.Int64(int64(v))
+			}
+		}
+
+		if v, ok := tfMap["security_details"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.SecurityDetails = &groundstation.SecurityDetails{
+				RoleArn:          aws.String(tfMap["role_arn"].(string)),
+				SecurityGroupIds: flex.ExpandStringSet(tfMap["security_group_ids"].(*schema.Set)),
+				SubnetIds:        flex.ExpandStringSet(tfMap["subnet_ids"].(*schema.Set)),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenEndpointDetails(apiObjects []*groundstation.EndpointDetails) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Endpoint; v != nil {
+			endpoint := map[string]interface{}{
+				"mtu":  aws.Int64Value(v.Mtu),
+				"name": aws.StringValue(v.Name),
+			}
+
+			if v := v.Address; v != nil {
+				endpoint["address"] = []interface{}{map[string]interface{}{
+					"name": aws.StringValue(v.Name),
+					"port": aws.Int64Value(v.Port),
+				}}
+			}
+
+			tfMap["endpoint"] = []interface{}{endpoint}
+		}
+
+		if v := apiObject.SecurityDetails; v != nil {
+			tfMap["security_details"] = []interface{}{map[string]interface{}{
+				"role_arn":           aws.StringValue(v.RoleArn),
+				"security_group_ids": aws.StringValueSlice(v.SecurityGroupIds),
+				"subnet_ids":         aws.StringValueSlice(v.SubnetIds),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
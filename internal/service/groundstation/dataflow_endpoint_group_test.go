@@ -0,0 +1,291 @@
+package groundstation_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/groundstation"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgroundstation "github.com/hashicorp/terraform-provider-aws/internal/service/groundstation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGroundStationDataflowEndpointGroup_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_groundstation_dataflow_endpoint_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, groundstation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDataflowEndpointGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataflowEndpointGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataflowEndpointGroupExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "groundstation", regexp.MustCompile(`dataflow-endpoint-group/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_details.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "endpoint_details.*", map[string]string{
+						"endpoint.#":                              "1",
+						"endpoint.0.address.#":                    "1",
+						"endpoint.0.address.0.name":               "10.0.0.10",
+						"endpoint.0.address.0.port":               "55888",
+						"endpoint.0.name":                         rName,
+						"security_details.#":                      "1",
+						"security_details.0.security_group_ids.#": "1",
+						"security_details.0.subnet_ids.#":         "1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGroundStationDataflowEndpointGroup_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_groundstation_dataflow_endpoint_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, groundstation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDataflowEndpointGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataflowEndpointGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataflowEndpointGroupExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfgroundstation.ResourceDataflowEndpointGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGroundStationDataflowEndpointGroup_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_groundstation_dataflow_endpoint_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, groundstation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDataflowEndpointGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataflowEndpointGroupTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataflowEndpointGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDataflowEndpointGroupTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataflowEndpointGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccDataflowEndpointGroupTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataflowEndpointGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDataflowEndpointGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Ground Station Dataflow Endpoint Group ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GroundStationConn
+
+		_, err := tfgroundstation.FindDataflowEndpointGroupByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckDataflowEndpointGroupDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GroundStationConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_groundstation_dataflow_endpoint_group" {
+			continue
+		}
+
+		_, err := tfgroundstation.FindDataflowEndpointGroupByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Ground Station Dataflow Endpoint Group %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccDataflowEndpointGroupBaseConfig(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  vpc_id            = aws_vpc.test.id
+  cidr_block        = "10.0.0.0/24"
+  availability_zone = data.aws_availability_zones.available.names[0]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {
+      "Service": "groundstation.${data.aws_partition.current.dns_suffix}"
+    },
+    "Action": "sts:AssumeRole"
+  }]
+}
+EOF
+}
+`, rName))
+}
+
+func testAccDataflowEndpointGroupConfig(rName string) string {
+	return acctest.ConfigCompose(testAccDataflowEndpointGroupBaseConfig(rName), fmt.Sprintf(`
+resource "aws_groundstation_dataflow_endpoint_group" "test" {
+  endpoint_details {
+    endpoint {
+      name = %[1]q
+
+      address {
+        name = "10.0.0.10"
+        port = 55888
+      }
+    }
+
+    security_details {
+      role_arn           = aws_iam_role.test.arn
+      security_group_ids = [aws_security_group.test.id]
+      subnet_ids         = [aws_subnet.test.id]
+    }
+  }
+}
+`, rName))
+}
+
+func testAccDataflowEndpointGroupTags1Config(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccDataflowEndpointGroupBaseConfig(rName), fmt.Sprintf(`
+resource "aws_groundstation_dataflow_endpoint_group" "test" {
+  endpoint_details {
+    endpoint {
+      name = %[1]q
+
+      address {
+        name = "10.0.0.10"
+        port = 55888
+      }
+    }
+
+    security_details {
+      role_arn           = aws_iam_role.test.arn
+      security_group_ids = [aws_security_group.test.id]
+      subnet_ids         = [aws_subnet.test.id]
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccDataflowEndpointGroupTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccDataflowEndpointGroupBaseConfig(rName), fmt.Sprintf(`
+resource "aws_groundstation_dataflow_endpoint_group" "test" {
+  endpoint_details {
+    endpoint {
+      name = %[1]q
+
+      address {
+        name = "10.0.0.10"
+        port = 55888
+      }
+    }
+
+    security_details {
+      role_arn           = aws_iam_role.test.arn
+      security_group_ids = [aws_security_group.test.id]
+      subnet_ids         = [aws_subnet.test.id]
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
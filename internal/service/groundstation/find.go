@@ -0,0 +1,85 @@
+package groundstation
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/groundstation"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindConfigByTwoPartKey(conn *groundstation.GroundStation, configID, configType string) (*groundstation.GetConfigOutput, error) {
+	input := &groundstation.GetConfigInput{
+		ConfigId:   aws.String(configID),
+		ConfigType: aws.String(configType),
+	}
+
+	output, err := conn.GetConfig(input)
+
+	if tfawserr.ErrCodeEquals(err, groundstation.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ConfigData == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindDataflowEndpointGroupByID(conn *groundstation.GroundStation, id string) (*groundstation.GetDataflowEndpointGroupOutput, error) {
+	input := &groundstation.GetDataflowEndpointGroupInput{
+		DataflowEndpointGroupId: aws.String(id),
+	}
+
+	output, err := conn.GetDataflowEndpointGroup(input)
+
+	if tfawserr.ErrCodeEquals(err, groundstation.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindMissionProfileByID(conn *groundstation.GroundStation, id string) (*groundstation.GetMissionProfileOutput, error) {
+	input := &groundstation.GetMissionProfileInput{
+		MissionProfileId: aws.String(id),
+	}
+
+	output, err := conn.GetMissionProfile(input)
+
+	if tfawserr.ErrCodeEquals(err, groundstation.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,322 @@
+package groundstation_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/groundstation"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgroundstation "github.com/hashicorp/terraform-provider-aws/internal/service/groundstation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGroundStationMissionProfile_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_groundstation_mission_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, groundstation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckMissionProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMissionProfileConfig(rName, 180),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMissionProfileExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "groundstation", regexp.MustCompile(`mission-profile/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "contact_post_pass_duration_seconds", "0"),
+					resource.TestCheckResourceAttr(resourceName, "contact_pre_pass_duration_seconds", "0"),
+					resource.TestCheckResourceAttr(resourceName, "dataflow_edge.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "dataflow_edge.0.destination", "aws_groundstation_config.destination", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "dataflow_edge.0.source", "aws_groundstation_config.source", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "minimum_viable_contact_duration_seconds", "180"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "tracking_config_arn", "aws_groundstation_config.tracking", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMissionProfileConfig(rName, 300),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMissionProfileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "minimum_viable_contact_duration_seconds", "300"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGroundStationMissionProfile_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_groundstation_mission_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, groundstation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckMissionProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMissionProfileConfig(rName, 180),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMissionProfileExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfgroundstation.ResourceMissionProfile(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGroundStationMissionProfile_contactDurations(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_groundstation_mission_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, groundstation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckMissionProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMissionProfileContactDurationsConfig(rName, 60, 120),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMissionProfileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "contact_post_pass_duration_seconds", "120"),
+					resource.TestCheckResourceAttr(resourceName, "contact_pre_pass_duration_seconds", "60"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMissionProfileContactDurationsConfig(rName, 90, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMissionProfileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "contact_post_pass_duration_seconds", "30"),
+					resource.TestCheckResourceAttr(resourceName, "contact_pre_pass_duration_seconds", "90"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGroundStationMissionProfile_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_groundstation_mission_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, groundstation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckMissionProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMissionProfileTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMissionProfileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMissionProfileTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMissionProfileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccMissionProfileTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMissionProfileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMissionProfileExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Ground Station Mission Profile ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GroundStationConn
+
+		_, err := tfgroundstation.FindMissionProfileByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckMissionProfileDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GroundStationConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_groundstation_mission_profile" {
+			continue
+		}
+
+		_, err := tfgroundstation.FindMissionProfileByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Ground Station Mission Profile %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccMissionProfileBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_groundstation_config" "tracking" {
+  name = "%[1]s-tracking"
+
+  config_data {
+    tracking {
+      autotrack = "PREFERRED"
+    }
+  }
+}
+
+resource "aws_groundstation_config" "source" {
+  name = "%[1]s-source"
+
+  config_data {
+    antenna_downlink {
+      spectrum_config {
+        bandwidth {
+          units = "MHz"
+          value = 30
+        }
+
+        center_frequency {
+          units = "MHz"
+          value = 7812.5
+        }
+
+        polarization = "RIGHT_HAND"
+      }
+    }
+  }
+}
+
+resource "aws_groundstation_config" "destination" {
+  name = "%[1]s-destination"
+
+  config_data {
+    dataflow_endpoint {
+      dataflow_endpoint_name   = %[1]q
+      dataflow_endpoint_region = data.aws_region.current.name
+    }
+  }
+}
+`, rName)
+}
+
+func testAccMissionProfileConfig(rName string, minimumViableContactDuration int) string {
+	return acctest.ConfigCompose(testAccMissionProfileBaseConfig(rName), fmt.Sprintf(`
+resource "aws_groundstation_mission_profile" "test" {
+  name                                    = %[1]q
+  minimum_viable_contact_duration_seconds = %[2]d
+  tracking_config_arn                     = aws_groundstation_config.tracking.arn
+
+  dataflow_edge {
+    source      = aws_groundstation_config.source.arn
+    destination = aws_groundstation_config.destination.arn
+  }
+}
+`, rName, minimumViableContactDuration))
+}
+
+func testAccMissionProfileContactDurationsConfig(rName string, prePass, postPass int) string {
+	return acctest.ConfigCompose(testAccMissionProfileBaseConfig(rName), fmt.Sprintf(`
+resource "aws_groundstation_mission_profile" "test" {
+  name                                    = %[1]q
+  minimum_viable_contact_duration_seconds = 180
+  contact_pre_pass_duration_seconds       = %[2]d
+  contact_post_pass_duration_seconds      = %[3]d
+  tracking_config_arn                     = aws_groundstation_config.tracking.arn
+
+  dataflow_edge {
+    source      = aws_groundstation_config.source.arn
+    destination = aws_groundstation_config.destination.arn
+  }
+}
+`, rName, prePass, postPass))
+}
+
+func testAccMissionProfileTags1Config(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccMissionProfileBaseConfig(rName), fmt.Sprintf(`
+resource "aws_groundstation_mission_profile" "test" {
+  name                                    = %[1]q
+  minimum_viable_contact_duration_seconds = 180
+  tracking_config_arn                     = aws_groundstation_config.tracking.arn
+
+  dataflow_edge {
+    source      = aws_groundstation_config.source.arn
+    destination = aws_groundstation_config.destination.arn
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccMissionProfileTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccMissionProfileBaseConfig(rName), fmt.Sprintf(`
+resource "aws_groundstation_mission_profile" "test" {
+  name                                    = %[1]q
+  minimum_viable_contact_duration_seconds = 180
+  tracking_config_arn                     = aws_groundstation_config.tracking.arn
+
+  dataflow_edge {
+    source      = aws_groundstation_config.source.arn
+    destination = aws_groundstation_config.destination.arn
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
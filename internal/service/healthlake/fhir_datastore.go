@@ -0,0 +1,283 @@
+package healthlake
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/healthlake"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceFHIRDatastore() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFHIRDatastoreCreate,
+		Read:   resourceFHIRDatastoreRead,
+		Update: resourceFHIRDatastoreUpdate,
+		Delete: resourceFHIRDatastoreDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(fhirDatastoreCreatedTimeout),
+			Delete: schema.DefaultTimeout(fhirDatastoreDeletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"preload_data_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(healthlake.PreloadDataType_Values(), false),
+			},
+			"sse_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_encryption_config": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cmk_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(healthlake.CmkType_Values(), false),
+									},
+									"kms_key_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"type_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      healthlake.FHIRVersionR4,
+				ValidateFunc: validation.StringInSlice(healthlake.FHIRVersion_Values(), false),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceFHIRDatastoreCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).HealthLakeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &healthlake.CreateFHIRDatastoreInput{
+		ClientToken:          aws.String(resource.UniqueId()),
+		DatastoreTypeVersion: aws.String(d.Get("type_version").(string)),
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		input.DatastoreName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("preload_data_type"); ok {
+		input.PreloadDataConfig = &healthlake.PreloadDataConfig{
+			PreloadDataType: aws.String(v.(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("sse_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SseConfiguration = expandSseConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating HealthLake FHIR Datastore: %s", input)
+	output, err := conn.CreateFHIRDatastore(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating HealthLake FHIR Datastore: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.DatastoreId))
+
+	if _, err := waitFHIRDatastoreCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for HealthLake FHIR Datastore (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceFHIRDatastoreRead(d, meta)
+}
+
+func resourceFHIRDatastoreRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).HealthLakeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	datastore, err := FindFHIRDatastoreByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] HealthLake FHIR Datastore (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading HealthLake FHIR Datastore (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(datastore.DatastoreArn)
+	d.Set("arn", arn)
+	if datastore.CreatedAt != nil {
+		d.Set("created_at", aws.TimeValue(datastore.CreatedAt).Format(time.RFC3339))
+	} else {
+		d.Set("created_at", nil)
+	}
+	d.Set("endpoint", datastore.DatastoreEndpoint)
+	d.Set("name", datastore.DatastoreName)
+	if datastore.PreloadDataConfig != nil {
+		d.Set("preload_data_type", datastore.PreloadDataConfig.PreloadDataType)
+	} else {
+		d.Set("preload_data_type", nil)
+	}
+	if datastore.SseConfiguration != nil {
+		if err := d.Set("sse_configuration", []interface{}{flattenSseConfiguration(datastore.SseConfiguration)}); err != nil {
+			return fmt.Errorf("error setting sse_configuration: %w", err)
+		}
+	} else {
+		d.Set("sse_configuration", nil)
+	}
+	d.Set("status", datastore.DatastoreStatus)
+	d.Set("type_version", datastore.DatastoreTypeVersion)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for HealthLake FHIR Datastore (%s): %w", arn, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceFHIRDatastoreUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).HealthLakeConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating HealthLake FHIR Datastore (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceFHIRDatastoreRead(d, meta)
+}
+
+func resourceFHIRDatastoreDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).HealthLakeConn
+
+	log.Printf("[DEBUG] Deleting HealthLake FHIR Datastore: %s", d.Id())
+	_, err := conn.DeleteFHIRDatastore(&healthlake.DeleteFHIRDatastoreInput{
+		DatastoreId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, healthlake.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting HealthLake FHIR Datastore (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitFHIRDatastoreDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for HealthLake FHIR Datastore (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSseConfiguration(tfMap map[string]interface{}) *healthlake.SseConfiguration {
+	apiObject := &healthlake.SseConfiguration{}
+
+	if v, ok := tfMap["kms_encryption_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.KmsEncryptionConfig = &healthlake.KmsEncryptionConfig{
+			CmkType: aws.String(tfMap["cmk_type"].(string)),
+		}
+
+		if v, ok := tfMap["kms_key_id"].(string); ok && v != "" {
+			apiObject.KmsEncryptionConfig.KmsKeyId = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenSseConfiguration(apiObject *healthlake.SseConfiguration) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.KmsEncryptionConfig; v != nil {
+		tfMap["kms_encryption_config"] = []interface{}{map[string]interface{}{
+			"cmk_type":   aws.StringValue(v.CmkType),
+			"kms_key_id": aws.StringValue(v.KmsKeyId),
+		}}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,277 @@
+package healthlake_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/healthlake"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfhealthlake "github.com/hashicorp/terraform-provider-aws/internal/service/healthlake"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccHealthLakeFHIRDatastore_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_healthlake_fhir_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, healthlake.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFHIRDatastoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFHIRDatastoreConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFHIRDatastoreExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "healthlake", regexp.MustCompile(`datastore/fhir/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoint"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "preload_data_type", ""),
+					resource.TestCheckResourceAttr(resourceName, "sse_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sse_configuration.0.kms_encryption_config.0.cmk_type", healthlake.CmkTypeAwsOwnedKmsKey),
+					resource.TestCheckResourceAttr(resourceName, "status", healthlake.DatastoreStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type_version", healthlake.FHIRVersionR4),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccHealthLakeFHIRDatastore_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_healthlake_fhir_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, healthlake.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFHIRDatastoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFHIRDatastoreConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFHIRDatastoreExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfhealthlake.ResourceFHIRDatastore(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccHealthLakeFHIRDatastore_preloadDataType(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_healthlake_fhir_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, healthlake.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFHIRDatastoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFHIRDatastorePreloadDataTypeConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFHIRDatastoreExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "preload_data_type", healthlake.PreloadDataTypeSynthea),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccHealthLakeFHIRDatastore_sseCustomerManagedKey(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_healthlake_fhir_datastore.test"
+	kmsKeyResourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, healthlake.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFHIRDatastoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFHIRDatastoreSSECustomerManagedKeyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFHIRDatastoreExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sse_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sse_configuration.0.kms_encryption_config.0.cmk_type", healthlake.CmkTypeCustomerManagedKmsKey),
+					resource.TestCheckResourceAttrPair(resourceName, "sse_configuration.0.kms_encryption_config.0.kms_key_id", kmsKeyResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccHealthLakeFHIRDatastore_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_healthlake_fhir_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, healthlake.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFHIRDatastoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFHIRDatastoreTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFHIRDatastoreExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFHIRDatastoreTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFHIRDatastoreExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccFHIRDatastoreTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFHIRDatastoreExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFHIRDatastoreExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No HealthLake FHIR Datastore ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).HealthLakeConn
+
+		_, err := tfhealthlake.FindFHIRDatastoreByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckFHIRDatastoreDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).HealthLakeConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_healthlake_fhir_datastore" {
+			continue
+		}
+
+		_, err := tfhealthlake.FindFHIRDatastoreByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("HealthLake FHIR Datastore %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccFHIRDatastoreConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_healthlake_fhir_datastore" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccFHIRDatastorePreloadDataTypeConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_healthlake_fhir_datastore" "test" {
+  name              = %[1]q
+  preload_data_type = "SYNTHEA"
+}
+`, rName)
+}
+
+func testAccFHIRDatastoreSSECustomerManagedKeyConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_healthlake_fhir_datastore" "test" {
+  name = %[1]q
+
+  sse_configuration {
+    kms_encryption_config {
+      cmk_type   = "CUSTOMER_MANAGED_KMS_KEY"
+      kms_key_id = aws_kms_key.test.arn
+    }
+  }
+}
+`, rName)
+}
+
+func testAccFHIRDatastoreTags1Config(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_healthlake_fhir_datastore" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccFHIRDatastoreTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_healthlake_fhir_datastore" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
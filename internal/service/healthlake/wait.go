@@ -0,0 +1,47 @@
+package healthlake
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/healthlake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	fhirDatastoreCreatedTimeout = 60 * time.Minute
+	fhirDatastoreDeletedTimeout = 60 * time.Minute
+)
+
+func waitFHIRDatastoreCreated(conn *healthlake.HealthLake, id string, timeout time.Duration) (*healthlake.DatastoreProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{healthlake.DatastoreStatusCreating},
+		Target:  []string{healthlake.DatastoreStatusActive},
+		Refresh: statusFHIRDatastore(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*healthlake.DatastoreProperties); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFHIRDatastoreDeleted(conn *healthlake.HealthLake, id string, timeout time.Duration) (*healthlake.DatastoreProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{healthlake.DatastoreStatusActive, healthlake.DatastoreStatusDeleting},
+		Target:  []string{},
+		Refresh: statusFHIRDatastore(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*healthlake.DatastoreProperties); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -111,6 +111,30 @@ func ResourceDistributionConfiguration() *schema.Resource {
 								},
 							},
 						},
+						"launch_template_configuration": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 100,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"account_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"default": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									"launch_template_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringMatch(regexp.MustCompile(`^lt-[a-z0-9-_]{17}$`), "must be a valid launch template ID"),
+									},
+								},
+							},
+						},
 						"license_configuration_arns": {
 							Type:     schema.TypeSet,
 							Optional: true,
@@ -328,6 +352,10 @@ func expandDistribution(tfMap map[string]interface{}) *imagebuilder.Distribution
 		apiObject.AmiDistributionConfiguration = expandAMIDistributionConfiguration(v[0].(map[string]interface{}))
 	}
 
+	if v, ok := tfMap["launch_template_configuration"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LaunchTemplateConfigurations = expandLaunchTemplateConfigurations(v.List())
+	}
+
 	if v, ok := tfMap["license_configuration_arns"].(*schema.Set); ok && v.Len() > 0 {
 		apiObject.LicenseConfigurationArns = flex.ExpandStringSet(v)
 	}
@@ -435,6 +463,10 @@ func flattenDistribution(apiObject *imagebuilder.Distribution) map[string]interf
 		tfMap["ami_distribution_configuration"] = []interface{}{flattenAMIDistributionConfiguration(v)}
 	}
 
+	if v := apiObject.LaunchTemplateConfigurations; v != nil {
+		tfMap["launch_template_configuration"] = flattenLaunchTemplateConfigurations(v)
+	}
+
 	if v := apiObject.LicenseConfigurationArns; v != nil {
 		tfMap["license_configuration_arns"] = aws.StringValueSlice(v)
 	}
@@ -481,3 +513,91 @@ func flattenLaunchPermissionConfiguration(apiObject *imagebuilder.LaunchPermissi
 
 	return tfMap
 }
+
+func expandLaunchTemplateConfiguration(tfMap map[string]interface{}) *imagebuilder.LaunchTemplateConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &imagebuilder.LaunchTemplateConfiguration{}
+
+	if v, ok := tfMap["account_id"].(string); ok && v != "" {
+		apiObject.AccountId = aws.String(v)
+	}
+
+	if v, ok := tfMap["default"].(bool); ok {
+		apiObject.SetDefaultVersion = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["launch_template_id"].(string); ok && v != "" {
+		apiObject.LaunchTemplateId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandLaunchTemplateConfigurations(tfList []interface{}) []*imagebuilder.LaunchTemplateConfiguration {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*imagebuilder.LaunchTemplateConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandLaunchTemplateConfiguration(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenLaunchTemplateConfiguration(apiObject *imagebuilder.LaunchTemplateConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AccountId; v != nil {
+		tfMap["account_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.SetDefaultVersion; v != nil {
+		tfMap["default"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.LaunchTemplateId; v != nil {
+		tfMap["launch_template_id"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenLaunchTemplateConfigurations(apiObjects []*imagebuilder.LaunchTemplateConfiguration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenLaunchTemplateConfiguration(apiObject))
+	}
+
+	return tfList
+}
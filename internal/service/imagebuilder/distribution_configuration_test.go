@@ -396,6 +396,48 @@ func TestAccImageBuilderDistributionConfiguration_DistributionAMIDistribution_ta
 	})
 }
 
+func TestAccImageBuilderDistributionConfiguration_Distribution_launchTemplateConfiguration(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	launchTemplateResourceName := "aws_launch_template.test"
+	resourceName := "aws_imagebuilder_distribution_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, imagebuilder.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDistributionConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDistributionConfigurationDistributionLaunchTemplateConfigurationConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "distribution.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "distribution.*.launch_template_configuration.*", map[string]string{
+						"default": "true",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "distribution.*.launch_template_configuration.*.launch_template_id", launchTemplateResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDistributionConfigurationDistributionLaunchTemplateConfigurationConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionConfigurationExists(resourceName),
+					acctest.CheckResourceAttrRFC3339(resourceName, "date_updated"),
+					resource.TestCheckResourceAttr(resourceName, "distribution.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "distribution.*.launch_template_configuration.*", map[string]string{
+						"default": "false",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccImageBuilderDistributionConfiguration_Distribution_licenseARNs(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	licenseConfigurationResourceName := "aws_licensemanager_license_configuration.test"
@@ -740,6 +782,29 @@ resource "aws_imagebuilder_distribution_configuration" "test" {
 `, rName, targetAccountId)
 }
 
+func testAccDistributionConfigurationDistributionLaunchTemplateConfigurationConfig(rName string, setDefault bool) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_launch_template" "test" {
+  name = %[1]q
+}
+
+resource "aws_imagebuilder_distribution_configuration" "test" {
+  name = %[1]q
+
+  distribution {
+    launch_template_configuration {
+      default            = %[2]t
+      launch_template_id = aws_launch_template.test.id
+    }
+
+    region = data.aws_region.current.name
+  }
+}
+`, rName, setDefault)
+}
+
 func testAccDistributionConfigurationDistributionLicenseConfigurationARNs1Config(rName string) string {
 	return fmt.Sprintf(`
 data "aws_region" "current" {}
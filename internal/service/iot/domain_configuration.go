@@ -0,0 +1,329 @@
+package iot
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDomainConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDomainConfigurationCreate,
+		Read:   resourceDomainConfigurationRead,
+		Update: resourceDomainConfigurationUpdate,
+		Delete: resourceDomainConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authorizer_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_authorizer_override": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"default_authorizer_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+					},
+				},
+			},
+			"domain_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 253),
+			},
+			"domain_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[\w.-]+$`), "must contain only alphanumeric characters, underscores, hyphens and periods"),
+				),
+			},
+			"server_certificate_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"service_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      iot.ServiceTypeData,
+				ValidateFunc: validation.StringInSlice(iot.ServiceType_Values(), false),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      iot.DomainConfigurationStatusEnabled,
+				ValidateFunc: validation.StringInSlice(iot.DomainConfigurationStatus_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"validation_certificate_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDomainConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IoTConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &iot.CreateDomainConfigurationInput{
+		DomainConfigurationName: aws.String(name),
+		ServiceType:             aws.String(d.Get("service_type").(string)),
+	}
+
+	if v, ok := d.GetOk("authorizer_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AuthorizerConfig = expandAuthorizerConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("domain_name"); ok {
+		input.DomainName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("server_certificate_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.ServerCertificateArns = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("validation_certificate_arn"); ok {
+		input.ValidationCertificateArn = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating IoT Domain Configuration: %s", input)
+	output, err := conn.CreateDomainConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating IoT Domain Configuration (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.DomainConfigurationName))
+
+	// Domain configurations are always created in the ENABLED state.
+	if v := d.Get("status").(string); v != iot.DomainConfigurationStatusEnabled {
+		_, err := conn.UpdateDomainConfiguration(&iot.UpdateDomainConfigurationInput{
+			DomainConfigurationName:   aws.String(d.Id()),
+			DomainConfigurationStatus: aws.String(v),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error updating IoT Domain Configuration (%s) status: %w", d.Id(), err)
+		}
+	}
+
+	return resourceDomainConfigurationRead(d, meta)
+}
+
+func resourceDomainConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IoTConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindDomainConfigurationByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Domain Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IoT Domain Configuration (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", output.DomainConfigurationArn)
+	if output.AuthorizerConfig != nil {
+		if err := d.Set("authorizer_config", []interface{}{flattenAuthorizerConfig(output.AuthorizerConfig)}); err != nil {
+			return fmt.Errorf("error setting authorizer_config: %w", err)
+		}
+	} else {
+		d.Set("authorizer_config", nil)
+	}
+	d.Set("domain_name", output.DomainName)
+	d.Set("domain_type", output.DomainType)
+	d.Set("name", output.DomainConfigurationName)
+	var serverCertificateArns []string
+	for _, v := range output.ServerCertificates {
+		serverCertificateArns = append(serverCertificateArns, aws.StringValue(v.ServerCertificateArn))
+	}
+	d.Set("server_certificate_arns", serverCertificateArns)
+	d.Set("service_type", output.ServiceType)
+	d.Set("status", output.DomainConfigurationStatus)
+
+	tags, err := ListTags(conn, d.Get("arn").(string))
+	if err != nil {
+		return fmt.Errorf("error listing tags for IoT Domain Configuration (%s): %w", d.Get("arn").(string), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDomainConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IoTConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &iot.UpdateDomainConfigurationInput{
+			DomainConfigurationName: aws.String(d.Id()),
+		}
+
+		if d.HasChange("authorizer_config") {
+			if v, ok := d.GetOk("authorizer_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.AuthorizerConfig = expandAuthorizerConfig(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				input.RemoveAuthorizerConfig = aws.Bool(true)
+			}
+		}
+
+		if d.HasChange("status") {
+			input.DomainConfigurationStatus = aws.String(d.Get("status").(string))
+		}
+
+		log.Printf("[DEBUG] Updating IoT Domain Configuration: %s", input)
+		_, err := conn.UpdateDomainConfiguration(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating IoT Domain Configuration (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating tags: %s", err)
+		}
+	}
+
+	return resourceDomainConfigurationRead(d, meta)
+}
+
+func resourceDomainConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IoTConn
+
+	// In order to delete an IoT Domain Configuration, you must disable it first.
+	if d.Get("status").(string) == iot.DomainConfigurationStatusEnabled {
+		log.Printf("[DEBUG] Disabling IoT Domain Configuration: %s", d.Id())
+		_, err := conn.UpdateDomainConfiguration(&iot.UpdateDomainConfigurationInput{
+			DomainConfigurationName:   aws.String(d.Id()),
+			DomainConfigurationStatus: aws.String(iot.DomainConfigurationStatusDisabled),
+		})
+
+		if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error disabling IoT Domain Configuration (%s): %w", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting IoT Domain Configuration: %s", d.Id())
+	_, err := conn.DeleteDomainConfiguration(&iot.DeleteDomainConfigurationInput{
+		DomainConfigurationName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IoT Domain Configuration (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandAuthorizerConfig(tfMap map[string]interface{}) *iot.AuthorizerConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iot.AuthorizerConfig{}
+
+	if v, ok := tfMap["allow_authorizer_override"].(bool); ok {
+		apiObject.AllowAuthorizerOverride = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["default_authorizer_name"].(string); ok && v != "" {
+		apiObject.DefaultAuthorizerName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAuthorizerConfig(apiObject *iot.AuthorizerConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AllowAuthorizerOverride; v != nil {
+		tfMap["allow_authorizer_override"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.DefaultAuthorizerName; v != nil {
+		tfMap["default_authorizer_name"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
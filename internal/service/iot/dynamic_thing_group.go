@@ -0,0 +1,264 @@
+package iot
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDynamicThingGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDynamicThingGroupCreate,
+		Read:   resourceDynamicThingGroupRead,
+		Update: resourceDynamicThingGroupUpdate,
+		Delete: resourceDynamicThingGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"index_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute_payload": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attributes": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"query_string": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"query_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDynamicThingGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IoTConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &iot.CreateDynamicThingGroupInput{
+		QueryString:    aws.String(d.Get("query_string").(string)),
+		ThingGroupName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("index_name"); ok {
+		input.IndexName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("properties"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ThingGroupProperties = expandThingGroupProperties(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("query_version"); ok {
+		input.QueryVersion = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating IoT Dynamic Thing Group: %s", input)
+	output, err := conn.CreateDynamicThingGroup(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating IoT Dynamic Thing Group (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ThingGroupName))
+
+	if _, err := waitDynamicThingGroupActive(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for IoT Dynamic Thing Group (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceDynamicThingGroupRead(d, meta)
+}
+
+func resourceDynamicThingGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IoTConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindDynamicThingGroupByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Dynamic Thing Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IoT Dynamic Thing Group (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", output.ThingGroupArn)
+	d.Set("index_name", output.IndexName)
+	d.Set("name", output.ThingGroupName)
+	if v := flattenThingGroupProperties(output.ThingGroupProperties); len(v) > 0 {
+		if err := d.Set("properties", []interface{}{v}); err != nil {
+			return fmt.Errorf("error setting properties: %w", err)
+		}
+	} else {
+		d.Set("properties", nil)
+	}
+	d.Set("query_string", output.QueryString)
+	d.Set("query_version", output.QueryVersion)
+	d.Set("status", output.Status)
+	d.Set("version", output.Version)
+
+	tags, err := ListTags(conn, d.Get("arn").(string))
+	if err != nil {
+		return fmt.Errorf("error listing tags for IoT Dynamic Thing Group (%s): %w", d.Get("arn").(string), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDynamicThingGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IoTConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &iot.UpdateDynamicThingGroupInput{
+			ExpectedVersion: aws.Int64(int64(d.Get("version").(int))),
+			QueryString:     aws.String(d.Get("query_string").(string)),
+			ThingGroupName:  aws.String(d.Id()),
+		}
+
+		if d.HasChange("index_name") {
+			input.IndexName = aws.String(d.Get("index_name").(string))
+		}
+
+		if d.HasChange("query_version") {
+			input.QueryVersion = aws.String(d.Get("query_version").(string))
+		}
+
+		if v, ok := d.GetOk("properties"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ThingGroupProperties = expandThingGroupProperties(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			input.ThingGroupProperties = &iot.ThingGroupProperties{}
+		}
+
+		// https://docs.aws.amazon.com/iot/latest/apireference/API_AttributePayload.html#API_AttributePayload_Contents:
+		// "To remove an attribute, call UpdateThing with an empty attribute value."
+		if input.ThingGroupProperties.AttributePayload == nil {
+			input.ThingGroupProperties.AttributePayload = &iot.AttributePayload{
+				Attributes: map[string]*string{},
+			}
+		}
+
+		log.Printf("[DEBUG] Updating IoT Dynamic Thing Group: %s", input)
+		_, err := conn.UpdateDynamicThingGroup(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating IoT Dynamic Thing Group (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitDynamicThingGroupActive(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for IoT Dynamic Thing Group (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating tags: %s", err)
+		}
+	}
+
+	return resourceDynamicThingGroupRead(d, meta)
+}
+
+func resourceDynamicThingGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IoTConn
+
+	log.Printf("[DEBUG] Deleting IoT Dynamic Thing Group: %s", d.Id())
+	_, err := conn.DeleteDynamicThingGroup(&iot.DeleteDynamicThingGroupInput{
+		ThingGroupName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IoT Dynamic Thing Group (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
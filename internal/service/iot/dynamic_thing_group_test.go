@@ -0,0 +1,204 @@
+package iot_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iot"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiot "github.com/hashicorp/terraform-provider-aws/internal/service/iot"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIoTDynamicThingGroup_basic(t *testing.T) {
+	var thingGroup iot.DescribeThingGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_dynamic_thing_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iot.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDynamicThingGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDynamicThingGroupConfig(rName, "attributes.env:test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDynamicThingGroupExists(resourceName, &thingGroup),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "iot", regexp.MustCompile(fmt.Sprintf("thinggroup/%s$", rName))),
+					resource.TestCheckResourceAttr(resourceName, "index_name", "AWS_Things"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "properties.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "query_string", "attributes.env:test"),
+					resource.TestCheckResourceAttrSet(resourceName, "query_version"),
+					resource.TestCheckResourceAttr(resourceName, "status", iot.DynamicGroupStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDynamicThingGroupConfig(rName, "attributes.env:prod"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDynamicThingGroupExists(resourceName, &thingGroup),
+					resource.TestCheckResourceAttr(resourceName, "query_string", "attributes.env:prod"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTDynamicThingGroup_disappears(t *testing.T) {
+	var thingGroup iot.DescribeThingGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_dynamic_thing_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iot.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDynamicThingGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDynamicThingGroupConfig(rName, "attributes.env:test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDynamicThingGroupExists(resourceName, &thingGroup),
+					acctest.CheckResourceDisappears(acctest.Provider, tfiot.ResourceDynamicThingGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTDynamicThingGroup_properties(t *testing.T) {
+	var thingGroup iot.DescribeThingGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_dynamic_thing_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iot.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDynamicThingGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDynamicThingGroupConfigProperties(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDynamicThingGroupExists(resourceName, &thingGroup),
+					resource.TestCheckResourceAttr(resourceName, "properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "properties.0.attribute_payload.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "properties.0.attribute_payload.0.attributes.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "properties.0.attribute_payload.0.attributes.Key1", "Value1"),
+					resource.TestCheckResourceAttr(resourceName, "properties.0.description", "test description 1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDynamicThingGroupExists(n string, v *iot.DescribeThingGroupOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Dynamic Thing Group ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn
+
+		output, err := tfiot.FindDynamicThingGroupByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckDynamicThingGroupDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iot_dynamic_thing_group" {
+			continue
+		}
+
+		_, err := tfiot.FindDynamicThingGroupByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("IoT Dynamic Thing Group %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccDynamicThingGroupConfigBase() string {
+	return `
+resource "aws_iot_indexing_configuration" "test" {
+  thing_indexing_configuration {
+    thing_indexing_mode = "REGISTRY"
+  }
+}
+`
+}
+
+func testAccDynamicThingGroupConfig(rName, queryString string) string {
+	return acctest.ConfigCompose(testAccDynamicThingGroupConfigBase(), fmt.Sprintf(`
+resource "aws_iot_dynamic_thing_group" "test" {
+  name         = %[1]q
+  query_string = %[2]q
+
+  depends_on = [aws_iot_indexing_configuration.test]
+}
+`, rName, queryString))
+}
+
+func testAccDynamicThingGroupConfigProperties(rName string) string {
+	return acctest.ConfigCompose(testAccDynamicThingGroupConfigBase(), fmt.Sprintf(`
+resource "aws_iot_dynamic_thing_group" "test" {
+  name         = %[1]q
+  query_string = "attributes.env:test"
+
+  properties {
+    attribute_payload {
+      attributes = {
+        Key1 = "Value1"
+      }
+    }
+
+    description = "test description 1"
+  }
+
+  depends_on = [aws_iot_indexing_configuration.test]
+}
+`, rName))
+}
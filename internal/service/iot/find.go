@@ -1,6 +1,8 @@
 package iot
 
 import (
+	"fmt"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iot"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
@@ -119,3 +121,61 @@ func FindThingGroupMembership(conn *iot.IoT, thingGroupName, thingName string) e
 
 	return nil
 }
+
+func FindDynamicThingGroupByName(conn *iot.IoT, name string) (*iot.DescribeThingGroupOutput, error) {
+	output, err := FindThingGroupByName(conn, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Static and dynamic thing groups share a namespace.
+	if output.QueryString == nil {
+		return nil, &resource.NotFoundError{
+			Message: fmt.Sprintf("IoT Thing Group (%s) is not a dynamic thing group", name),
+		}
+	}
+
+	return output, nil
+}
+
+func FindDomainConfigurationByName(conn *iot.IoT, name string) (*iot.DescribeDomainConfigurationOutput, error) {
+	input := &iot.DescribeDomainConfigurationInput{
+		DomainConfigurationName: aws.String(name),
+	}
+
+	output, err := conn.DescribeDomainConfiguration(input)
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindIndexingConfiguration(conn *iot.IoT) (*iot.GetIndexingConfigurationOutput, error) {
+	input := &iot.GetIndexingConfigurationInput{}
+
+	output, err := conn.GetIndexingConfiguration(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
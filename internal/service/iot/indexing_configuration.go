@@ -0,0 +1,319 @@
+package iot
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func ResourceIndexingConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIndexingConfigurationPut,
+		Read:   resourceIndexingConfigurationRead,
+		Update: resourceIndexingConfigurationPut,
+		Delete: schema.Noop,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"thing_group_indexing_configuration": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				AtLeastOneOf: []string{"thing_group_indexing_configuration", "thing_indexing_configuration"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"custom_field":  indexingFieldSchema(false),
+						"managed_field": indexingFieldSchema(true),
+						"thing_group_indexing_mode": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(iot.ThingGroupIndexingMode_Values(), false),
+						},
+					},
+				},
+			},
+			"thing_indexing_configuration": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				AtLeastOneOf: []string{"thing_group_indexing_configuration", "thing_indexing_configuration"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"custom_field":  indexingFieldSchema(false),
+						"managed_field": indexingFieldSchema(true),
+						"thing_connectivity_indexing_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      iot.ThingConnectivityIndexingModeOff,
+							ValidateFunc: validation.StringInSlice(iot.ThingConnectivityIndexingMode_Values(), false),
+						},
+						"thing_indexing_mode": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(iot.ThingIndexingMode_Values(), false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func indexingFieldSchema(computed bool) *schema.Schema {
+	s := &schema.Schema{
+		Type: schema.TypeSet,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Optional: !computed,
+					Computed: computed,
+				},
+				"type": {
+					Type:         schema.TypeString,
+					Optional:     !computed,
+					Computed:     computed,
+					ValidateFunc: validation.StringInSlice(iot.FieldType_Values(), false),
+				},
+			},
+		},
+	}
+
+	if computed {
+		s.Computed = true
+	} else {
+		s.Optional = true
+	}
+
+	return s
+}
+
+func resourceIndexingConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IoTConn
+
+	input := &iot.UpdateIndexingConfigurationInput{}
+
+	if v, ok := d.GetOk("thing_group_indexing_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ThingGroupIndexingConfiguration = expandThingGroupIndexingConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("thing_indexing_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ThingIndexingConfiguration = expandThingIndexingConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating IoT Indexing Configuration: %s", input)
+	_, err := conn.UpdateIndexingConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating IoT Indexing Configuration: %w", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	return resourceIndexingConfigurationRead(d, meta)
+}
+
+func resourceIndexingConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IoTConn
+
+	output, err := FindIndexingConfiguration(conn)
+
+	if err != nil {
+		return fmt.Errorf("error reading IoT Indexing Configuration: %w", err)
+	}
+
+	if output.ThingGroupIndexingConfiguration != nil {
+		if err := d.Set("thing_group_indexing_configuration", []interface{}{flattenThingGroupIndexingConfiguration(output.ThingGroupIndexingConfiguration)}); err != nil {
+			return fmt.Errorf("error setting thing_group_indexing_configuration: %w", err)
+		}
+	} else {
+		d.Set("thing_group_indexing_configuration", nil)
+	}
+	if output.ThingIndexingConfiguration != nil {
+		if err := d.Set("thing_indexing_configuration", []interface{}{flattenThingIndexingConfiguration(output.ThingIndexingConfiguration)}); err != nil {
+			return fmt.Errorf("error setting thing_indexing_configuration: %w", err)
+		}
+	} else {
+		d.Set("thing_indexing_configuration", nil)
+	}
+
+	return nil
+}
+
+func expandThingGroupIndexingConfiguration(tfMap map[string]interface{}) *iot.ThingGroupIndexingConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iot.ThingGroupIndexingConfiguration{}
+
+	if v, ok := tfMap["custom_field"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.CustomFields = expandFields(v.List())
+	}
+
+	if v, ok := tfMap["thing_group_indexing_mode"].(string); ok && v != "" {
+		apiObject.ThingGroupIndexingMode = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandThingIndexingConfiguration(tfMap map[string]interface{}) *iot.ThingIndexingConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iot.ThingIndexingConfiguration{}
+
+	if v, ok := tfMap["custom_field"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.CustomFields = expandFields(v.List())
+	}
+
+	if v, ok := tfMap["thing_connectivity_indexing_mode"].(string); ok && v != "" {
+		apiObject.ThingConnectivityIndexingMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["thing_indexing_mode"].(string); ok && v != "" {
+		apiObject.ThingIndexingMode = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandField(tfMap map[string]interface{}) *iot.Field {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iot.Field{}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandFields(tfList []interface{}) []*iot.Field {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iot.Field
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandField(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenThingGroupIndexingConfiguration(apiObject *iot.ThingGroupIndexingConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CustomFields; v != nil {
+		tfMap["custom_field"] = flattenFields(v)
+	}
+
+	if v := apiObject.ManagedFields; v != nil {
+		tfMap["managed_field"] = flattenFields(v)
+	}
+
+	if v := apiObject.ThingGroupIndexingMode; v != nil {
+		tfMap["thing_group_indexing_mode"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenThingIndexingConfiguration(apiObject *iot.ThingIndexingConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CustomFields; v != nil {
+		tfMap["custom_field"] = flattenFields(v)
+	}
+
+	if v := apiObject.ManagedFields; v != nil {
+		tfMap["managed_field"] = flattenFields(v)
+	}
+
+	if v := apiObject.ThingConnectivityIndexingMode; v != nil {
+		tfMap["thing_connectivity_indexing_mode"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ThingIndexingMode; v != nil {
+		tfMap["thing_indexing_mode"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenField(apiObject *iot.Field) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Name; v != nil {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Type; v != nil {
+		tfMap["type"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenFields(apiObjects []*iot.Field) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenField(apiObject))
+	}
+
+	return tfList
+}
@@ -0,0 +1,125 @@
+package iot_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIoTIndexingConfiguration_serial(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"basic":       testAccIndexingConfiguration_basic,
+		"customField": testAccIndexingConfiguration_customField,
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			tc(t)
+		})
+	}
+}
+
+func testAccIndexingConfiguration_basic(t *testing.T) {
+	resourceName := "aws_iot_indexing_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iot.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIndexingConfigurationConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "thing_group_indexing_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "thing_group_indexing_configuration.0.custom_field.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "thing_group_indexing_configuration.0.thing_group_indexing_mode", "OFF"),
+					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.custom_field.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.thing_connectivity_indexing_mode", "OFF"),
+					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.thing_indexing_mode", "REGISTRY"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIndexingConfiguration_customField(t *testing.T) {
+	resourceName := "aws_iot_indexing_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iot.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIndexingConfigurationConfigCustomField(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "thing_group_indexing_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "thing_group_indexing_configuration.0.thing_group_indexing_mode", "ON"),
+					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.custom_field.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "thing_indexing_configuration.0.custom_field.*", map[string]string{
+						"name": "attributes.version",
+						"type": "Number",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "thing_indexing_configuration.0.custom_field.*", map[string]string{
+						"name": "shadow.desired.power",
+						"type": "Boolean",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.thing_connectivity_indexing_mode", "STATUS"),
+					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.thing_indexing_mode", "REGISTRY_AND_SHADOW"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIndexingConfigurationConfig() string {
+	return `
+resource "aws_iot_indexing_configuration" "test" {
+  thing_indexing_configuration {
+    thing_indexing_mode = "REGISTRY"
+  }
+}
+`
+}
+
+func testAccIndexingConfigurationConfigCustomField() string {
+	return `
+resource "aws_iot_indexing_configuration" "test" {
+  thing_group_indexing_configuration {
+    thing_group_indexing_mode = "ON"
+  }
+
+  thing_indexing_configuration {
+    thing_connectivity_indexing_mode = "STATUS"
+    thing_indexing_mode              = "REGISTRY_AND_SHADOW"
+
+    custom_field {
+      name = "attributes.version"
+      type = "Number"
+    }
+
+    custom_field {
+      name = "shadow.desired.power"
+      type = "Boolean"
+    }
+  }
+}
+`
+}
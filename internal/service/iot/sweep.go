@@ -6,6 +6,7 @@ package iot
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iot"
@@ -26,6 +27,11 @@ func init() {
 		},
 	})
 
+	resource.AddTestSweepers("aws_iot_domain_configuration", &resource.Sweeper{
+		Name: "aws_iot_domain_configuration",
+		F:    sweepDomainConfigurations,
+	})
+
 	resource.AddTestSweepers("aws_iot_policy_attachment", &resource.Sweeper{
 		Name: "aws_iot_policy_attachment",
 		F:    sweepPolicyAttachments,
@@ -523,3 +529,56 @@ func sweepThingGroups(region string) error {
 
 	return nil
 }
+
+func sweepDomainConfigurations(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).IoTConn
+	input := &iot.ListDomainConfigurationsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	for {
+		output, err := conn.ListDomainConfigurations(input)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping IoT Domain Configuration sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing IoT Domain Configurations (%s): %w", region, err)
+		}
+
+		for _, v := range output.DomainConfigurations {
+			name := aws.StringValue(v.DomainConfigurationName)
+
+			// AWS managed domain configurations cannot be deleted.
+			if strings.HasPrefix(name, "iot:") {
+				continue
+			}
+
+			r := ResourceDomainConfiguration()
+			d := r.Data(nil)
+			d.SetId(name)
+			d.Set("status", iot.DomainConfigurationStatusEnabled)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.StringValue(output.NextMarker) == "" {
+			break
+		}
+
+		input.Marker = output.NextMarker
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT Domain Configurations (%s): %w", region, err)
+	}
+
+	return nil
+}
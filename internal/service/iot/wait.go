@@ -0,0 +1,29 @@
+package iot
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	dynamicThingGroupActiveTimeout = 10 * time.Minute
+)
+
+func waitDynamicThingGroupActive(conn *iot.IoT, name string) (*iot.DescribeThingGroupOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{iot.DynamicGroupStatusBuilding, iot.DynamicGroupStatusRebuilding},
+		Target:  []string{iot.DynamicGroupStatusActive},
+		Refresh: statusDynamicThingGroup(conn, name),
+		Timeout: dynamicThingGroupActiveTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*iot.DescribeThingGroupOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,303 @@
+package kendra
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/kendra"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const faqResourceIDSeparator = "/"
+
+func ResourceFaq() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFaqCreate,
+		Read:   resourceFaqRead,
+		Update: resourceFaqUpdate,
+		Delete: resourceFaqDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(faqCreatedTimeout),
+			Delete: schema.DefaultTimeout(faqDeletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"error_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"faq_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"file_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(kendra.FaqFileFormat_Values(), false),
+			},
+			"index_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"s3_path": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(3, 63),
+						},
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceFaqCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KendraConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	indexID := d.Get("index_id").(string)
+	name := d.Get("name").(string)
+	input := &kendra.CreateFaqInput{
+		IndexId: aws.String(indexID),
+		Name:    aws.String(name),
+		RoleArn: aws.String(d.Get("role_arn").(string)),
+		S3Path:  expandS3Path(d.Get("s3_path").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("file_format"); ok {
+		input.FileFormat = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Kendra FAQ: %s", input)
+	outputRaw, err := tfresource.RetryWhen(
+		tfiam.PropagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateFaq(input)
+		},
+		func(err error) (bool, error) {
+			// The IAM role may not yet be assumable by Kendra.
+			if tfawserr.ErrMessageContains(err, kendra.ErrCodeValidationException, "Please make sure your role exists and has") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("error creating Kendra FAQ (%s): %w", name, err)
+	}
+
+	faqID := aws.StringValue(outputRaw.(*kendra.CreateFaqOutput).Id)
+	d.SetId(FaqCreateResourceID(faqID, indexID))
+
+	if _, err := waitFaqCreated(conn, faqID, indexID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Kendra FAQ (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceFaqRead(d, meta)
+}
+
+func resourceFaqRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KendraConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	faqID, indexID, err := FaqParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindFaqByTwoPartKey(conn, faqID, indexID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Kendra FAQ (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Kendra FAQ (%s): %w", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "kendra",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("index/%s/faq/%s", indexID, faqID),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("created_at", aws.TimeValue(output.CreatedAt).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	d.Set("error_message", output.ErrorMessage)
+	d.Set("faq_id", output.Id)
+	d.Set("file_format", output.FileFormat)
+	d.Set("index_id", output.IndexId)
+	d.Set("name", output.Name)
+	d.Set("role_arn", output.RoleArn)
+	if err := d.Set("s3_path", flattenS3Path(output.S3Path)); err != nil {
+		return fmt.Errorf("error setting s3_path: %w", err)
+	}
+	d.Set("status", output.Status)
+	d.Set("updated_at", aws.TimeValue(output.UpdatedAt).Format(time.RFC3339))
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Kendra FAQ (%s): %w", arn, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceFaqUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KendraConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Kendra FAQ (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceFaqRead(d, meta)
+}
+
+func resourceFaqDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KendraConn
+
+	faqID, indexID, err := FaqParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Kendra FAQ: %s", d.Id())
+	_, err = conn.DeleteFaq(&kendra.DeleteFaqInput{
+		Id:      aws.String(faqID),
+		IndexId: aws.String(indexID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kendra.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Kendra FAQ (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitFaqDeleted(conn, faqID, indexID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Kendra FAQ (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FaqCreateResourceID(faqID, indexID string) string {
+	parts := []string{faqID, indexID}
+	id := strings.Join(parts, faqResourceIDSeparator)
+
+	return id
+}
+
+func FaqParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, faqResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected FAQ_ID%[2]sINDEX_ID", id, faqResourceIDSeparator)
+}
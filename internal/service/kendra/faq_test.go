@@ -0,0 +1,304 @@
+package kendra_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kendra"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkendra "github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// testAccIndexID returns the ID of an existing Kendra index to attach test resources to.
+// Kendra indexes take a long time to provision and are billed by the hour, so one is not created per test.
+func testAccIndexID(t *testing.T) string {
+	key := "AWS_KENDRA_INDEX_ID"
+	indexID := os.Getenv(key)
+	if indexID == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	return indexID
+}
+
+func TestAccKendraFaq_basic(t *testing.T) {
+	indexID := testAccIndexID(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_faq.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kendra.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFaqDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFaqConfig(rName, indexID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFaqExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "kendra", regexp.MustCompile(`index/.+/faq/.+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "faq_id"),
+					resource.TestCheckResourceAttr(resourceName, "file_format", kendra.FaqFileFormatCsv),
+					resource.TestCheckResourceAttr(resourceName, "index_id", indexID),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "s3_path.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_path.0.bucket", "aws_s3_bucket.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_path.0.key", "aws_s3_bucket_object.test", "key"),
+					resource.TestCheckResourceAttr(resourceName, "status", kendra.FaqStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKendraFaq_disappears(t *testing.T) {
+	indexID := testAccIndexID(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_faq.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kendra.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFaqDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFaqConfig(rName, indexID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFaqExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfkendra.ResourceFaq(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccKendraFaq_tags(t *testing.T) {
+	indexID := testAccIndexID(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_faq.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kendra.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFaqDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFaqTags1Config(rName, indexID, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFaqExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFaqTags2Config(rName, indexID, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFaqExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccFaqTags1Config(rName, indexID, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFaqExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFaqExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Kendra FAQ ID is set")
+		}
+
+		faqID, indexID, err := tfkendra.FaqParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KendraConn
+
+		_, err = tfkendra.FindFaqByTwoPartKey(conn, faqID, indexID)
+
+		return err
+	}
+}
+
+func testAccCheckFaqDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).KendraConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kendra_faq" {
+			continue
+		}
+
+		faqID, indexID, err := tfkendra.FaqParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfkendra.FindFaqByTwoPartKey(conn, faqID, indexID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Kendra FAQ %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccS3SourceBaseConfig(rName, key, content string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "test" {
+  bucket  = aws_s3_bucket.test.id
+  key     = %[2]q
+  content = %[3]q
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "kendra.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "s3:GetObject"
+      Effect   = "Allow"
+      Resource = "${aws_s3_bucket.test.arn}/*"
+    }]
+  })
+}
+`, rName, key, content)
+}
+
+func testAccFaqBaseConfig(rName string) string {
+	return testAccS3SourceBaseConfig(rName, "test/faq.csv", "How many free clinics are in Spokane WA?,13,https://www.freeclinics.com/\n")
+}
+
+func testAccFaqConfig(rName, indexID string) string {
+	return acctest.ConfigCompose(testAccFaqBaseConfig(rName), fmt.Sprintf(`
+resource "aws_kendra_faq" "test" {
+  index_id    = %[2]q
+  name        = %[1]q
+  file_format = "CSV"
+  role_arn    = aws_iam_role.test.arn
+
+  s3_path {
+    bucket = aws_s3_bucket.test.id
+    key    = aws_s3_bucket_object.test.key
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, indexID))
+}
+
+func testAccFaqTags1Config(rName, indexID, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccFaqBaseConfig(rName), fmt.Sprintf(`
+resource "aws_kendra_faq" "test" {
+  index_id    = %[2]q
+  name        = %[1]q
+  file_format = "CSV"
+  role_arn    = aws_iam_role.test.arn
+
+  s3_path {
+    bucket = aws_s3_bucket.test.id
+    key    = aws_s3_bucket_object.test.key
+  }
+
+  tags = {
+    %[3]q = %[4]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, indexID, tagKey1, tagValue1))
+}
+
+func testAccFaqTags2Config(rName, indexID, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccFaqBaseConfig(rName), fmt.Sprintf(`
+resource "aws_kendra_faq" "test" {
+  index_id    = %[2]q
+  name        = %[1]q
+  file_format = "CSV"
+  role_arn    = aws_iam_role.test.arn
+
+  s3_path {
+    bucket = aws_s3_bucket.test.id
+    key    = aws_s3_bucket_object.test.key
+  }
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, indexID, tagKey1, tagValue1, tagKey2, tagValue2))
+}
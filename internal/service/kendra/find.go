@@ -0,0 +1,61 @@
+package kendra
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kendra"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindFaqByTwoPartKey(conn *kendra.Kendra, faqID, indexID string) (*kendra.DescribeFaqOutput, error) {
+	input := &kendra.DescribeFaqInput{
+		Id:      aws.String(faqID),
+		IndexId: aws.String(indexID),
+	}
+
+	output, err := conn.DescribeFaq(input)
+
+	if tfawserr.ErrCodeEquals(err, kendra.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindThesaurusByTwoPartKey(conn *kendra.Kendra, thesaurusID, indexID string) (*kendra.DescribeThesaurusOutput, error) {
+	input := &kendra.DescribeThesaurusInput{
+		Id:      aws.String(thesaurusID),
+		IndexId: aws.String(indexID),
+	}
+
+	output, err := conn.DescribeThesaurus(input)
+
+	if tfawserr.ErrCodeEquals(err, kendra.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,38 @@
+package kendra
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kendra"
+)
+
+func expandS3Path(tfList []interface{}) *kendra.S3Path {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &kendra.S3Path{}
+
+	if v, ok := tfMap["bucket"].(string); ok && v != "" {
+		apiObject.Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["key"].(string); ok && v != "" {
+		apiObject.Key = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenS3Path(apiObject *kendra.S3Path) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"bucket": aws.StringValue(apiObject.Bucket),
+		"key":    aws.StringValue(apiObject.Key),
+	}
+
+	return []interface{}{tfMap}
+}
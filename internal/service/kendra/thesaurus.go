@@ -0,0 +1,322 @@
+package kendra
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/kendra"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const thesaurusResourceIDSeparator = "/"
+
+func ResourceThesaurus() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceThesaurusCreate,
+		Read:   resourceThesaurusRead,
+		Update: resourceThesaurusUpdate,
+		Delete: resourceThesaurusDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(thesaurusCreatedTimeout),
+			Update: schema.DefaultTimeout(thesaurusUpdatedTimeout),
+			Delete: schema.DefaultTimeout(thesaurusDeletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"index_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"source_s3_path": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(3, 63),
+						},
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"thesaurus_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceThesaurusCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KendraConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	indexID := d.Get("index_id").(string)
+	name := d.Get("name").(string)
+	input := &kendra.CreateThesaurusInput{
+		IndexId:      aws.String(indexID),
+		Name:         aws.String(name),
+		RoleArn:      aws.String(d.Get("role_arn").(string)),
+		SourceS3Path: expandS3Path(d.Get("source_s3_path").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Kendra Thesaurus: %s", input)
+	outputRaw, err := tfresource.RetryWhen(
+		tfiam.PropagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateThesaurus(input)
+		},
+		func(err error) (bool, error) {
+			if tfawserr.ErrMessageContains(err, kendra.ErrCodeValidationException, "Please make sure your role exists and has") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("error creating Kendra Thesaurus (%s): %w", name, err)
+	}
+
+	thesaurusID := aws.StringValue(outputRaw.(*kendra.CreateThesaurusOutput).Id)
+	d.SetId(ThesaurusCreateResourceID(thesaurusID, indexID))
+
+	if _, err := waitThesaurusCreated(conn, thesaurusID, indexID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Kendra Thesaurus (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceThesaurusRead(d, meta)
+}
+
+func resourceThesaurusRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KendraConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	thesaurusID, indexID, err := ThesaurusParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindThesaurusByTwoPartKey(conn, thesaurusID, indexID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Kendra Thesaurus (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Kendra Thesaurus (%s): %w", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "kendra",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("index/%s/thesaurus/%s", indexID, thesaurusID),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("description", output.Description)
+	d.Set("index_id", output.IndexId)
+	d.Set("name", output.Name)
+	d.Set("role_arn", output.RoleArn)
+	if err := d.Set("source_s3_path", flattenS3Path(output.SourceS3Path)); err != nil {
+		return fmt.Errorf("error setting source_s3_path: %w", err)
+	}
+	d.Set("status", output.Status)
+	d.Set("thesaurus_id", output.Id)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Kendra Thesaurus (%s): %w", arn, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceThesaurusUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KendraConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		thesaurusID, indexID, err := ThesaurusParseResourceID(d.Id())
+
+		if err != nil {
+			return err
+		}
+
+		input := &kendra.UpdateThesaurusInput{
+			Id:      aws.String(thesaurusID),
+			IndexId: aws.String(indexID),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("role_arn") {
+			input.RoleArn = aws.String(d.Get("role_arn").(string))
+		}
+
+		if d.HasChange("source_s3_path") {
+			input.SourceS3Path = expandS3Path(d.Get("source_s3_path").([]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating Kendra Thesaurus: %s", input)
+		_, err = tfresource.RetryWhen(
+			tfiam.PropagationTimeout,
+			func() (interface{}, error) {
+				return conn.UpdateThesaurus(input)
+			},
+			func(err error) (bool, error) {
+				if tfawserr.ErrMessageContains(err, kendra.ErrCodeValidationException, "Please make sure your role exists and has") {
+					return true, err
+				}
+
+				return false, err
+			},
+		)
+
+		if err != nil {
+			return fmt.Errorf("error updating Kendra Thesaurus (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitThesaurusUpdated(conn, thesaurusID, indexID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Kendra Thesaurus (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Kendra Thesaurus (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceThesaurusRead(d, meta)
+}
+
+func resourceThesaurusDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KendraConn
+
+	thesaurusID, indexID, err := ThesaurusParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Kendra Thesaurus: %s", d.Id())
+	_, err = conn.DeleteThesaurus(&kendra.DeleteThesaurusInput{
+		Id:      aws.String(thesaurusID),
+		IndexId: aws.String(indexID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kendra.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Kendra Thesaurus (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitThesaurusDeleted(conn, thesaurusID, indexID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Kendra Thesaurus (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func ThesaurusCreateResourceID(thesaurusID, indexID string) string {
+	parts := []string{thesaurusID, indexID}
+	id := strings.Join(parts, thesaurusResourceIDSeparator)
+
+	return id
+}
+
+func ThesaurusParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, thesaurusResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected THESAURUS_ID%[2]sINDEX_ID", id, thesaurusResourceIDSeparator)
+}
@@ -0,0 +1,177 @@
+package kendra_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kendra"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkendra "github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccKendraThesaurus_basic(t *testing.T) {
+	indexID := testAccIndexID(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_thesaurus.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kendra.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckThesaurusDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThesaurusConfig(rName, indexID, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThesaurusExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "kendra", regexp.MustCompile(`index/.+/thesaurus/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "index_id", indexID),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "source_s3_path.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_s3_path.0.bucket", "aws_s3_bucket.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_s3_path.0.key", "aws_s3_bucket_object.test", "key"),
+					resource.TestCheckResourceAttr(resourceName, "status", kendra.ThesaurusStatusActive),
+					resource.TestCheckResourceAttrSet(resourceName, "thesaurus_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKendraThesaurus_disappears(t *testing.T) {
+	indexID := testAccIndexID(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_thesaurus.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kendra.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckThesaurusDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThesaurusConfig(rName, indexID, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThesaurusExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfkendra.ResourceThesaurus(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccKendraThesaurus_name(t *testing.T) {
+	indexID := testAccIndexID(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_thesaurus.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kendra.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckThesaurusDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThesaurusConfig(rName, indexID, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThesaurusExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				Config: testAccThesaurusConfig(rName, indexID, rNameUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThesaurusExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckThesaurusExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Kendra Thesaurus ID is set")
+		}
+
+		thesaurusID, indexID, err := tfkendra.ThesaurusParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KendraConn
+
+		_, err = tfkendra.FindThesaurusByTwoPartKey(conn, thesaurusID, indexID)
+
+		return err
+	}
+}
+
+func testAccCheckThesaurusDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).KendraConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kendra_thesaurus" {
+			continue
+		}
+
+		thesaurusID, indexID, err := tfkendra.ThesaurusParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfkendra.FindThesaurusByTwoPartKey(conn, thesaurusID, indexID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Kendra Thesaurus %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccThesaurusConfig(rName, indexID, thesaurusName string) string {
+	return acctest.ConfigCompose(testAccS3SourceBaseConfig(rName, "test/thesaurus.txt", "Kendra,Search Engine\n"), fmt.Sprintf(`
+resource "aws_kendra_thesaurus" "test" {
+  index_id = %[1]q
+  name     = %[2]q
+  role_arn = aws_iam_role.test.arn
+
+  source_s3_path {
+    bucket = aws_s3_bucket.test.id
+    key    = aws_s3_bucket_object.test.key
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, indexID, thesaurusName))
+}
@@ -0,0 +1,115 @@
+package kendra
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kendra"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	faqCreatedTimeout = 30 * time.Minute
+	faqDeletedTimeout = 30 * time.Minute
+
+	thesaurusCreatedTimeout = 30 * time.Minute
+	thesaurusUpdatedTimeout = 30 * time.Minute
+	thesaurusDeletedTimeout = 30 * time.Minute
+)
+
+func waitFaqCreated(conn *kendra.Kendra, faqID, indexID string, timeout time.Duration) (*kendra.DescribeFaqOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.FaqStatusCreating},
+		Target:  []string{kendra.FaqStatusActive},
+		Refresh: statusFaq(conn, faqID, indexID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kendra.DescribeFaqOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFaqDeleted(conn *kendra.Kendra, faqID, indexID string, timeout time.Duration) (*kendra.DescribeFaqOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.FaqStatusDeleting},
+		Target:  []string{},
+		Refresh: statusFaq(conn, faqID, indexID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kendra.DescribeFaqOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitThesaurusCreated(conn *kendra.Kendra, thesaurusID, indexID string, timeout time.Duration) (*kendra.DescribeThesaurusOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.ThesaurusStatusCreating},
+		Target:  []string{kendra.ThesaurusStatusActive},
+		Refresh: statusThesaurus(conn, thesaurusID, indexID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kendra.DescribeThesaurusOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitThesaurusUpdated(conn *kendra.Kendra, thesaurusID, indexID string, timeout time.Duration) (*kendra.DescribeThesaurusOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.ThesaurusStatusUpdating},
+		Target:  []string{kendra.ThesaurusStatusActive},
+		Refresh: statusThesaurus(conn, thesaurusID, indexID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kendra.DescribeThesaurusOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitThesaurusDeleted(conn *kendra.Kendra, thesaurusID, indexID string, timeout time.Duration) (*kendra.DescribeThesaurusOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.ThesaurusStatusDeleting},
+		Target:  []string{},
+		Refresh: statusThesaurus(conn, thesaurusID, indexID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kendra.DescribeThesaurusOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
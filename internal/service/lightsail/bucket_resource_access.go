@@ -0,0 +1,140 @@
+package lightsail
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const bucketResourceAccessIDSeparator = ","
+
+func ResourceBucketResourceAccess() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketResourceAccessCreate,
+		Read:   resourceBucketResourceAccessRead,
+		Delete: resourceBucketResourceAccessDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceBucketResourceAccessCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	bucketName := d.Get("bucket_name").(string)
+	resourceName := d.Get("resource_name").(string)
+	id := BucketResourceAccessCreateResourceID(bucketName, resourceName)
+
+	if err := setBucketResourceAccess(conn, bucketName, resourceName, lightsail.ResourceBucketAccessAllow); err != nil {
+		return fmt.Errorf("error creating Lightsail Bucket Resource Access (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceBucketResourceAccessRead(d, meta)
+}
+
+func resourceBucketResourceAccessRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	bucketName, resourceName, err := BucketResourceAccessParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	_, err = FindBucketResourceAccessByTwoPartKey(conn, bucketName, resourceName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lightsail Bucket Resource Access (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Bucket Resource Access (%s): %w", d.Id(), err)
+	}
+
+	d.Set("bucket_name", bucketName)
+	d.Set("resource_name", resourceName)
+
+	return nil
+}
+
+func resourceBucketResourceAccessDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	bucketName, resourceName, err := BucketResourceAccessParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Lightsail Bucket Resource Access: %s", d.Id())
+	err = setBucketResourceAccess(conn, bucketName, resourceName, lightsail.ResourceBucketAccessDeny)
+
+	if tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lightsail Bucket Resource Access (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func setBucketResourceAccess(conn *lightsail.Lightsail, bucketName, resourceName, access string) error {
+	input := &lightsail.SetResourceAccessForBucketInput{
+		Access:       aws.String(access),
+		BucketName:   aws.String(bucketName),
+		ResourceName: aws.String(resourceName),
+	}
+
+	log.Printf("[DEBUG] Setting Lightsail Bucket resource access: %s", input)
+	output, err := conn.SetResourceAccessForBucket(input)
+
+	if err != nil {
+		return err
+	}
+
+	return waitOperations(conn, output.Operations, OperationTimeout)
+}
+
+func BucketResourceAccessCreateResourceID(bucketName, resourceName string) string {
+	parts := []string{bucketName, resourceName}
+	id := strings.Join(parts, bucketResourceAccessIDSeparator)
+
+	return id
+}
+
+func BucketResourceAccessParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, bucketResourceAccessIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected BUCKET_NAME%[2]sRESOURCE_NAME", id, bucketResourceAccessIDSeparator)
+}
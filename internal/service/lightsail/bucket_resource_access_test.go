@@ -0,0 +1,122 @@
+package lightsail_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflightsail "github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccLightsailBucketResourceAccess_basic(t *testing.T) {
+	resourceName := "aws_lightsail_bucket_resource_access.test"
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lightsail.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, lightsail.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketResourceAccessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketResourceAccessConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketResourceAccessExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "bucket_name", "aws_lightsail_bucket.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_name", "aws_lightsail_instance.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBucketResourceAccessExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lightsail Bucket Resource Access ID is set")
+		}
+
+		bucketName, resourceName, err := tflightsail.BucketResourceAccessParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailConn
+
+		_, err = tflightsail.FindBucketResourceAccessByTwoPartKey(conn, bucketName, resourceName)
+
+		return err
+	}
+}
+
+func testAccCheckBucketResourceAccessDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lightsail_bucket_resource_access" {
+			continue
+		}
+
+		bucketName, resourceName, err := tflightsail.BucketResourceAccessParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tflightsail.FindBucketResourceAccessByTwoPartKey(conn, bucketName, resourceName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lightsail Bucket Resource Access %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccBucketResourceAccessConfig(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_lightsail_bucket" "test" {
+  name      = %[1]q
+  bundle_id = "small_1_0"
+}
+
+resource "aws_lightsail_instance" "test" {
+  name              = %[1]q
+  availability_zone = data.aws_availability_zones.available.names[0]
+  blueprint_id      = "amazon_linux"
+  bundle_id         = "nano_1_0"
+}
+
+resource "aws_lightsail_bucket_resource_access" "test" {
+  bucket_name   = aws_lightsail_bucket.test.name
+  resource_name = aws_lightsail_instance.test.name
+}
+`, rName))
+}
@@ -0,0 +1,190 @@
+package lightsail_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflightsail "github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccLightsailBucket_basic(t *testing.T) {
+	var v lightsail.Bucket
+	resourceName := "aws_lightsail_bucket.test"
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lightsail.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, lightsail.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "access_rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_rules.0.allow_public_overrides", "false"),
+					resource.TestCheckResourceAttr(resourceName, "access_rules.0.get_object", "private"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "bundle_id", "small_1_0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "url"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_delete"},
+			},
+		},
+	})
+}
+
+func TestAccLightsailBucket_accessRules(t *testing.T) {
+	var v lightsail.Bucket
+	resourceName := "aws_lightsail_bucket.test"
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lightsail.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, lightsail.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketAccessRulesConfig(rName, "public", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "access_rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_rules.0.allow_public_overrides", "false"),
+					resource.TestCheckResourceAttr(resourceName, "access_rules.0.get_object", "public"),
+				),
+			},
+			{
+				Config: testAccBucketAccessRulesConfig(rName, "private", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "access_rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_rules.0.allow_public_overrides", "true"),
+					resource.TestCheckResourceAttr(resourceName, "access_rules.0.get_object", "private"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLightsailBucket_disappears(t *testing.T) {
+	var v lightsail.Bucket
+	resourceName := "aws_lightsail_bucket.test"
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lightsail.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, lightsail.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tflightsail.ResourceBucket(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBucketExists(n string, v *lightsail.Bucket) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lightsail Bucket ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailConn
+
+		output, err := tflightsail.FindBucketByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckBucketDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lightsail_bucket" {
+			continue
+		}
+
+		_, err := tflightsail.FindBucketByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lightsail Bucket %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccBucketConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lightsail_bucket" "test" {
+  name      = %[1]q
+  bundle_id = "small_1_0"
+}
+`, rName)
+}
+
+func testAccBucketAccessRulesConfig(rName, getObject string, allowPublicOverrides bool) string {
+	return fmt.Sprintf(`
+resource "aws_lightsail_bucket" "test" {
+  name      = %[1]q
+  bundle_id = "small_1_0"
+
+  access_rules {
+    get_object             = %[2]q
+    allow_public_overrides = %[3]t
+  }
+}
+`, rName, getObject, allowPublicOverrides)
+}
@@ -0,0 +1,267 @@
+package lightsail
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceContainerService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceContainerServiceCreate,
+		Read:   resourceContainerServiceRead,
+		Update: resourceContainerServiceUpdate,
+		Delete: resourceContainerServiceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(ContainerServiceCreatedTimeout),
+			Update: schema.DefaultTimeout(ContainerServiceUpdatedTimeout),
+			Delete: schema.DefaultTimeout(ContainerServiceDeletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_disabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 63),
+					validation.StringMatch(regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`), "must contain only lowercase alphanumeric characters and hyphens, and must not begin or end with a hyphen"),
+				),
+			},
+			"power": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(lightsail.ContainerServicePowerName_Values(), false),
+			},
+			"power_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"principal_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"private_domain_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"scale": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 20),
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceContainerServiceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &lightsail.CreateContainerServiceInput{
+		Power:       aws.String(d.Get("power").(string)),
+		Scale:       aws.Int64(int64(d.Get("scale").(int))),
+		ServiceName: aws.String(name),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Lightsail Container Service: %s", input)
+	_, err := conn.CreateContainerService(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Lightsail Container Service (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitContainerServiceCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Container Service (%s) create: %w", d.Id(), err)
+	}
+
+	// Container services can only be disabled once they have been created.
+	if d.Get("is_disabled").(bool) {
+		input := &lightsail.UpdateContainerServiceInput{
+			IsDisabled:  aws.Bool(true),
+			ServiceName: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Disabling Lightsail Container Service: %s", input)
+		if _, err := conn.UpdateContainerService(input); err != nil {
+			return fmt.Errorf("error disabling Lightsail Container Service (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitContainerServiceUpdated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error waiting for Lightsail Container Service (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	return resourceContainerServiceRead(d, meta)
+}
+
+func resourceContainerServiceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	cs, err := FindContainerServiceByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lightsail Container Service (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Container Service (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", cs.Arn)
+	if cs.Location != nil {
+		d.Set("availability_zone", cs.Location.AvailabilityZone)
+	} else {
+		d.Set("availability_zone", nil)
+	}
+	d.Set("created_at", aws.TimeValue(cs.CreatedAt).Format(time.RFC3339))
+	d.Set("is_disabled", cs.IsDisabled)
+	d.Set("name", cs.ContainerServiceName)
+	d.Set("power", cs.Power)
+	d.Set("power_id", cs.PowerId)
+	d.Set("principal_arn", cs.PrincipalArn)
+	d.Set("private_domain_name", cs.PrivateDomainName)
+	d.Set("resource_type", cs.ResourceType)
+	d.Set("scale", cs.Scale)
+	d.Set("state", cs.State)
+	d.Set("url", cs.Url)
+
+	tags := KeyValueTags(cs.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceContainerServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &lightsail.UpdateContainerServiceInput{
+			ServiceName: aws.String(d.Id()),
+		}
+
+		if d.HasChange("is_disabled") {
+			input.IsDisabled = aws.Bool(d.Get("is_disabled").(bool))
+		}
+
+		if d.HasChange("power") {
+			input.Power = aws.String(d.Get("power").(string))
+		}
+
+		if d.HasChange("scale") {
+			input.Scale = aws.Int64(int64(d.Get("scale").(int)))
+		}
+
+		log.Printf("[DEBUG] Updating Lightsail Container Service: %s", input)
+		_, err := conn.UpdateContainerService(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Lightsail Container Service (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitContainerServiceUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Lightsail Container Service (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating Lightsail Container Service (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceContainerServiceRead(d, meta)
+}
+
+func resourceContainerServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	log.Printf("[DEBUG] Deleting Lightsail Container Service: %s", d.Id())
+	_, err := conn.DeleteContainerService(&lightsail.DeleteContainerServiceInput{
+		ServiceName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lightsail Container Service (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitContainerServiceDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Container Service (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
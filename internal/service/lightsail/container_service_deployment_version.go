@@ -0,0 +1,381 @@
+package lightsail
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const containerServiceDeploymentVersionIDSeparator = "/"
+
+func ResourceContainerServiceDeploymentVersion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceContainerServiceDeploymentVersionCreate,
+		Read:   resourceContainerServiceDeploymentVersionRead,
+		Delete: resourceContainerServiceDeploymentVersionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(ContainerServiceDeploymentCreatedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"container": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 53,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"command": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"container_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 53),
+						},
+						"environment": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"image": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"ports": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(lightsail.ContainerServiceProtocol_Values(), false),
+							},
+						},
+					},
+				},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_endpoint": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"container_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"health_check": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"healthy_threshold": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										Default:      2,
+										ValidateFunc: validation.IntBetween(2, 10),
+									},
+									"interval_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										Default:      5,
+										ValidateFunc: validation.IntBetween(5, 300),
+									},
+									"path": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+										Default:  "/",
+									},
+									"success_codes": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+										Default:  "200-499",
+									},
+									"timeout_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										Default:      2,
+										ValidateFunc: validation.IntBetween(2, 60),
+									},
+									"unhealthy_threshold": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										Default:      2,
+										ValidateFunc: validation.IntBetween(2, 10),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceContainerServiceDeploymentVersionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	serviceName := d.Get("service_name").(string)
+	input := &lightsail.CreateContainerServiceDeploymentInput{
+		Containers:  expandContainerServiceDeploymentContainers(d.Get("container").(*schema.Set).List()),
+		ServiceName: aws.String(serviceName),
+	}
+
+	if v, ok := d.GetOk("public_endpoint"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.PublicEndpoint = expandContainerServiceDeploymentPublicEndpoint(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Lightsail Container Service Deployment: %s", input)
+	output, err := conn.CreateContainerServiceDeployment(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Lightsail Container Service (%s) deployment: %w", serviceName, err)
+	}
+
+	if output.ContainerService == nil || output.ContainerService.NextDeployment == nil {
+		return fmt.Errorf("error creating Lightsail Container Service (%s) deployment: empty next deployment", serviceName)
+	}
+
+	version := int(aws.Int64Value(output.ContainerService.NextDeployment.Version))
+
+	d.SetId(ContainerServiceDeploymentVersionCreateResourceID(serviceName, version))
+
+	if _, err := waitContainerServiceDeploymentActive(conn, serviceName, version, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Container Service (%s) deployment (%d) create: %w", serviceName, version, err)
+	}
+
+	return resourceContainerServiceDeploymentVersionRead(d, meta)
+}
+
+func resourceContainerServiceDeploymentVersionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	serviceName, version, err := ContainerServiceDeploymentVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	deployment, err := FindContainerServiceDeploymentByVersion(conn, serviceName, version)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lightsail Container Service Deployment Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Container Service Deployment Version (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("container", flattenContainerServiceDeploymentContainers(deployment.Containers)); err != nil {
+		return fmt.Errorf("error setting container: %w", err)
+	}
+
+	d.Set("created_at", aws.TimeValue(deployment.CreatedAt).Format(time.RFC3339))
+
+	if err := d.Set("public_endpoint", flattenContainerServiceDeploymentPublicEndpoint(deployment.PublicEndpoint)); err != nil {
+		return fmt.Errorf("error setting public_endpoint: %w", err)
+	}
+
+	d.Set("service_name", serviceName)
+	d.Set("state", deployment.State)
+	d.Set("version", deployment.Version)
+
+	return nil
+}
+
+func resourceContainerServiceDeploymentVersionDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] Cannot destroy Lightsail Container Service Deployment Version. Terraform will remove this resource from the state file, however resources may remain.")
+	return nil
+}
+
+func ContainerServiceDeploymentVersionCreateResourceID(serviceName string, version int) string {
+	parts := []string{serviceName, strconv.Itoa(version)}
+	id := strings.Join(parts, containerServiceDeploymentVersionIDSeparator)
+
+	return id
+}
+
+func ContainerServiceDeploymentVersionParseResourceID(id string) (string, int, error) {
+	parts := strings.Split(id, containerServiceDeploymentVersionIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		version, err := strconv.Atoi(parts[1])
+
+		if err != nil {
+			return "", 0, fmt.Errorf("error parsing Lightsail Container Service Deployment Version ID (%s) version: %w", id, err)
+		}
+
+		return parts[0], version, nil
+	}
+
+	return "", 0, fmt.Errorf("unexpected format for ID (%[1]s), expected SERVICE_NAME%[2]sVERSION", id, containerServiceDeploymentVersionIDSeparator)
+}
+
+func expandContainerServiceDeploymentContainers(tfList []interface{}) map[string]*lightsail.Container {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]*lightsail.Container)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &lightsail.Container{
+			Image: aws.String(tfMap["image"].(string)),
+		}
+
+		if v, ok := tfMap["command"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Command = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["environment"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.Environment = flex.ExpandStringMap(v)
+		}
+
+		if v, ok := tfMap["ports"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.Ports = flex.ExpandStringMap(v)
+		}
+
+		apiObjects[tfMap["container_name"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func expandContainerServiceDeploymentPublicEndpoint(tfMap map[string]interface{}) *lightsail.EndpointRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lightsail.EndpointRequest{
+		ContainerName: aws.String(tfMap["container_name"].(string)),
+		ContainerPort: aws.Int64(int64(tfMap["container_port"].(int))),
+	}
+
+	if v, ok := tfMap["health_check"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.HealthCheck = &lightsail.ContainerServiceHealthCheckConfig{
+			HealthyThreshold:   aws.Int64(int64(tfMap["healthy_threshold"].(int))),
+			IntervalSeconds:    aws.Int64(int64(tfMap["interval_seconds"].(int))),
+			Path:               aws.String(tfMap["path"].(string)),
+			SuccessCodes:       aws.String(tfMap["success_codes"].(string)),
+			TimeoutSeconds:     aws.Int64(int64(tfMap["timeout_seconds"].(int))),
+			UnhealthyThreshold: aws.Int64(int64(tfMap["unhealthy_threshold"].(int))),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenContainerServiceDeploymentContainers(apiObjects map[string]*lightsail.Container) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for name, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"command":        aws.StringValueSlice(apiObject.Command),
+			"container_name": name,
+			"environment":    aws.StringValueMap(apiObject.Environment),
+			"image":          aws.StringValue(apiObject.Image),
+			"ports":          aws.StringValueMap(apiObject.Ports),
+		})
+	}
+
+	return tfList
+}
+
+func flattenContainerServiceDeploymentPublicEndpoint(apiObject *lightsail.ContainerServiceEndpoint) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"container_name": aws.StringValue(apiObject.ContainerName),
+		"container_port": int(aws.Int64Value(apiObject.ContainerPort)),
+	}
+
+	if v := apiObject.HealthCheck; v != nil {
+		tfMap["health_check"] = []interface{}{
+			map[string]interface{}{
+				"healthy_threshold":   int(aws.Int64Value(v.HealthyThreshold)),
+				"interval_seconds":    int(aws.Int64Value(v.IntervalSeconds)),
+				"path":                aws.StringValue(v.Path),
+				"success_codes":       aws.StringValue(v.SuccessCodes),
+				"timeout_seconds":     int(aws.Int64Value(v.TimeoutSeconds)),
+				"unhealthy_threshold": int(aws.Int64Value(v.UnhealthyThreshold)),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,117 @@
+package lightsail_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflightsail "github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
+)
+
+func TestAccLightsailContainerServiceDeploymentVersion_basic(t *testing.T) {
+	resourceName := "aws_lightsail_container_service_deployment_version.test"
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lightsail.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, lightsail.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckContainerServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerServiceDeploymentVersionConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerServiceDeploymentVersionExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "service_name", "aws_lightsail_container_service.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "state", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "container.*", map[string]string{
+						"container_name":     "app",
+						"image":              "amazon/amazon-lightsail:hello-world",
+						"environment.%":      "1",
+						"environment.MY_ENV": "value",
+						"ports.%":            "1",
+						"ports.80":           "HTTP",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "public_endpoint.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "public_endpoint.0.container_name", "app"),
+					resource.TestCheckResourceAttr(resourceName, "public_endpoint.0.container_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "public_endpoint.0.health_check.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "public_endpoint.0.health_check.0.path", "/"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckContainerServiceDeploymentVersionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lightsail Container Service Deployment Version ID is set")
+		}
+
+		serviceName, version, err := tflightsail.ContainerServiceDeploymentVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailConn
+
+		_, err = tflightsail.FindContainerServiceDeploymentByVersion(conn, serviceName, version)
+
+		return err
+	}
+}
+
+func testAccContainerServiceDeploymentVersionConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lightsail_container_service" "test" {
+  name  = %[1]q
+  power = "nano"
+  scale = 1
+}
+
+resource "aws_lightsail_container_service_deployment_version" "test" {
+  service_name = aws_lightsail_container_service.test.name
+
+  container {
+    container_name = "app"
+    image          = "amazon/amazon-lightsail:hello-world"
+
+    environment = {
+      MY_ENV = "value"
+    }
+
+    ports = {
+      80 = "HTTP"
+    }
+  }
+
+  public_endpoint {
+    container_name = "app"
+    container_port = 80
+  }
+}
+`, rName)
+}
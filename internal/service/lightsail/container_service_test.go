@@ -0,0 +1,149 @@
+package lightsail_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflightsail "github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccLightsailContainerService_basic(t *testing.T) {
+	var v lightsail.ContainerService
+	resourceName := "aws_lightsail_container_service.test"
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lightsail.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, lightsail.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckContainerServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerServiceConfig(rName, "nano", 1, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerServiceExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "is_disabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "power", "nano"),
+					resource.TestCheckResourceAttr(resourceName, "scale", "1"),
+					resource.TestCheckResourceAttr(resourceName, "state", "READY"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccContainerServiceConfig(rName, "micro", 2, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerServiceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "is_disabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "power", "micro"),
+					resource.TestCheckResourceAttr(resourceName, "scale", "2"),
+					resource.TestCheckResourceAttr(resourceName, "state", "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLightsailContainerService_disappears(t *testing.T) {
+	var v lightsail.ContainerService
+	resourceName := "aws_lightsail_container_service.test"
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lightsail.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, lightsail.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckContainerServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerServiceConfig(rName, "nano", 1, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerServiceExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tflightsail.ResourceContainerService(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckContainerServiceExists(n string, v *lightsail.ContainerService) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lightsail Container Service ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailConn
+
+		output, err := tflightsail.FindContainerServiceByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckContainerServiceDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lightsail_container_service" {
+			continue
+		}
+
+		_, err := tflightsail.FindContainerServiceByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lightsail Container Service %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccContainerServiceConfig(rName, power string, scale int, isDisabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_lightsail_container_service" "test" {
+  name        = %[1]q
+  power       = %[2]q
+  scale       = %[3]d
+  is_disabled = %[4]t
+}
+`, rName, power, scale, isDisabled)
+}
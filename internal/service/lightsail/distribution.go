@@ -0,0 +1,426 @@
+package lightsail
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDistribution() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDistributionCreate,
+		Read:   resourceDistributionRead,
+		Update: resourceDistributionUpdate,
+		Delete: resourceDistributionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"alternative_domain_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bundle_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"certificate_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_cache_behavior": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"behavior": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(lightsail.BehaviorEnum_Values(), false),
+						},
+					},
+				},
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"origin": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"protocol_policy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(lightsail.OriginProtocolPolicyEnum_Values(), false),
+						},
+						"region_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(lightsail.RegionName_Values(), false),
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"origin_public_dns": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"support_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDistributionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &lightsail.CreateDistributionInput{
+		BundleId:             aws.String(d.Get("bundle_id").(string)),
+		DefaultCacheBehavior: expandDistributionCacheBehavior(d.Get("default_cache_behavior").([]interface{})),
+		DistributionName:     aws.String(name),
+		Origin:               expandDistributionInputOrigin(d.Get("origin").([]interface{})),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Lightsail Distribution: %s", input)
+	output, err := conn.CreateDistribution(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Lightsail Distribution (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if err := waitOperations(conn, []*lightsail.Operation{output.Operation}, OperationTimeout); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Distribution (%s) create: %w", d.Id(), err)
+	}
+
+	if v, ok := d.GetOk("certificate_name"); ok {
+		if err := attachDistributionCertificate(conn, d.Id(), v.(string)); err != nil {
+			return err
+		}
+	}
+
+	if !d.Get("is_enabled").(bool) {
+		input := &lightsail.UpdateDistributionInput{
+			DistributionName: aws.String(d.Id()),
+			IsEnabled:        aws.Bool(false),
+		}
+
+		if err := updateDistribution(conn, input); err != nil {
+			return err
+		}
+	}
+
+	return resourceDistributionRead(d, meta)
+}
+
+func resourceDistributionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	distribution, err := FindDistributionByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lightsail Distribution (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Distribution (%s): %w", d.Id(), err)
+	}
+
+	d.Set("alternative_domain_names", aws.StringValueSlice(distribution.AlternativeDomainNames))
+	d.Set("arn", distribution.Arn)
+	d.Set("bundle_id", distribution.BundleId)
+	d.Set("certificate_name", distribution.CertificateName)
+	d.Set("created_at", aws.TimeValue(distribution.CreatedAt).Format(time.RFC3339))
+
+	if err := d.Set("default_cache_behavior", flattenDistributionCacheBehavior(distribution.DefaultCacheBehavior)); err != nil {
+		return fmt.Errorf("error setting default_cache_behavior: %w", err)
+	}
+
+	d.Set("domain_name", distribution.DomainName)
+	d.Set("is_enabled", distribution.IsEnabled)
+	d.Set("name", distribution.Name)
+
+	if err := d.Set("origin", flattenDistributionOrigin(distribution.Origin)); err != nil {
+		return fmt.Errorf("error setting origin: %w", err)
+	}
+
+	d.Set("origin_public_dns", distribution.OriginPublicDNS)
+	d.Set("resource_type", distribution.ResourceType)
+	d.Set("status", distribution.Status)
+	d.Set("support_code", distribution.SupportCode)
+
+	tags := KeyValueTags(distribution.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDistributionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	if d.HasChanges("default_cache_behavior", "is_enabled", "origin") {
+		input := &lightsail.UpdateDistributionInput{
+			DefaultCacheBehavior: expandDistributionCacheBehavior(d.Get("default_cache_behavior").([]interface{})),
+			DistributionName:     aws.String(d.Id()),
+			IsEnabled:            aws.Bool(d.Get("is_enabled").(bool)),
+			Origin:               expandDistributionInputOrigin(d.Get("origin").([]interface{})),
+		}
+
+		if err := updateDistribution(conn, input); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("bundle_id") {
+		input := &lightsail.UpdateDistributionBundleInput{
+			BundleId:         aws.String(d.Get("bundle_id").(string)),
+			DistributionName: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Lightsail Distribution bundle: %s", input)
+		output, err := conn.UpdateDistributionBundle(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Lightsail Distribution (%s) bundle: %w", d.Id(), err)
+		}
+
+		if err := waitOperations(conn, []*lightsail.Operation{output.Operation}, OperationTimeout); err != nil {
+			return fmt.Errorf("error waiting for Lightsail Distribution (%s) bundle update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("certificate_name") {
+		if v := d.Get("certificate_name").(string); v != "" {
+			if err := attachDistributionCertificate(conn, d.Id(), v); err != nil {
+				return err
+			}
+		} else {
+			log.Printf("[DEBUG] Detaching Lightsail Distribution (%s) certificate", d.Id())
+			output, err := conn.DetachCertificateFromDistribution(&lightsail.DetachCertificateFromDistributionInput{
+				DistributionName: aws.String(d.Id()),
+			})
+
+			if err != nil {
+				return fmt.Errorf("error detaching Lightsail Distribution (%s) certificate: %w", d.Id(), err)
+			}
+
+			if err := waitOperations(conn, []*lightsail.Operation{output.Operation}, OperationTimeout); err != nil {
+				return fmt.Errorf("error waiting for Lightsail Distribution (%s) certificate detach: %w", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating Lightsail Distribution (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceDistributionRead(d, meta)
+}
+
+func resourceDistributionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	log.Printf("[DEBUG] Deleting Lightsail Distribution: %s", d.Id())
+	output, err := conn.DeleteDistribution(&lightsail.DeleteDistributionInput{
+		DistributionName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lightsail Distribution (%s): %w", d.Id(), err)
+	}
+
+	if err := waitOperations(conn, []*lightsail.Operation{output.Operation}, OperationTimeout); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Distribution (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func updateDistribution(conn *lightsail.Lightsail, input *lightsail.UpdateDistributionInput) error {
+	name := aws.StringValue(input.DistributionName)
+
+	log.Printf("[DEBUG] Updating Lightsail Distribution: %s", input)
+	output, err := conn.UpdateDistribution(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Lightsail Distribution (%s): %w", name, err)
+	}
+
+	if err := waitOperations(conn, []*lightsail.Operation{output.Operation}, OperationTimeout); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Distribution (%s) update: %w", name, err)
+	}
+
+	return nil
+}
+
+func attachDistributionCertificate(conn *lightsail.Lightsail, name, certificateName string) error {
+	input := &lightsail.AttachCertificateToDistributionInput{
+		CertificateName:  aws.String(certificateName),
+		DistributionName: aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Attaching Lightsail Distribution certificate: %s", input)
+	output, err := conn.AttachCertificateToDistribution(input)
+
+	if err != nil {
+		return fmt.Errorf("error attaching Lightsail Distribution (%s) certificate (%s): %w", name, certificateName, err)
+	}
+
+	if err := waitOperations(conn, []*lightsail.Operation{output.Operation}, OperationTimeout); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Distribution (%s) certificate (%s) attach: %w", name, certificateName, err)
+	}
+
+	return nil
+}
+
+func expandDistributionCacheBehavior(tfList []interface{}) *lightsail.CacheBehavior {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &lightsail.CacheBehavior{}
+
+	if v, ok := tfMap["behavior"].(string); ok && v != "" {
+		apiObject.Behavior = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDistributionInputOrigin(tfList []interface{}) *lightsail.InputOrigin {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &lightsail.InputOrigin{}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["protocol_policy"].(string); ok && v != "" {
+		apiObject.ProtocolPolicy = aws.String(v)
+	}
+
+	if v, ok := tfMap["region_name"].(string); ok && v != "" {
+		apiObject.RegionName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenDistributionCacheBehavior(apiObject *lightsail.CacheBehavior) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"behavior": aws.StringValue(apiObject.Behavior),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenDistributionOrigin(apiObject *lightsail.Origin) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"name":            aws.StringValue(apiObject.Name),
+		"protocol_policy": aws.StringValue(apiObject.ProtocolPolicy),
+		"region_name":     aws.StringValue(apiObject.RegionName),
+		"resource_type":   aws.StringValue(apiObject.ResourceType),
+	}
+
+	return []interface{}{tfMap}
+}
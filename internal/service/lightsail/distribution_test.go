@@ -0,0 +1,141 @@
+package lightsail_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflightsail "github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccLightsailDistribution_basic(t *testing.T) {
+	var v lightsail.LightsailDistribution
+	resourceName := "aws_lightsail_distribution.test"
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lightsail.EndpointsID, t)
+			acctest.PreCheckRegion(t, endpoints.UsEast1RegionID)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, lightsail.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDistributionConfig(rName, "dont-cache", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "bundle_id", "small_1_0"),
+					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.behavior", "dont-cache"),
+					resource.TestCheckResourceAttrSet(resourceName, "domain_name"),
+					resource.TestCheckResourceAttr(resourceName, "is_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "origin.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "origin.0.name", "aws_lightsail_bucket.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "origin.0.region_name", endpoints.UsEast1RegionID),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDistributionConfig(rName, "cache", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.behavior", "cache"),
+					resource.TestCheckResourceAttr(resourceName, "is_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDistributionExists(n string, v *lightsail.LightsailDistribution) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lightsail Distribution ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailConn
+
+		output, err := tflightsail.FindDistributionByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckDistributionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lightsail_distribution" {
+			continue
+		}
+
+		_, err := tflightsail.FindDistributionByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lightsail Distribution %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccDistributionConfig(rName, behavior string, isEnabled bool) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_lightsail_bucket" "test" {
+  name      = %[1]q
+  bundle_id = "small_1_0"
+}
+
+resource "aws_lightsail_distribution" "test" {
+  name       = %[1]q
+  bundle_id  = "small_1_0"
+  is_enabled = %[3]t
+
+  origin {
+    name        = aws_lightsail_bucket.test.name
+    region_name = data.aws_region.current.name
+  }
+
+  default_cache_behavior {
+    behavior = %[2]q
+  }
+}
+`, rName, behavior, isEnabled)
+}
@@ -0,0 +1,179 @@
+package lightsail
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindOperationByID(conn *lightsail.Lightsail, id string) (*lightsail.Operation, error) {
+	input := &lightsail.GetOperationInput{
+		OperationId: aws.String(id),
+	}
+
+	output, err := conn.GetOperation(input)
+
+	if tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Operation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Operation, nil
+}
+
+func FindContainerServiceByName(conn *lightsail.Lightsail, name string) (*lightsail.ContainerService, error) {
+	input := &lightsail.GetContainerServicesInput{
+		ServiceName: aws.String(name),
+	}
+
+	output, err := conn.GetContainerServices(input)
+
+	if tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ContainerServices) == 0 || output.ContainerServices[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.ContainerServices); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.ContainerServices[0], nil
+}
+
+func FindContainerServiceDeploymentByVersion(conn *lightsail.Lightsail, serviceName string, version int) (*lightsail.ContainerServiceDeployment, error) {
+	input := &lightsail.GetContainerServiceDeploymentsInput{
+		ServiceName: aws.String(serviceName),
+	}
+
+	output, err := conn.GetContainerServiceDeployments(input)
+
+	if tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, deployment := range output.Deployments {
+		if deployment == nil {
+			continue
+		}
+
+		if int(aws.Int64Value(deployment.Version)) == version {
+			return deployment, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+func FindBucketByName(conn *lightsail.Lightsail, name string) (*lightsail.Bucket, error) {
+	input := &lightsail.GetBucketsInput{
+		BucketName:                aws.String(name),
+		IncludeConnectedResources: aws.Bool(true),
+	}
+
+	output, err := conn.GetBuckets(input)
+
+	if tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Buckets) == 0 || output.Buckets[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Buckets); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.Buckets[0], nil
+}
+
+func FindBucketResourceAccessByTwoPartKey(conn *lightsail.Lightsail, bucketName, resourceName string) (*lightsail.ResourceReceivingAccess, error) {
+	bucket, err := FindBucketByName(conn, bucketName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range bucket.ResourcesReceivingAccess {
+		if v == nil {
+			continue
+		}
+
+		if aws.StringValue(v.Name) == resourceName {
+			return v, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{}
+}
+
+func FindDistributionByName(conn *lightsail.Lightsail, name string) (*lightsail.LightsailDistribution, error) {
+	input := &lightsail.GetDistributionsInput{
+		DistributionName: aws.String(name),
+	}
+
+	output, err := conn.GetDistributions(input)
+
+	if tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Distributions) == 0 || output.Distributions[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Distributions); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.Distributions[0], nil
+}
@@ -0,0 +1,56 @@
+package lightsail
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusOperation(conn *lightsail.Lightsail, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindOperationByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusContainerService(conn *lightsail.Lightsail, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindContainerServiceByName(conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
+func statusContainerServiceDeployment(conn *lightsail.Lightsail, serviceName string, version int) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindContainerServiceDeploymentByVersion(conn, serviceName, version)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
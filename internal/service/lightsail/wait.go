@@ -0,0 +1,139 @@
+package lightsail
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	OperationTimeout = 10 * time.Minute
+
+	ContainerServiceCreatedTimeout = 30 * time.Minute
+	ContainerServiceUpdatedTimeout = 30 * time.Minute
+	ContainerServiceDeletedTimeout = 30 * time.Minute
+
+	ContainerServiceDeploymentCreatedTimeout = 30 * time.Minute
+)
+
+// waitOperation waits for a Lightsail asynchronous operation, as returned by most mutating APIs, to complete.
+func waitOperation(conn *lightsail.Lightsail, id string, timeout time.Duration) (*lightsail.Operation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{lightsail.OperationStatusNotStarted, lightsail.OperationStatusStarted},
+		Target:     []string{lightsail.OperationStatusCompleted, lightsail.OperationStatusSucceeded},
+		Refresh:    statusOperation(conn, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*lightsail.Operation); ok {
+		if status := aws.StringValue(output.Status); status == lightsail.OperationStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorDetails)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitOperations(conn *lightsail.Lightsail, operations []*lightsail.Operation, timeout time.Duration) error {
+	for _, operation := range operations {
+		if operation == nil {
+			continue
+		}
+
+		if _, err := waitOperation(conn, aws.StringValue(operation.Id), timeout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func waitContainerServiceCreated(conn *lightsail.Lightsail, name string, timeout time.Duration) (*lightsail.ContainerService, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{lightsail.ContainerServiceStatePending},
+		Target:     []string{lightsail.ContainerServiceStateReady},
+		Refresh:    statusContainerService(conn, name),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*lightsail.ContainerService); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitContainerServiceUpdated(conn *lightsail.Lightsail, name string, timeout time.Duration) (*lightsail.ContainerService, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lightsail.ContainerServiceStateUpdating},
+		Target: []string{
+			lightsail.ContainerServiceStateDisabled,
+			lightsail.ContainerServiceStateReady,
+			lightsail.ContainerServiceStateRunning,
+		},
+		Refresh:    statusContainerService(conn, name),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*lightsail.ContainerService); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitContainerServiceDeleted(conn *lightsail.Lightsail, name string, timeout time.Duration) (*lightsail.ContainerService, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{lightsail.ContainerServiceStateDeleting},
+		Target:     []string{},
+		Refresh:    statusContainerService(conn, name),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*lightsail.ContainerService); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitContainerServiceDeploymentActive(conn *lightsail.Lightsail, serviceName string, version int, timeout time.Duration) (*lightsail.ContainerServiceDeployment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{lightsail.ContainerServiceDeploymentStateActivating},
+		Target:     []string{lightsail.ContainerServiceDeploymentStateActive},
+		Refresh:    statusContainerServiceDeployment(conn, serviceName, version),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*lightsail.ContainerServiceDeployment); ok {
+		return output, err
+	}
+
+	return nil, err
+}
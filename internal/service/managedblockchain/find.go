@@ -0,0 +1,80 @@
+package managedblockchain
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindProposalByTwoPartKey(conn *managedblockchain.ManagedBlockchain, networkID, proposalID string) (*managedblockchain.Proposal, error) {
+	input := &managedblockchain.GetProposalInput{
+		NetworkId:  aws.String(networkID),
+		ProposalId: aws.String(proposalID),
+	}
+
+	output, err := conn.GetProposal(input)
+
+	if tfawserr.ErrCodeEquals(err, managedblockchain.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Proposal == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Proposal, nil
+}
+
+func FindProposalVoteByThreePartKey(conn *managedblockchain.ManagedBlockchain, networkID, proposalID, voterMemberID string) (*managedblockchain.VoteSummary, error) {
+	input := &managedblockchain.ListProposalVotesInput{
+		NetworkId:  aws.String(networkID),
+		ProposalId: aws.String(proposalID),
+	}
+	var output *managedblockchain.VoteSummary
+
+	err := conn.ListProposalVotesPages(input, func(page *managedblockchain.ListProposalVotesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ProposalVotes {
+			if v == nil {
+				continue
+			}
+
+			if aws.StringValue(v.MemberId) == voterMemberID {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, managedblockchain.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,328 @@
+package managedblockchain
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceProposal() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceProposalCreate,
+		Read:   resourceProposalRead,
+		Update: resourceProposalUpdate,
+		Delete: resourceProposalDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"actions": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"invitation": {
+							Type:         schema.TypeSet,
+							Optional:     true,
+							ForceNew:     true,
+							AtLeastOneOf: []string{"actions.0.invitation", "actions.0.removal"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"principal": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+								},
+							},
+						},
+						"removal": {
+							Type:         schema.TypeSet,
+							Optional:     true,
+							ForceNew:     true,
+							AtLeastOneOf: []string{"actions.0.invitation", "actions.0.removal"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"member_id": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"expiration_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"member_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"member_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"no_vote_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"outstanding_vote_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"proposal_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"yes_vote_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceProposalCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	networkID := d.Get("network_id").(string)
+	input := &managedblockchain.CreateProposalInput{
+		Actions:            expandProposalActions(d.Get("actions").([]interface{})),
+		ClientRequestToken: aws.String(resource.UniqueId()),
+		MemberId:           aws.String(d.Get("member_id").(string)),
+		NetworkId:          aws.String(networkID),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Managed Blockchain Proposal: %s", input)
+	output, err := conn.CreateProposal(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Managed Blockchain Proposal (network %s): %w", networkID, err)
+	}
+
+	d.SetId(ProposalCreateResourceID(networkID, aws.StringValue(output.ProposalId)))
+
+	return resourceProposalRead(d, meta)
+}
+
+func resourceProposalRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	networkID, proposalID, err := ProposalParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	proposal, err := FindProposalByTwoPartKey(conn, networkID, proposalID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Managed Blockchain Proposal (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Managed Blockchain Proposal (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("actions", flattenProposalActions(proposal.Actions)); err != nil {
+		return fmt.Errorf("error setting actions: %w", err)
+	}
+	d.Set("arn", proposal.Arn)
+	if proposal.CreationDate != nil {
+		d.Set("creation_date", aws.TimeValue(proposal.CreationDate).Format(time.RFC3339))
+	} else {
+		d.Set("creation_date", nil)
+	}
+	d.Set("description", proposal.Description)
+	if proposal.ExpirationDate != nil {
+		d.Set("expiration_date", aws.TimeValue(proposal.ExpirationDate).Format(time.RFC3339))
+	} else {
+		d.Set("expiration_date", nil)
+	}
+	d.Set("member_id", proposal.MemberId)
+	d.Set("member_name", proposal.MemberName)
+	d.Set("network_id", proposal.NetworkId)
+	d.Set("no_vote_count", proposal.NoVoteCount)
+	d.Set("outstanding_vote_count", proposal.OutstandingVoteCount)
+	d.Set("proposal_id", proposal.ProposalId)
+	d.Set("status", proposal.Status)
+	d.Set("yes_vote_count", proposal.YesVoteCount)
+
+	tags := KeyValueTags(proposal.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceProposalUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Managed Blockchain Proposal (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceProposalRead(d, meta)
+}
+
+func resourceProposalDelete(d *schema.ResourceData, meta interface{}) error {
+	// Proposals cannot be withdrawn; they remain on the network until voting completes or they expire.
+	log.Printf("[WARN] Managed Blockchain Proposal (%s) cannot be deleted, removing from state", d.Id())
+
+	return nil
+}
+
+const proposalResourceIDSeparator = "/"
+
+func ProposalCreateResourceID(networkID, proposalID string) string {
+	parts := []string{networkID, proposalID}
+	id := strings.Join(parts, proposalResourceIDSeparator)
+
+	return id
+}
+
+func ProposalParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, proposalResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected NETWORK_ID%[2]sPROPOSAL_ID", id, proposalResourceIDSeparator)
+}
+
+func expandProposalActions(tfList []interface{}) *managedblockchain.ProposalActions {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &managedblockchain.ProposalActions{}
+
+	if v, ok := tfMap["invitation"].(*schema.Set); ok && v.Len() > 0 {
+		for _, tfMapRaw := range v.List() {
+			tfMap := tfMapRaw.(map[string]interface{})
+
+			apiObject.Invitations = append(apiObject.Invitations, &managedblockchain.InviteAction{
+				Principal: aws.String(tfMap["principal"].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["removal"].(*schema.Set); ok && v.Len() > 0 {
+		for _, tfMapRaw := range v.List() {
+			tfMap := tfMapRaw.(map[string]interface{})
+
+			apiObject.Removals = append(apiObject.Removals, &managedblockchain.RemoveAction{
+				MemberId: aws.String(tfMap["member_id"].(string)),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func flattenProposalActions(apiObject *managedblockchain.ProposalActions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var invitations []interface{}
+	for _, v := range apiObject.Invitations {
+		if v == nil {
+			continue
+		}
+
+		invitations = append(invitations, map[string]interface{}{
+			"principal": aws.StringValue(v.Principal),
+		})
+	}
+
+	var removals []interface{}
+	for _, v := range apiObject.Removals {
+		if v == nil {
+			continue
+		}
+
+		removals = append(removals, map[string]interface{}{
+			"member_id": aws.StringValue(v.MemberId),
+		})
+	}
+
+	return []interface{}{map[string]interface{}{
+		"invitation": invitations,
+		"removal":    removals,
+	}}
+}
@@ -0,0 +1,199 @@
+package managedblockchain_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmanagedblockchain "github.com/hashicorp/terraform-provider-aws/internal/service/managedblockchain"
+)
+
+// Managed Blockchain networks take a long time to create and cannot be deleted
+// while they have members, so the tests use an existing Hyperledger Fabric network.
+func testAccNetworkMember(t *testing.T) (string, string) {
+	networkKey := "AWS_MANAGEDBLOCKCHAIN_NETWORK_ID"
+	networkID := os.Getenv(networkKey)
+	if networkID == "" {
+		t.Skipf("Environment variable %s is not set", networkKey)
+	}
+
+	memberKey := "AWS_MANAGEDBLOCKCHAIN_MEMBER_ID"
+	memberID := os.Getenv(memberKey)
+	if memberID == "" {
+		t.Skipf("Environment variable %s is not set", memberKey)
+	}
+
+	return networkID, memberID
+}
+
+func TestAccManagedBlockchainProposal_basic(t *testing.T) {
+	networkID, memberID := testAccNetworkMember(t)
+	resourceName := "aws_managedblockchain_proposal.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, managedblockchain.EndpointsID),
+		Providers:  acctest.Providers,
+		// Proposals cannot be deleted.
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProposalConfig(networkID, memberID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProposalExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "actions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.invitation.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "actions.0.invitation.*.principal", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.removal.#", "0"),
+					acctest.MatchResourceAttrRegionalARNNoAccount(resourceName, "arn", "managedblockchain", regexp.MustCompile(`proposals/.+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Invite the test account"),
+					resource.TestCheckResourceAttrSet(resourceName, "expiration_date"),
+					resource.TestCheckResourceAttr(resourceName, "member_id", memberID),
+					resource.TestCheckResourceAttr(resourceName, "network_id", networkID),
+					resource.TestCheckResourceAttrSet(resourceName, "proposal_id"),
+					resource.TestCheckResourceAttr(resourceName, "status", managedblockchain.ProposalStatusInProgress),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Vote counts and status can change as soon as other members vote.
+				ImportStateVerifyIgnore: []string{"no_vote_count", "outstanding_vote_count", "status", "yes_vote_count"},
+			},
+		},
+	})
+}
+
+func TestAccManagedBlockchainProposal_tags(t *testing.T) {
+	networkID, memberID := testAccNetworkMember(t)
+	resourceName := "aws_managedblockchain_proposal.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, managedblockchain.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProposalTags1Config(networkID, memberID, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProposalExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccProposalTags2Config(networkID, memberID, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProposalExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccProposalTags1Config(networkID, memberID, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProposalExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckProposalExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Managed Blockchain Proposal ID is set")
+		}
+
+		networkID, proposalID, err := tfmanagedblockchain.ProposalParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ManagedBlockchainConn
+
+		_, err = tfmanagedblockchain.FindProposalByTwoPartKey(conn, networkID, proposalID)
+
+		return err
+	}
+}
+
+func testAccProposalConfig(networkID, memberID string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_managedblockchain_proposal" "test" {
+  network_id  = %[1]q
+  member_id   = %[2]q
+  description = "Invite the test account"
+
+  actions {
+    invitation {
+      principal = data.aws_caller_identity.current.account_id
+    }
+  }
+}
+`, networkID, memberID)
+}
+
+func testAccProposalTags1Config(networkID, memberID, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_managedblockchain_proposal" "test" {
+  network_id = %[1]q
+  member_id  = %[2]q
+
+  actions {
+    invitation {
+      principal = data.aws_caller_identity.current.account_id
+    }
+  }
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, networkID, memberID, tagKey1, tagValue1)
+}
+
+func testAccProposalTags2Config(networkID, memberID, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_managedblockchain_proposal" "test" {
+  network_id = %[1]q
+  member_id  = %[2]q
+
+  actions {
+    invitation {
+      principal = data.aws_caller_identity.current.account_id
+    }
+  }
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, networkID, memberID, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,135 @@
+package managedblockchain
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceProposalVote() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceProposalVoteCreate,
+		Read:   resourceProposalVoteRead,
+		Delete: resourceProposalVoteDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"member_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"proposal_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vote": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(managedblockchain.VoteValue_Values(), false),
+			},
+			"voter_member_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceProposalVoteCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn
+
+	networkID := d.Get("network_id").(string)
+	proposalID := d.Get("proposal_id").(string)
+	voterMemberID := d.Get("voter_member_id").(string)
+	id := ProposalVoteCreateResourceID(networkID, proposalID, voterMemberID)
+	input := &managedblockchain.VoteOnProposalInput{
+		NetworkId:     aws.String(networkID),
+		ProposalId:    aws.String(proposalID),
+		Vote:          aws.String(d.Get("vote").(string)),
+		VoterMemberId: aws.String(voterMemberID),
+	}
+
+	log.Printf("[DEBUG] Creating Managed Blockchain Proposal Vote: %s", input)
+	_, err := conn.VoteOnProposal(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Managed Blockchain Proposal Vote (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceProposalVoteRead(d, meta)
+}
+
+func resourceProposalVoteRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn
+
+	networkID, proposalID, voterMemberID, err := ProposalVoteParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	vote, err := FindProposalVoteByThreePartKey(conn, networkID, proposalID, voterMemberID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Managed Blockchain Proposal Vote (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Managed Blockchain Proposal Vote (%s): %w", d.Id(), err)
+	}
+
+	d.Set("member_name", vote.MemberName)
+	d.Set("network_id", networkID)
+	d.Set("proposal_id", proposalID)
+	d.Set("vote", vote.Vote)
+	d.Set("voter_member_id", vote.MemberId)
+
+	return nil
+}
+
+func resourceProposalVoteDelete(d *schema.ResourceData, meta interface{}) error {
+	// A vote that has been cast cannot be withdrawn.
+	log.Printf("[WARN] Managed Blockchain Proposal Vote (%s) cannot be deleted, removing from state", d.Id())
+
+	return nil
+}
+
+const proposalVoteResourceIDSeparator = "/"
+
+func ProposalVoteCreateResourceID(networkID, proposalID, voterMemberID string) string {
+	parts := []string{networkID, proposalID, voterMemberID}
+	id := strings.Join(parts, proposalVoteResourceIDSeparator)
+
+	return id
+}
+
+func ProposalVoteParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, proposalVoteResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected NETWORK_ID%[2]sPROPOSAL_ID%[2]sVOTER_MEMBER_ID", id, proposalVoteResourceIDSeparator)
+}
@@ -0,0 +1,81 @@
+package managedblockchain_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmanagedblockchain "github.com/hashicorp/terraform-provider-aws/internal/service/managedblockchain"
+)
+
+func TestAccManagedBlockchainProposalVote_basic(t *testing.T) {
+	networkID, memberID := testAccNetworkMember(t)
+	resourceName := "aws_managedblockchain_proposal_vote.test"
+	proposalResourceName := "aws_managedblockchain_proposal.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, managedblockchain.EndpointsID),
+		Providers:  acctest.Providers,
+		// Votes cannot be withdrawn.
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProposalVoteConfig(networkID, memberID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProposalVoteExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "member_name"),
+					resource.TestCheckResourceAttr(resourceName, "network_id", networkID),
+					resource.TestCheckResourceAttrPair(resourceName, "proposal_id", proposalResourceName, "proposal_id"),
+					resource.TestCheckResourceAttr(resourceName, "vote", managedblockchain.VoteValueNo),
+					resource.TestCheckResourceAttr(resourceName, "voter_member_id", memberID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckProposalVoteExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Managed Blockchain Proposal Vote ID is set")
+		}
+
+		networkID, proposalID, voterMemberID, err := tfmanagedblockchain.ProposalVoteParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ManagedBlockchainConn
+
+		_, err = tfmanagedblockchain.FindProposalVoteByThreePartKey(conn, networkID, proposalID, voterMemberID)
+
+		return err
+	}
+}
+
+func testAccProposalVoteConfig(networkID, memberID string) string {
+	return acctest.ConfigCompose(testAccProposalConfig(networkID, memberID), fmt.Sprintf(`
+resource "aws_managedblockchain_proposal_vote" "test" {
+  network_id      = %[1]q
+  proposal_id     = aws_managedblockchain_proposal.test.proposal_id
+  voter_member_id = %[2]q
+  vote            = "NO"
+}
+`, networkID, memberID))
+}
@@ -0,0 +1,226 @@
+package pinpoint
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceEmailTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEmailTemplateCreate,
+		Read:   resourceEmailTemplateRead,
+		Update: resourceEmailTemplateUpdate,
+		Delete: resourceEmailTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_substitutions": templateDefaultSubstitutionsSchema(),
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"html_part": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"recommender_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"subject": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"text_part": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceEmailTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).PinpointConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	request := expandEmailTemplateRequest(d)
+
+	if len(tags) > 0 {
+		request.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	input := &pinpoint.CreateEmailTemplateInput{
+		EmailTemplateRequest: request,
+		TemplateName:         aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Creating Pinpoint Email Template: %s", input)
+	_, err := conn.CreateEmailTemplate(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Pinpoint Email Template (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceEmailTemplateRead(d, meta)
+}
+
+func resourceEmailTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).PinpointConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	template, err := FindEmailTemplateByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Pinpoint Email Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Pinpoint Email Template (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", template.Arn)
+	d.Set("default_substitutions", template.DefaultSubstitutions)
+	d.Set("description", template.TemplateDescription)
+	d.Set("html_part", template.HtmlPart)
+	d.Set("name", template.TemplateName)
+	d.Set("recommender_id", template.RecommenderId)
+	d.Set("subject", template.Subject)
+	d.Set("text_part", template.TextPart)
+
+	tags := KeyValueTags(template.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceEmailTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).PinpointConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &pinpoint.UpdateEmailTemplateInput{
+			EmailTemplateRequest: expandEmailTemplateRequest(d),
+			TemplateName:         aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Pinpoint Email Template: %s", input)
+		_, err := conn.UpdateEmailTemplate(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Pinpoint Email Template (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Pinpoint Email Template (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceEmailTemplateRead(d, meta)
+}
+
+func resourceEmailTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).PinpointConn
+
+	log.Printf("[DEBUG] Deleting Pinpoint Email Template: %s", d.Id())
+	_, err := conn.DeleteEmailTemplate(&pinpoint.DeleteEmailTemplateInput{
+		TemplateName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, pinpoint.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Pinpoint Email Template (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// templateDefaultSubstitutionsSchema returns the schema shared by all message template types
+// for the JSON object of default values to use for message variables.
+func templateDefaultSubstitutionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsJSON,
+		StateFunc: func(v interface{}) string {
+			json, _ := structure.NormalizeJsonString(v)
+			return json
+		},
+	}
+}
+
+func expandEmailTemplateRequest(d *schema.ResourceData) *pinpoint.EmailTemplateRequest {
+	apiObject := &pinpoint.EmailTemplateRequest{}
+
+	if v, ok := d.GetOk("default_substitutions"); ok {
+		apiObject.DefaultSubstitutions = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		apiObject.TemplateDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("html_part"); ok {
+		apiObject.HtmlPart = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("recommender_id"); ok {
+		apiObject.RecommenderId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("subject"); ok {
+		apiObject.Subject = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("text_part"); ok {
+		apiObject.TextPart = aws.String(v.(string))
+	}
+
+	return apiObject
+}
@@ -0,0 +1,203 @@
+package pinpoint_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpinpoint "github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccPinpointEmailTemplate_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pinpoint_email_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckApp(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, pinpoint.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEmailTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailTemplateConfig(rName, "Hello"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailTemplateExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "mobiletargeting", regexp.MustCompile(`templates/.+/EMAIL$`)),
+					resource.TestCheckResourceAttr(resourceName, "html_part", "<p>Hello {{User.UserAttributes.FirstName}}</p>"),
+					resource.TestCheckResourceAttr(resourceName, "subject", "Hello"),
+					resource.TestCheckResourceAttr(resourceName, "text_part", "Hello {{User.UserAttributes.FirstName}}"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEmailTemplateConfig(rName, "Goodbye"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "subject", "Goodbye"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPinpointEmailTemplate_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pinpoint_email_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckApp(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, pinpoint.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEmailTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailTemplateConfig(rName, "Hello"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailTemplateExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfpinpoint.ResourceEmailTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccPinpointEmailTemplate_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pinpoint_email_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckApp(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, pinpoint.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEmailTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailTemplateTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEmailTemplateTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccEmailTemplateTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEmailTemplateExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Pinpoint Email Template ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointConn
+
+		_, err := tfpinpoint.FindEmailTemplateByName(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckEmailTemplateDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_pinpoint_email_template" {
+			continue
+		}
+
+		_, err := tfpinpoint.FindEmailTemplateByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Pinpoint Email Template %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccEmailTemplateConfig(rName, greeting string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_email_template" "test" {
+  name      = %[1]q
+  subject   = %[2]q
+  html_part = "<p>%[2]s {{User.UserAttributes.FirstName}}</p>"
+  text_part = "%[2]s {{User.UserAttributes.FirstName}}"
+}
+`, rName, greeting)
+}
+
+func testAccEmailTemplateTags1Config(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_email_template" "test" {
+  name      = %[1]q
+  subject   = "Hello"
+  text_part = "Hello"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccEmailTemplateTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_email_template" "test" {
+  name      = %[1]q
+  subject   = "Hello"
+  text_part = "Hello"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,110 @@
+package pinpoint
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindEmailTemplateByName(conn *pinpoint.Pinpoint, name string) (*pinpoint.EmailTemplateResponse, error) {
+	input := &pinpoint.GetEmailTemplateInput{
+		TemplateName: aws.String(name),
+	}
+
+	output, err := conn.GetEmailTemplate(input)
+
+	if tfawserr.ErrCodeEquals(err, pinpoint.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.EmailTemplateResponse == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.EmailTemplateResponse, nil
+}
+
+func FindSMSTemplateByName(conn *pinpoint.Pinpoint, name string) (*pinpoint.SMSTemplateResponse, error) {
+	input := &pinpoint.GetSmsTemplateInput{
+		TemplateName: aws.String(name),
+	}
+
+	output, err := conn.GetSmsTemplate(input)
+
+	if tfawserr.ErrCodeEquals(err, pinpoint.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.SMSTemplateResponse == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.SMSTemplateResponse, nil
+}
+
+func FindPushTemplateByName(conn *pinpoint.Pinpoint, name string) (*pinpoint.PushNotificationTemplateResponse, error) {
+	input := &pinpoint.GetPushTemplateInput{
+		TemplateName: aws.String(name),
+	}
+
+	output, err := conn.GetPushTemplate(input)
+
+	if tfawserr.ErrCodeEquals(err, pinpoint.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.PushNotificationTemplateResponse == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.PushNotificationTemplateResponse, nil
+}
+
+func FindJourneyByTwoPartKey(conn *pinpoint.Pinpoint, applicationID, journeyID string) (*pinpoint.JourneyResponse, error) {
+	input := &pinpoint.GetJourneyInput{
+		ApplicationId: aws.String(applicationID),
+		JourneyId:     aws.String(journeyID),
+	}
+
+	output, err := conn.GetJourney(input)
+
+	if tfawserr.ErrCodeEquals(err, pinpoint.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.JourneyResponse == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.JourneyResponse, nil
+}
@@ -0,0 +1,264 @@
+package pinpoint
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const journeyResourceIDSeparator = "/"
+
+// journeyDefinitionComputedKeys are the journey fields that are either set by the service
+// or managed through dedicated arguments, and so are not compared in the journey definition.
+var journeyDefinitionComputedKeys = []string{
+	"ApplicationId",
+	"CreationDate",
+	"Id",
+	"LastModifiedDate",
+	"State",
+	"tags",
+}
+
+func ResourceJourney() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceJourneyCreate,
+		Read:   resourceJourneyRead,
+		Update: resourceJourneyUpdate,
+		Delete: resourceJourneyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"definition": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+				StateFunc: func(v interface{}) string {
+					json, _ := normalizeJourneyDefinition(v.(string))
+					return json
+				},
+			},
+			"journey_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					pinpoint.StateActive,
+					pinpoint.StateDraft,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceJourneyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).PinpointConn
+
+	applicationID := d.Get("application_id").(string)
+	request, err := expandWriteJourneyRequest(d)
+
+	if err != nil {
+		return err
+	}
+
+	input := &pinpoint.CreateJourneyInput{
+		ApplicationId:       aws.String(applicationID),
+		WriteJourneyRequest: request,
+	}
+
+	log.Printf("[DEBUG] Creating Pinpoint Journey: %s", input)
+	output, err := conn.CreateJourney(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Pinpoint Journey (%s): %w", aws.StringValue(request.Name), err)
+	}
+
+	d.SetId(JourneyCreateResourceID(applicationID, aws.StringValue(output.JourneyResponse.Id)))
+
+	return resourceJourneyRead(d, meta)
+}
+
+func resourceJourneyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).PinpointConn
+
+	applicationID, journeyID, err := JourneyParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	journey, err := FindJourneyByTwoPartKey(conn, applicationID, journeyID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Pinpoint Journey (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Pinpoint Journey (%s): %w", d.Id(), err)
+	}
+
+	b, err := jsonutil.BuildJSON(journey)
+
+	if err != nil {
+		return fmt.Errorf("error serializing Pinpoint Journey (%s) definition: %w", d.Id(), err)
+	}
+
+	definition, err := normalizeJourneyDefinition(string(b))
+
+	if err != nil {
+		return fmt.Errorf("error serializing Pinpoint Journey (%s) definition: %w", d.Id(), err)
+	}
+
+	d.Set("application_id", journey.ApplicationId)
+	d.Set("definition", definition)
+	d.Set("journey_id", journey.Id)
+	d.Set("name", journey.Name)
+	d.Set("state", journey.State)
+
+	return nil
+}
+
+func resourceJourneyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).PinpointConn
+
+	applicationID, journeyID, err := JourneyParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	request, err := expandWriteJourneyRequest(d)
+
+	if err != nil {
+		return err
+	}
+
+	input := &pinpoint.UpdateJourneyInput{
+		ApplicationId:       aws.String(applicationID),
+		JourneyId:           aws.String(journeyID),
+		WriteJourneyRequest: request,
+	}
+
+	log.Printf("[DEBUG] Updating Pinpoint Journey: %s", input)
+	_, err = conn.UpdateJourney(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Pinpoint Journey (%s): %w", d.Id(), err)
+	}
+
+	return resourceJourneyRead(d, meta)
+}
+
+func resourceJourneyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).PinpointConn
+
+	applicationID, journeyID, err := JourneyParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Pinpoint Journey: %s", d.Id())
+	_, err = conn.DeleteJourney(&pinpoint.DeleteJourneyInput{
+		ApplicationId: aws.String(applicationID),
+		JourneyId:     aws.String(journeyID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, pinpoint.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Pinpoint Journey (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func JourneyCreateResourceID(applicationID, journeyID string) string {
+	parts := []string{applicationID, journeyID}
+	id := strings.Join(parts, journeyResourceIDSeparator)
+
+	return id
+}
+
+func JourneyParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, journeyResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected APPLICATION_ID%[2]sJOURNEY_ID", id, journeyResourceIDSeparator)
+}
+
+// expandWriteJourneyRequest builds the journey request from the JSON journey definition,
+// which uses the same field names as the Amazon Pinpoint REST API.
+func expandWriteJourneyRequest(d *schema.ResourceData) (*pinpoint.WriteJourneyRequest, error) {
+	definition, err := normalizeJourneyDefinition(d.Get("definition").(string))
+
+	if err != nil {
+		return nil, fmt.Errorf("error parsing Pinpoint Journey definition: %w", err)
+	}
+
+	apiObject := &pinpoint.WriteJourneyRequest{}
+
+	if err := jsonutil.UnmarshalJSON(apiObject, bytes.NewReader([]byte(definition))); err != nil {
+		return nil, fmt.Errorf("error parsing Pinpoint Journey definition: %w", err)
+	}
+
+	if v, ok := d.GetOk("state"); ok {
+		apiObject.State = aws.String(v.(string))
+	}
+
+	return apiObject, nil
+}
+
+// normalizeJourneyDefinition returns the journey definition JSON with keys sorted
+// and service-managed fields removed.
+func normalizeJourneyDefinition(definition string) (string, error) {
+	var m map[string]interface{}
+
+	if err := json.Unmarshal([]byte(definition), &m); err != nil {
+		return "", err
+	}
+
+	for _, k := range journeyDefinitionComputedKeys {
+		delete(m, k)
+	}
+
+	b, err := json.Marshal(m)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
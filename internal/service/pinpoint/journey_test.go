@@ -0,0 +1,156 @@
+package pinpoint_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpinpoint "github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccPinpointJourney_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pinpoint_journey.test"
+	appResourceName := "aws_pinpoint_app.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckApp(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, pinpoint.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckJourneyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJourneyConfig(rName, 300),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJourneyExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", appResourceName, "application_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "definition"),
+					resource.TestCheckResourceAttrSet(resourceName, "journey_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "state", pinpoint.StateDraft),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccJourneyConfig(rName, 600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJourneyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "state", pinpoint.StateDraft),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPinpointJourney_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pinpoint_journey.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckApp(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, pinpoint.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckJourneyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJourneyConfig(rName, 300),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJourneyExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfpinpoint.ResourceJourney(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckJourneyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Pinpoint Journey ID is set")
+		}
+
+		applicationID, journeyID, err := tfpinpoint.JourneyParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointConn
+
+		_, err = tfpinpoint.FindJourneyByTwoPartKey(conn, applicationID, journeyID)
+
+		return err
+	}
+}
+
+func testAccCheckJourneyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_pinpoint_journey" {
+			continue
+		}
+
+		applicationID, journeyID, err := tfpinpoint.JourneyParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfpinpoint.FindJourneyByTwoPartKey(conn, applicationID, journeyID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Pinpoint Journey %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccJourneyConfig(rName string, waitSeconds int) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_app" "test" {
+  name = %[1]q
+}
+
+resource "aws_pinpoint_journey" "test" {
+  application_id = aws_pinpoint_app.test.application_id
+
+  definition = jsonencode({
+    Name          = %[1]q
+    StartActivity = "wait"
+    Activities = {
+      wait = {
+        Wait = {
+          WaitTime = {
+            WaitFor = "PT%[2]dS"
+          }
+        }
+      }
+    }
+  })
+}
+`, rName, waitSeconds)
+}
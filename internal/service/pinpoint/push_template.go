@@ -0,0 +1,505 @@
+package pinpoint
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourcePushTemplate() *schema.Resource {
+	androidPushNotificationTemplateSchema := &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"action": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(pinpoint.Action_Values(), false),
+				},
+				"body": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"image_icon_url": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"image_url": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"raw_content": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"small_image_icon_url": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"sound": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"title": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"url": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Create: resourcePushTemplateCreate,
+		Read:   resourcePushTemplateRead,
+		Update: resourcePushTemplateUpdate,
+		Delete: resourcePushTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"adm": androidPushNotificationTemplateSchema,
+			"apns": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(pinpoint.Action_Values(), false),
+						},
+						"body": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"media_url": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"raw_content": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"sound": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"baidu": androidPushNotificationTemplateSchema,
+			"default": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(pinpoint.Action_Values(), false),
+						},
+						"body": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"sound": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"default_substitutions": templateDefaultSubstitutionsSchema(),
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"gcm": androidPushNotificationTemplateSchema,
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"recommender_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourcePushTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).PinpointConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	request := expandPushNotificationTemplateRequest(d)
+
+	if len(tags) > 0 {
+		request.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	input := &pinpoint.CreatePushTemplateInput{
+		PushNotificationTemplateRequest: request,
+		TemplateName:                    aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Creating Pinpoint Push Template: %s", input)
+	_, err := conn.CreatePushTemplate(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Pinpoint Push Template (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourcePushTemplateRead(d, meta)
+}
+
+func resourcePushTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).PinpointConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	template, err := FindPushTemplateByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Pinpoint Push Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Pinpoint Push Template (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("adm", flattenAndroidPushNotificationTemplate(template.ADM)); err != nil {
+		return fmt.Errorf("error setting adm: %w", err)
+	}
+	if err := d.Set("apns", flattenAPNSPushNotificationTemplate(template.APNS)); err != nil {
+		return fmt.Errorf("error setting apns: %w", err)
+	}
+	d.Set("arn", template.Arn)
+	if err := d.Set("baidu", flattenAndroidPushNotificationTemplate(template.Baidu)); err != nil {
+		return fmt.Errorf("error setting baidu: %w", err)
+	}
+	if err := d.Set("default", flattenDefaultPushNotificationTemplate(template.Default)); err != nil {
+		return fmt.Errorf("error setting default: %w", err)
+	}
+	d.Set("default_substitutions", template.DefaultSubstitutions)
+	d.Set("description", template.TemplateDescription)
+	if err := d.Set("gcm", flattenAndroidPushNotificationTemplate(template.GCM)); err != nil {
+		return fmt.Errorf("error setting gcm: %w", err)
+	}
+	d.Set("name", template.TemplateName)
+	d.Set("recommender_id", template.RecommenderId)
+
+	tags := KeyValueTags(template.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourcePushTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).PinpointConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &pinpoint.UpdatePushTemplateInput{
+			PushNotificationTemplateRequest: expandPushNotificationTemplateRequest(d),
+			TemplateName:                    aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Pinpoint Push Template: %s", input)
+		_, err := conn.UpdatePushTemplate(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Pinpoint Push Template (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Pinpoint Push Template (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourcePushTemplateRead(d, meta)
+}
+
+func resourcePushTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).PinpointConn
+
+	log.Printf("[DEBUG] Deleting Pinpoint Push Template: %s", d.Id())
+	_, err := conn.DeletePushTemplate(&pinpoint.DeletePushTemplateInput{
+		TemplateName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, pinpoint.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Pinpoint Push Template (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandPushNotificationTemplateRequest(d *schema.ResourceData) *pinpoint.PushNotificationTemplateRequest {
+	apiObject := &pinpoint.PushNotificationTemplateRequest{}
+
+	if v, ok := d.GetOk("adm"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.ADM = expandAndroidPushNotificationTemplate(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("apns"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.APNS = expandAPNSPushNotificationTemplate(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("baidu"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.Baidu = expandAndroidPushNotificationTemplate(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("default"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.Default = expandDefaultPushNotificationTemplate(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("default_substitutions"); ok {
+		apiObject.DefaultSubstitutions = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		apiObject.TemplateDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("gcm"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.GCM = expandAndroidPushNotificationTemplate(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("recommender_id"); ok {
+		apiObject.RecommenderId = aws.String(v.(string))
+	}
+
+	return apiObject
+}
+
+func expandAndroidPushNotificationTemplate(tfMap map[string]interface{}) *pinpoint.AndroidPushNotificationTemplate {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pinpoint.AndroidPushNotificationTemplate{}
+
+	if v, ok := tfMap["action"].(string); ok && v != "" {
+		apiObject.Action = aws.String(v)
+	}
+
+	if v, ok := tfMap["body"].(string); ok && v != "" {
+		apiObject.Body = aws.String(v)
+	}
+
+	if v, ok := tfMap["image_icon_url"].(string); ok && v != "" {
+		apiObject.ImageIconUrl = aws.String(v)
+	}
+
+	if v, ok := tfMap["image_url"].(string); ok && v != "" {
+		apiObject.ImageUrl = aws.String(v)
+	}
+
+	if v, ok := tfMap["raw_content"].(string); ok && v != "" {
+		apiObject.RawContent = aws.String(v)
+	}
+
+	if v, ok := tfMap["small_image_icon_url"].(string); ok && v != "" {
+		apiObject.SmallImageIconUrl = aws.String(v)
+	}
+
+	if v, ok := tfMap["sound"].(string); ok && v != "" {
+		apiObject.Sound = aws.String(v)
+	}
+
+	if v, ok := tfMap["title"].(string); ok && v != "" {
+		apiObject.Title = aws.String(v)
+	}
+
+	if v, ok := tfMap["url"].(string); ok && v != "" {
+		apiObject.Url = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandAPNSPushNotificationTemplate(tfMap map[string]interface{}) *pinpoint.APNSPushNotificationTemplate {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pinpoint.APNSPushNotificationTemplate{}
+
+	if v, ok := tfMap["action"].(string); ok && v != "" {
+		apiObject.Action = aws.String(v)
+	}
+
+	if v, ok := tfMap["body"].(string); ok && v != "" {
+		apiObject.Body = aws.String(v)
+	}
+
+	if v, ok := tfMap["media_url"].(string); ok && v != "" {
+		apiObject.MediaUrl = aws.String(v)
+	}
+
+	if v, ok := tfMap["raw_content"].(string); ok && v != "" {
+		apiObject.RawContent = aws.String(v)
+	}
+
+	if v, ok := tfMap["sound"].(string); ok && v != "" {
+		apiObject.Sound = aws.String(v)
+	}
+
+	if v, ok := tfMap["title"].(string); ok && v != "" {
+		apiObject.Title = aws.String(v)
+	}
+
+	if v, ok := tfMap["url"].(string); ok && v != "" {
+		apiObject.Url = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDefaultPushNotificationTemplate(tfMap map[string]interface{}) *pinpoint.DefaultPushNotificationTemplate {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pinpoint.DefaultPushNotificationTemplate{}
+
+	if v, ok := tfMap["action"].(string); ok && v != "" {
+		apiObject.Action = aws.String(v)
+	}
+
+	if v, ok := tfMap["body"].(string); ok && v != "" {
+		apiObject.Body = aws.String(v)
+	}
+
+	if v, ok := tfMap["sound"].(string); ok && v != "" {
+		apiObject.Sound = aws.String(v)
+	}
+
+	if v, ok := tfMap["title"].(string); ok && v != "" {
+		apiObject.Title = aws.String(v)
+	}
+
+	if v, ok := tfMap["url"].(string); ok && v != "" {
+		apiObject.Url = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAndroidPushNotificationTemplate(apiObject *pinpoint.AndroidPushNotificationTemplate) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"action":               aws.StringValue(apiObject.Action),
+		"body":                 aws.StringValue(apiObject.Body),
+		"image_icon_url":       aws.StringValue(apiObject.ImageIconUrl),
+		"image_url":            aws.StringValue(apiObject.ImageUrl),
+		"raw_content":          aws.StringValue(apiObject.RawContent),
+		"small_image_icon_url": aws.StringValue(apiObject.SmallImageIconUrl),
+		"sound":                aws.StringValue(apiObject.Sound),
+		"title":                aws.StringValue(apiObject.Title),
+		"url":                  aws.StringValue(apiObject.Url),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAPNSPushNotificationTemplate(apiObject *pinpoint.APNSPushNotificationTemplate) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"action":      aws.StringValue(apiObject.Action),
+		"body":        aws.StringValue(apiObject.Body),
+		"media_url":   aws.StringValue(apiObject.MediaUrl),
+		"raw_content": aws.StringValue(apiObject.RawContent),
+		"sound":       aws.StringValue(apiObject.Sound),
+		"title":       aws.StringValue(apiObject.Title),
+		"url":         aws.StringValue(apiObject.Url),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenDefaultPushNotificationTemplate(apiObject *pinpoint.DefaultPushNotificationTemplate) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"action": aws.StringValue(apiObject.Action),
+		"body":   aws.StringValue(apiObject.Body),
+		"sound":  aws.StringValue(apiObject.Sound),
+		"title":  aws.StringValue(apiObject.Title),
+		"url":    aws.StringValue(apiObject.Url),
+	}
+
+	return []interface{}{tfMap}
+}
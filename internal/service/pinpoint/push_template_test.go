@@ -0,0 +1,227 @@
+package pinpoint_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpinpoint "github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccPinpointPushTemplate_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pinpoint_push_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckApp(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, pinpoint.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPushTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPushTemplateConfig(rName, "Hello"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPushTemplateExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "mobiletargeting", regexp.MustCompile(`templates/.+/PUSH$`)),
+					resource.TestCheckResourceAttr(resourceName, "adm.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "apns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "apns.0.body", "Hello"),
+					resource.TestCheckResourceAttr(resourceName, "default.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default.0.action", "OPEN_APP"),
+					resource.TestCheckResourceAttr(resourceName, "default.0.body", "Hello"),
+					resource.TestCheckResourceAttr(resourceName, "gcm.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "gcm.0.title", "Hello"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPushTemplateConfig(rName, "Goodbye"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPushTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "apns.0.body", "Goodbye"),
+					resource.TestCheckResourceAttr(resourceName, "default.0.body", "Goodbye"),
+					resource.TestCheckResourceAttr(resourceName, "gcm.0.title", "Goodbye"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPinpointPushTemplate_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pinpoint_push_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckApp(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, pinpoint.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPushTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPushTemplateConfig(rName, "Hello"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPushTemplateExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfpinpoint.ResourcePushTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccPinpointPushTemplate_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pinpoint_push_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckApp(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, pinpoint.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPushTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPushTemplateTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPushTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPushTemplateTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPushTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccPushTemplateTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPushTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPushTemplateExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Pinpoint Push Template ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointConn
+
+		_, err := tfpinpoint.FindPushTemplateByName(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckPushTemplateDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_pinpoint_push_template" {
+			continue
+		}
+
+		_, err := tfpinpoint.FindPushTemplateByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Pinpoint Push Template %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccPushTemplateConfig(rName, greeting string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_push_template" "test" {
+  name = %[1]q
+
+  default {
+    action = "OPEN_APP"
+    body   = %[2]q
+    title  = %[2]q
+  }
+
+  apns {
+    body  = %[2]q
+    sound = "default"
+  }
+
+  gcm {
+    body  = %[2]q
+    title = %[2]q
+  }
+}
+`, rName, greeting)
+}
+
+func testAccPushTemplateTags1Config(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_push_template" "test" {
+  name = %[1]q
+
+  default {
+    body = "Hello"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccPushTemplateTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_push_template" "test" {
+  name = %[1]q
+
+  default {
+    body = "Hello"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,192 @@
+package pinpoint
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceSMSTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSMSTemplateCreate,
+		Read:   resourceSMSTemplateRead,
+		Update: resourceSMSTemplateUpdate,
+		Delete: resourceSMSTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"body": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"default_substitutions": templateDefaultSubstitutionsSchema(),
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"recommender_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceSMSTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).PinpointConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	request := expandSMSTemplateRequest(d)
+
+	if len(tags) > 0 {
+		request.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	input := &pinpoint.CreateSmsTemplateInput{
+		SMSTemplateRequest: request,
+		TemplateName:       aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Creating Pinpoint SMS Template: %s", input)
+	_, err := conn.CreateSmsTemplate(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Pinpoint SMS Template (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceSMSTemplateRead(d, meta)
+}
+
+func resourceSMSTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).PinpointConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	template, err := FindSMSTemplateByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Pinpoint SMS Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Pinpoint SMS Template (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", template.Arn)
+	d.Set("body", template.Body)
+	d.Set("default_substitutions", template.DefaultSubstitutions)
+	d.Set("description", template.TemplateDescription)
+	d.Set("name", template.TemplateName)
+	d.Set("recommender_id", template.RecommenderId)
+
+	tags := KeyValueTags(template.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceSMSTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).PinpointConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &pinpoint.UpdateSmsTemplateInput{
+			SMSTemplateRequest: expandSMSTemplateRequest(d),
+			TemplateName:       aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Pinpoint SMS Template: %s", input)
+		_, err := conn.UpdateSmsTemplate(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Pinpoint SMS Template (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Pinpoint SMS Template (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceSMSTemplateRead(d, meta)
+}
+
+func resourceSMSTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).PinpointConn
+
+	log.Printf("[DEBUG] Deleting Pinpoint SMS Template: %s", d.Id())
+	_, err := conn.DeleteSmsTemplate(&pinpoint.DeleteSmsTemplateInput{
+		TemplateName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, pinpoint.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Pinpoint SMS Template (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSMSTemplateRequest(d *schema.ResourceData) *pinpoint.SMSTemplateRequest {
+	apiObject := &pinpoint.SMSTemplateRequest{}
+
+	if v, ok := d.GetOk("body"); ok {
+		apiObject.Body = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_substitutions"); ok {
+		apiObject.DefaultSubstitutions = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		apiObject.TemplateDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("recommender_id"); ok {
+		apiObject.RecommenderId = aws.String(v.(string))
+	}
+
+	return apiObject
+}
@@ -0,0 +1,202 @@
+package pinpoint_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpinpoint "github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccPinpointSMSTemplate_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pinpoint_sms_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckApp(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, pinpoint.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSMSTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSMSTemplateConfig(rName, "Hello"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSMSTemplateExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "mobiletargeting", regexp.MustCompile(`templates/.+/SMS$`)),
+					resource.TestCheckResourceAttr(resourceName, "body", "Hello {{User.UserAttributes.FirstName}}"),
+					resource.TestCheckResourceAttr(resourceName, "default_substitutions", `{"User.UserAttributes.FirstName":"there"}`),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSMSTemplateConfig(rName, "Goodbye"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSMSTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "body", "Goodbye {{User.UserAttributes.FirstName}}"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPinpointSMSTemplate_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pinpoint_sms_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckApp(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, pinpoint.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSMSTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSMSTemplateConfig(rName, "Hello"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSMSTemplateExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfpinpoint.ResourceSMSTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccPinpointSMSTemplate_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pinpoint_sms_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckApp(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, pinpoint.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSMSTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSMSTemplateTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSMSTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSMSTemplateTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSMSTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccSMSTemplateTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSMSTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSMSTemplateExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Pinpoint SMS Template ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointConn
+
+		_, err := tfpinpoint.FindSMSTemplateByName(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckSMSTemplateDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_pinpoint_sms_template" {
+			continue
+		}
+
+		_, err := tfpinpoint.FindSMSTemplateByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Pinpoint SMS Template %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccSMSTemplateConfig(rName, greeting string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_sms_template" "test" {
+  name = %[1]q
+  body = "%[2]s {{User.UserAttributes.FirstName}}"
+
+  default_substitutions = jsonencode({
+    "User.UserAttributes.FirstName" = "there"
+  })
+}
+`, rName, greeting)
+}
+
+func testAccSMSTemplateTags1Config(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_sms_template" "test" {
+  name = %[1]q
+  body = "Hello"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccSMSTemplateTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_sms_template" "test" {
+  name = %[1]q
+  body = "Hello"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
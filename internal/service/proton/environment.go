@@ -0,0 +1,282 @@
+package proton
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/proton"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceEnvironment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEnvironmentCreate,
+		Read:   resourceEnvironmentRead,
+		Update: resourceEnvironmentUpdate,
+		Delete: resourceEnvironmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(environmentCreatedTimeout),
+			Update: schema.DefaultTimeout(environmentUpdatedTimeout),
+			Delete: schema.DefaultTimeout(environmentDeletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deployment_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"environment_account_connection_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"environment_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"proton_service_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"provisioning": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"spec": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 51200),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"template_major_version": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"template_minor_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"template_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ProtonConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &proton.CreateEnvironmentInput{
+		Name:                 aws.String(name),
+		Spec:                 aws.String(d.Get("spec").(string)),
+		TemplateMajorVersion: aws.String(d.Get("template_major_version").(string)),
+		TemplateName:         aws.String(d.Get("template_name").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("environment_account_connection_id"); ok {
+		input.EnvironmentAccountConnectionId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("proton_service_role_arn"); ok {
+		input.ProtonServiceRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("template_minor_version"); ok {
+		input.TemplateMinorVersion = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Proton Environment: %s", input)
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(tfiam.PropagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateEnvironment(input)
+		},
+		proton.ErrCodeAccessDeniedException)
+
+	if err != nil {
+		return fmt.Errorf("error creating Proton Environment (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitEnvironmentDeployed(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Proton Environment (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceEnvironmentRead(d, meta)
+}
+
+func resourceEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ProtonConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	environment, err := FindEnvironmentByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Proton Environment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Proton Environment (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(environment.Arn)
+	d.Set("arn", arn)
+	d.Set("deployment_status", environment.DeploymentStatus)
+	d.Set("description", environment.Description)
+	d.Set("environment_account_connection_id", environment.EnvironmentAccountConnectionId)
+	d.Set("environment_account_id", environment.EnvironmentAccountId)
+	d.Set("name", environment.Name)
+	d.Set("proton_service_role_arn", environment.ProtonServiceRoleArn)
+	d.Set("provisioning", environment.Provisioning)
+	d.Set("spec", environment.Spec)
+	d.Set("template_major_version", environment.TemplateMajorVersion)
+	d.Set("template_minor_version", environment.TemplateMinorVersion)
+	d.Set("template_name", environment.TemplateName)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Proton Environment (%s): %w", arn, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ProtonConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &proton.UpdateEnvironmentInput{
+			Description:          aws.String(d.Get("description").(string)),
+			Name:                 aws.String(d.Id()),
+			Spec:                 aws.String(d.Get("spec").(string)),
+			TemplateMajorVersion: aws.String(d.Get("template_major_version").(string)),
+		}
+
+		// The deployment type tells Proton whether, and against which template version, to redeploy.
+		switch {
+		case d.HasChange("template_major_version"):
+			input.DeploymentType = aws.String(proton.DeploymentUpdateTypeMajorVersion)
+		case d.HasChange("template_minor_version"):
+			input.DeploymentType = aws.String(proton.DeploymentUpdateTypeMinorVersion)
+		case d.HasChanges("proton_service_role_arn", "spec"):
+			input.DeploymentType = aws.String(proton.DeploymentUpdateTypeCurrentVersion)
+		default:
+			input.DeploymentType = aws.String(proton.DeploymentUpdateTypeNone)
+		}
+
+		if v, ok := d.GetOk("proton_service_role_arn"); ok {
+			input.ProtonServiceRoleArn = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("template_minor_version"); ok && aws.StringValue(input.DeploymentType) != proton.DeploymentUpdateTypeMajorVersion {
+			input.TemplateMinorVersion = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Proton Environment: %s", input)
+		_, err := conn.UpdateEnvironment(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Proton Environment (%s): %w", d.Id(), err)
+		}
+
+		if aws.StringValue(input.DeploymentType) != proton.DeploymentUpdateTypeNone {
+			if _, err := waitEnvironmentDeployed(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("error waiting for Proton Environment (%s) update: %w", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Proton Environment (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceEnvironmentRead(d, meta)
+}
+
+func resourceEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ProtonConn
+
+	log.Printf("[DEBUG] Deleting Proton Environment: %s", d.Id())
+	_, err := conn.DeleteEnvironment(&proton.DeleteEnvironmentInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, proton.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Proton Environment (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitEnvironmentDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Proton Environment (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,214 @@
+package proton
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/proton"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceEnvironmentTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEnvironmentTemplateCreate,
+		Read:   resourceEnvironmentTemplateRead,
+		Update: resourceEnvironmentTemplateUpdate,
+		Delete: resourceEnvironmentTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"encryption_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"provisioning": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(proton.Provisioning_Values(), false),
+			},
+			"recommended_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+// validName validates the names of Proton templates, environments and services.
+var validName = validation.All(
+	validation.StringLenBetween(1, 100),
+	validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z]+[0-9A-Za-z_\-]*$`), "must begin with a letter or number and contain only letters, numbers, underscores and hyphens"),
+)
+
+func resourceEnvironmentTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ProtonConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &proton.CreateEnvironmentTemplateInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		input.DisplayName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("encryption_key"); ok {
+		input.EncryptionKey = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("provisioning"); ok {
+		input.Provisioning = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Proton Environment Template: %s", input)
+	_, err := conn.CreateEnvironmentTemplate(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Proton Environment Template (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceEnvironmentTemplateRead(d, meta)
+}
+
+func resourceEnvironmentTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ProtonConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	template, err := FindEnvironmentTemplateByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Proton Environment Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Proton Environment Template (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(template.Arn)
+	d.Set("arn", arn)
+	d.Set("description", template.Description)
+	d.Set("display_name", template.DisplayName)
+	d.Set("encryption_key", template.EncryptionKey)
+	d.Set("name", template.Name)
+	d.Set("provisioning", template.Provisioning)
+	d.Set("recommended_version", template.RecommendedVersion)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Proton Environment Template (%s): %w", arn, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceEnvironmentTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ProtonConn
+
+	if d.HasChanges("description", "display_name") {
+		input := &proton.UpdateEnvironmentTemplateInput{
+			Description: aws.String(d.Get("description").(string)),
+			DisplayName: aws.String(d.Get("display_name").(string)),
+			Name:        aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Proton Environment Template: %s", input)
+		_, err := conn.UpdateEnvironmentTemplate(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Proton Environment Template (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Proton Environment Template (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceEnvironmentTemplateRead(d, meta)
+}
+
+func resourceEnvironmentTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ProtonConn
+
+	log.Printf("[DEBUG] Deleting Proton Environment Template: %s", d.Id())
+	_, err := conn.DeleteEnvironmentTemplate(&proton.DeleteEnvironmentTemplateInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, proton.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Proton Environment Template (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,235 @@
+package proton_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/proton"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfproton "github.com/hashicorp/terraform-provider-aws/internal/service/proton"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccProtonEnvironmentTemplate_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_proton_environment_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, proton.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEnvironmentTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentTemplateConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentTemplateExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "proton", regexp.MustCompile(`environment-template/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "display_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "encryption_key", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "provisioning", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccProtonEnvironmentTemplate_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_proton_environment_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, proton.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEnvironmentTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentTemplateConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentTemplateExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfproton.ResourceEnvironmentTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccProtonEnvironmentTemplate_update(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_proton_environment_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, proton.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEnvironmentTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentTemplateConfigDescription(rName, "description 1", "display name 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 1"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "display name 1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEnvironmentTemplateConfigDescription(rName, "description 2", "display name 2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 2"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "display name 2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProtonEnvironmentTemplate_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_proton_environment_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, proton.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEnvironmentTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentTemplateConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEnvironmentTemplateConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccEnvironmentTemplateConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEnvironmentTemplateExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Proton Environment Template ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ProtonConn
+
+		_, err := tfproton.FindEnvironmentTemplateByName(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckEnvironmentTemplateDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ProtonConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_proton_environment_template" {
+			continue
+		}
+
+		_, err := tfproton.FindEnvironmentTemplateByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Proton Environment Template %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccEnvironmentTemplateConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_proton_environment_template" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccEnvironmentTemplateConfigDescription(rName, description, displayName string) string {
+	return fmt.Sprintf(`
+resource "aws_proton_environment_template" "test" {
+  name         = %[1]q
+  description  = %[2]q
+  display_name = %[3]q
+}
+`, rName, description, displayName)
+}
+
+func testAccEnvironmentTemplateConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_proton_environment_template" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccEnvironmentTemplateConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_proton_environment_template" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,342 @@
+package proton
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/proton"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const templateVersionResourceIDSeparator = ":"
+
+func ResourceEnvironmentTemplateVersion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEnvironmentTemplateVersionCreate,
+		Read:   resourceEnvironmentTemplateVersionRead,
+		Update: resourceEnvironmentTemplateVersionUpdate,
+		Delete: resourceEnvironmentTemplateVersionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(templateVersionRegisteredTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"major_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"minor_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"recommended_minor_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"schema": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source": templateVersionSourceSchema(),
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{proton.TemplateVersionStatusDraft, proton.TemplateVersionStatusPublished}, false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"template_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+// templateVersionSourceSchema returns the schema for the template bundle that a template version is registered from.
+// The source is not returned by the Proton API once the version is registered.
+func templateVersionSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"s3": {
+					Type:     schema.TypeList,
+					Required: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"bucket": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringLenBetween(3, 63),
+							},
+							"key": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringLenBetween(1, 1024),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceEnvironmentTemplateVersionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ProtonConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	templateName := d.Get("template_name").(string)
+	input := &proton.CreateEnvironmentTemplateVersionInput{
+		ClientToken:  aws.String(resource.UniqueId()),
+		Source:       expandTemplateVersionSourceInput(d.Get("source").([]interface{})),
+		TemplateName: aws.String(templateName),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("major_version"); ok {
+		input.MajorVersion = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Proton Environment Template Version: %s", input)
+	output, err := conn.CreateEnvironmentTemplateVersion(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Proton Environment Template (%s) version: %w", templateName, err)
+	}
+
+	majorVersion := aws.StringValue(output.EnvironmentTemplateVersion.MajorVersion)
+	minorVersion := aws.StringValue(output.EnvironmentTemplateVersion.MinorVersion)
+	d.SetId(TemplateVersionCreateResourceID(templateName, majorVersion, minorVersion))
+
+	if _, err := waitEnvironmentTemplateVersionRegistered(conn, templateName, majorVersion, minorVersion, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Proton Environment Template Version (%s) register: %w", d.Id(), err)
+	}
+
+	if v, ok := d.GetOk("status"); ok && v.(string) != proton.TemplateVersionStatusDraft {
+		input := &proton.UpdateEnvironmentTemplateVersionInput{
+			MajorVersion: aws.String(majorVersion),
+			MinorVersion: aws.String(minorVersion),
+			Status:       aws.String(v.(string)),
+			TemplateName: aws.String(templateName),
+		}
+
+		log.Printf("[DEBUG] Updating Proton Environment Template Version: %s", input)
+		_, err := conn.UpdateEnvironmentTemplateVersion(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Proton Environment Template Version (%s) status: %w", d.Id(), err)
+		}
+	}
+
+	return resourceEnvironmentTemplateVersionRead(d, meta)
+}
+
+func resourceEnvironmentTemplateVersionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ProtonConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	templateName, majorVersion, minorVersion, err := TemplateVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	version, err := FindEnvironmentTemplateVersionByThreePartKey(conn, templateName, majorVersion, minorVersion)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Proton Environment Template Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Proton Environment Template Version (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(version.Arn)
+	d.Set("arn", arn)
+	d.Set("description", version.Description)
+	d.Set("major_version", version.MajorVersion)
+	d.Set("minor_version", version.MinorVersion)
+	d.Set("recommended_minor_version", version.RecommendedMinorVersion)
+	d.Set("schema", version.Schema)
+	d.Set("status", version.Status)
+	d.Set("template_name", version.TemplateName)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Proton Environment Template Version (%s): %w", arn, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceEnvironmentTemplateVersionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ProtonConn
+
+	if d.HasChanges("description", "status") {
+		templateName, majorVersion, minorVersion, err := TemplateVersionParseResourceID(d.Id())
+
+		if err != nil {
+			return err
+		}
+
+		input := &proton.UpdateEnvironmentTemplateVersionInput{
+			MajorVersion: aws.String(majorVersion),
+			MinorVersion: aws.String(minorVersion),
+			TemplateName: aws.String(templateName),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("status") {
+			input.Status = aws.String(d.Get("status").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Proton Environment Template Version: %s", input)
+		_, err = conn.UpdateEnvironmentTemplateVersion(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Proton Environment Template Version (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Proton Environment Template Version (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceEnvironmentTemplateVersionRead(d, meta)
+}
+
+func resourceEnvironmentTemplateVersionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ProtonConn
+
+	templateName, majorVersion, minorVersion, err := TemplateVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Proton Environment Template Version: %s", d.Id())
+	_, err = conn.DeleteEnvironmentTemplateVersion(&proton.DeleteEnvironmentTemplateVersionInput{
+		MajorVersion: aws.String(majorVersion),
+		MinorVersion: aws.String(minorVersion),
+		TemplateName: aws.String(templateName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, proton.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Proton Environment Template Version (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func TemplateVersionCreateResourceID(templateName, majorVersion, minorVersion string) string {
+	parts := []string{templateName, majorVersion, minorVersion}
+	id := strings.Join(parts, templateVersionResourceIDSeparator)
+
+	return id
+}
+
+func TemplateVersionParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, templateVersionResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected TEMPLATE_NAME%[2]sMAJOR_VERSION%[2]sMINOR_VERSION", id, templateVersionResourceIDSeparator)
+}
+
+func expandTemplateVersionSourceInput(tfList []interface{}) *proton.TemplateVersionSourceInput {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &proton.TemplateVersionSourceInput{}
+
+	if v, ok := tfMap["s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.S3 = &proton.S3ObjectSource{}
+
+		if v, ok := tfMap["bucket"].(string); ok && v != "" {
+			apiObject.S3.Bucket = aws.String(v)
+		}
+
+		if v, ok := tfMap["key"].(string); ok && v != "" {
+			apiObject.S3.Key = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
@@ -0,0 +1,219 @@
+package proton_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/proton"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfproton "github.com/hashicorp/terraform-provider-aws/internal/service/proton"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccProtonEnvironmentTemplateVersion_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_proton_environment_template_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, proton.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEnvironmentTemplateVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentTemplateVersionConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentTemplateVersionExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "proton", regexp.MustCompile(`environment-template/.+:1\.0$`)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "major_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "minor_version", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "schema"),
+					resource.TestCheckResourceAttr(resourceName, "status", proton.TemplateVersionStatusDraft),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "template_name", "aws_proton_environment_template.test", "name"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source"},
+			},
+		},
+	})
+}
+
+func TestAccProtonEnvironmentTemplateVersion_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_proton_environment_template_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, proton.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEnvironmentTemplateVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentTemplateVersionConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentTemplateVersionExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfproton.ResourceEnvironmentTemplateVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccProtonEnvironmentTemplateVersion_status(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_proton_environment_template_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, proton.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEnvironmentTemplateVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentTemplateVersionConfigStatus(rName, "description 1", proton.TemplateVersionStatusDraft),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentTemplateVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 1"),
+					resource.TestCheckResourceAttr(resourceName, "status", proton.TemplateVersionStatusDraft),
+				),
+			},
+			{
+				Config: testAccEnvironmentTemplateVersionConfigStatus(rName, "description 2", proton.TemplateVersionStatusPublished),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentTemplateVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 2"),
+					resource.TestCheckResourceAttr(resourceName, "status", proton.TemplateVersionStatusPublished),
+					resource.TestCheckResourceAttrPair("aws_proton_environment_template.test", "recommended_version", resourceName, "major_version"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEnvironmentTemplateVersionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Proton Environment Template Version ID is set")
+		}
+
+		templateName, majorVersion, minorVersion, err := tfproton.TemplateVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ProtonConn
+
+		_, err = tfproton.FindEnvironmentTemplateVersionByThreePartKey(conn, templateName, majorVersion, minorVersion)
+
+		return err
+	}
+}
+
+func testAccCheckEnvironmentTemplateVersionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ProtonConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_proton_environment_template_version" {
+			continue
+		}
+
+		templateName, majorVersion, minorVersion, err := tfproton.TemplateVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfproton.FindEnvironmentTemplateVersionByThreePartKey(conn, templateName, majorVersion, minorVersion)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Proton Environment Template Version %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccTemplateBundleBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "environment_template" {
+  bucket = aws_s3_bucket.test.id
+  key    = "environment-template.tar.gz"
+  source = "test-fixtures/environment-template.tar.gz"
+}
+
+resource "aws_s3_bucket_object" "service_template" {
+  bucket = aws_s3_bucket.test.id
+  key    = "service-template.tar.gz"
+  source = "test-fixtures/service-template.tar.gz"
+}
+`, rName)
+}
+
+func testAccEnvironmentTemplateVersionConfigStatus(rName, description, status string) string {
+	return acctest.ConfigCompose(testAccTemplateBundleBaseConfig(rName), fmt.Sprintf(`
+resource "aws_proton_environment_template" "test" {
+  name = %[1]q
+}
+
+resource "aws_proton_environment_template_version" "test" {
+  template_name = aws_proton_environment_template.test.name
+  description   = %[2]q
+  status        = %[3]q
+
+  source {
+    s3 {
+      bucket = aws_s3_bucket_object.environment_template.bucket
+      key    = aws_s3_bucket_object.environment_template.key
+    }
+  }
+}
+`, rName, description, status))
+}
+
+func testAccEnvironmentTemplateVersionConfig(rName string) string {
+	return acctest.ConfigCompose(testAccTemplateBundleBaseConfig(rName), fmt.Sprintf(`
+resource "aws_proton_environment_template" "test" {
+  name = %[1]q
+}
+
+resource "aws_proton_environment_template_version" "test" {
+  template_name = aws_proton_environment_template.test.name
+
+  source {
+    s3 {
+      bucket = aws_s3_bucket_object.environment_template.bucket
+      key    = aws_s3_bucket_object.environment_template.key
+    }
+  }
+}
+`, rName))
+}
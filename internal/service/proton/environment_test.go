@@ -0,0 +1,202 @@
+package proton_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/proton"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfproton "github.com/hashicorp/terraform-provider-aws/internal/service/proton"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccProtonEnvironment_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_proton_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, proton.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig(rName, "tf-acc-test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "proton", regexp.MustCompile(`environment/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "deployment_status", proton.DeploymentStatusSucceeded),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "proton_service_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "template_major_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "template_minor_version", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "template_name", "aws_proton_environment_template.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccProtonEnvironment_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_proton_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, proton.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig(rName, "tf-acc-test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfproton.ResourceEnvironment(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccProtonEnvironment_spec(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_proton_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, proton.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig(rName, "display name 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "spec", regexp.MustCompile(`display name 1`)),
+				),
+			},
+			{
+				Config: testAccEnvironmentConfig(rName, "display name 2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "deployment_status", proton.DeploymentStatusSucceeded),
+					resource.TestMatchResourceAttr(resourceName, "spec", regexp.MustCompile(`display name 2`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEnvironmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Proton Environment ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ProtonConn
+
+		_, err := tfproton.FindEnvironmentByName(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckEnvironmentDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ProtonConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_proton_environment" {
+			continue
+		}
+
+		_, err := tfproton.FindEnvironmentByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Proton Environment %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccEnvironmentConfig(rName, topicDisplayName string) string {
+	return acctest.ConfigCompose(testAccEnvironmentTemplateVersionConfigStatus(rName, "", proton.TemplateVersionStatusPublished), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {
+      "Service": "proton.${data.aws_partition.current.dns_suffix}"
+    },
+    "Action": "sts:AssumeRole"
+  }]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  role = aws_iam_role.test.id
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Action": [
+      "cloudformation:*",
+      "sns:*",
+      "sqs:*"
+    ],
+    "Resource": "*"
+  }]
+}
+EOF
+}
+
+resource "aws_proton_environment" "test" {
+  name                    = %[1]q
+  proton_service_role_arn = aws_iam_role.test.arn
+  template_name           = aws_proton_environment_template_version.test.template_name
+  template_major_version  = aws_proton_environment_template_version.test.major_version
+
+  spec = <<EOF
+proton: EnvironmentSpec
+
+spec:
+  topic_display_name: %[2]q
+EOF
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, topicDisplayName))
+}
@@ -0,0 +1,163 @@
+package proton
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/proton"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindEnvironmentByName(conn *proton.Proton, name string) (*proton.Environment, error) {
+	input := &proton.GetEnvironmentInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetEnvironment(input)
+
+	if tfawserr.ErrCodeEquals(err, proton.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Environment == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Environment, nil
+}
+
+func FindEnvironmentTemplateByName(conn *proton.Proton, name string) (*proton.EnvironmentTemplate, error) {
+	input := &proton.GetEnvironmentTemplateInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetEnvironmentTemplate(input)
+
+	if tfawserr.ErrCodeEquals(err, proton.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.EnvironmentTemplate == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.EnvironmentTemplate, nil
+}
+
+func FindEnvironmentTemplateVersionByThreePartKey(conn *proton.Proton, templateName, majorVersion, minorVersion string) (*proton.EnvironmentTemplateVersion, error) {
+	input := &proton.GetEnvironmentTemplateVersionInput{
+		MajorVersion: aws.String(majorVersion),
+		MinorVersion: aws.String(minorVersion),
+		TemplateName: aws.String(templateName),
+	}
+
+	output, err := conn.GetEnvironmentTemplateVersion(input)
+
+	if tfawserr.ErrCodeEquals(err, proton.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.EnvironmentTemplateVersion == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.EnvironmentTemplateVersion, nil
+}
+
+func FindServiceByName(conn *proton.Proton, name string) (*proton.Service, error) {
+	input := &proton.GetServiceInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetService(input)
+
+	if tfawserr.ErrCodeEquals(err, proton.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Service == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Service, nil
+}
+
+func FindServiceTemplateByName(conn *proton.Proton, name string) (*proton.ServiceTemplate, error) {
+	input := &proton.GetServiceTemplateInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetServiceTemplate(input)
+
+	if tfawserr.ErrCodeEquals(err, proton.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ServiceTemplate == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ServiceTemplate, nil
+}
+
+func FindServiceTemplateVersionByThreePartKey(conn *proton.Proton, templateName, majorVersion, minorVersion string) (*proton.ServiceTemplateVersion, error) {
+	input := &proton.GetServiceTemplateVersionInput{
+		MajorVersion: aws.String(majorVersion),
+		MinorVersion: aws.String(minorVersion),
+		TemplateName: aws.String(templateName),
+	}
+
+	output, err := conn.GetServiceTemplateVersion(input)
+
+	if tfawserr.ErrCodeEquals(err, proton.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ServiceTemplateVersion == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ServiceTemplateVersion, nil
+}
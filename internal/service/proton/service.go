@@ -0,0 +1,264 @@
+package proton
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/proton"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceServiceCreate,
+		Read:   resourceServiceRead,
+		Update: resourceServiceUpdate,
+		Delete: resourceServiceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(serviceCreatedTimeout),
+			Update: schema.DefaultTimeout(serviceUpdatedTimeout),
+			Delete: schema.DefaultTimeout(serviceDeletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"branch_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"repository_connection_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"repository_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+			},
+			"spec": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 51200),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			// Service instances track the template version they are deployed with,
+			// so the version of the service itself is not returned by the API.
+			"template_major_version": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"template_minor_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"template_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ProtonConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &proton.CreateServiceInput{
+		Name:                 aws.String(name),
+		Spec:                 aws.String(d.Get("spec").(string)),
+		TemplateMajorVersion: aws.String(d.Get("template_major_version").(string)),
+		TemplateName:         aws.String(d.Get("template_name").(string)),
+	}
+
+	if v, ok := d.GetOk("branch_name"); ok {
+		input.BranchName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("repository_connection_arn"); ok {
+		input.RepositoryConnectionArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("repository_id"); ok {
+		input.RepositoryId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("template_minor_version"); ok {
+		input.TemplateMinorVersion = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Proton Service: %s", input)
+	_, err := conn.CreateService(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Proton Service (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitServiceCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Proton Service (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceServiceRead(d, meta)
+}
+
+func resourceServiceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ProtonConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	service, err := FindServiceByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Proton Service (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Proton Service (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(service.Arn)
+	d.Set("arn", arn)
+	d.Set("branch_name", service.BranchName)
+	d.Set("description", service.Description)
+	d.Set("name", service.Name)
+	d.Set("repository_connection_arn", service.RepositoryConnectionArn)
+	d.Set("repository_id", service.RepositoryId)
+	d.Set("spec", service.Spec)
+	d.Set("status", service.Status)
+	d.Set("template_name", service.TemplateName)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Proton Service (%s): %w", arn, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ProtonConn
+
+	if d.HasChanges("description", "spec") {
+		input := &proton.UpdateServiceInput{
+			Name: aws.String(d.Id()),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("spec") {
+			input.Spec = aws.String(d.Get("spec").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Proton Service: %s", input)
+		_, err := conn.UpdateService(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Proton Service (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitServiceUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Proton Service (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Proton Service (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceServiceRead(d, meta)
+}
+
+func resourceServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ProtonConn
+
+	log.Printf("[DEBUG] Deleting Proton Service: %s", d.Id())
+	_, err := conn.DeleteService(&proton.DeleteServiceInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, proton.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Proton Service (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitServiceDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Proton Service (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,207 @@
+package proton
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/proton"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceServiceTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceServiceTemplateCreate,
+		Read:   resourceServiceTemplateRead,
+		Update: resourceServiceTemplateUpdate,
+		Delete: resourceServiceTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"encryption_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"pipeline_provisioning": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(proton.Provisioning_Values(), false),
+			},
+			"recommended_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceServiceTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ProtonConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &proton.CreateServiceTemplateInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		input.DisplayName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("encryption_key"); ok {
+		input.EncryptionKey = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("pipeline_provisioning"); ok {
+		input.PipelineProvisioning = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Proton Service Template: %s", input)
+	_, err := conn.CreateServiceTemplate(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Proton Service Template (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceServiceTemplateRead(d, meta)
+}
+
+func resourceServiceTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ProtonConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	template, err := FindServiceTemplateByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Proton Service Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Proton Service Template (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(template.Arn)
+	d.Set("arn", arn)
+	d.Set("description", template.Description)
+	d.Set("display_name", template.DisplayName)
+	d.Set("encryption_key", template.EncryptionKey)
+	d.Set("name", template.Name)
+	d.Set("pipeline_provisioning", template.PipelineProvisioning)
+	d.Set("recommended_version", template.RecommendedVersion)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Proton Service Template (%s): %w", arn, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceServiceTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ProtonConn
+
+	if d.HasChanges("description", "display_name") {
+		input := &proton.UpdateServiceTemplateInput{
+			Description: aws.String(d.Get("description").(string)),
+			DisplayName: aws.String(d.Get("display_name").(string)),
+			Name:        aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Proton Service Template: %s", input)
+		_, err := conn.UpdateServiceTemplate(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Proton Service Template (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Proton Service Template (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceServiceTemplateRead(d, meta)
+}
+
+func resourceServiceTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ProtonConn
+
+	log.Printf("[DEBUG] Deleting Proton Service Template: %s", d.Id())
+	_, err := conn.DeleteServiceTemplate(&proton.DeleteServiceTemplateInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, proton.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Proton Service Template (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
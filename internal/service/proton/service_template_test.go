@@ -0,0 +1,166 @@
+package proton_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/proton"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfproton "github.com/hashicorp/terraform-provider-aws/internal/service/proton"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccProtonServiceTemplate_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_proton_service_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, proton.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckServiceTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceTemplateConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceTemplateExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "proton", regexp.MustCompile(`service-template/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "display_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "encryption_key", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_provisioning", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccProtonServiceTemplate_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_proton_service_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, proton.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckServiceTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceTemplateConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceTemplateExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfproton.ResourceServiceTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccProtonServiceTemplate_update(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_proton_service_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, proton.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckServiceTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceTemplateConfigDescription(rName, "description 1", "display name 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 1"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "display name 1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccServiceTemplateConfigDescription(rName, "description 2", "display name 2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 2"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "display name 2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckServiceTemplateExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Proton Service Template ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ProtonConn
+
+		_, err := tfproton.FindServiceTemplateByName(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckServiceTemplateDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ProtonConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_proton_service_template" {
+			continue
+		}
+
+		_, err := tfproton.FindServiceTemplateByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Proton Service Template %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccServiceTemplateConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_proton_service_template" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccServiceTemplateConfigDescription(rName, description, displayName string) string {
+	return fmt.Sprintf(`
+resource "aws_proton_service_template" "test" {
+  name         = %[1]q
+  description  = %[2]q
+  display_name = %[3]q
+}
+`, rName, description, displayName)
+}
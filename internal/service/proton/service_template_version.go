@@ -0,0 +1,345 @@
+package proton
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/proton"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceServiceTemplateVersion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceServiceTemplateVersionCreate,
+		Read:   resourceServiceTemplateVersionRead,
+		Update: resourceServiceTemplateVersionUpdate,
+		Delete: resourceServiceTemplateVersionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(templateVersionRegisteredTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"compatible_environment_template": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"major_version": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"template_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validName,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"major_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"minor_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"recommended_minor_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"schema": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source": templateVersionSourceSchema(),
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{proton.TemplateVersionStatusDraft, proton.TemplateVersionStatusPublished}, false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"template_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceServiceTemplateVersionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ProtonConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	templateName := d.Get("template_name").(string)
+	input := &proton.CreateServiceTemplateVersionInput{
+		ClientToken:                    aws.String(resource.UniqueId()),
+		CompatibleEnvironmentTemplates: expandCompatibleEnvironmentTemplateInputs(d.Get("compatible_environment_template").(*schema.Set).List()),
+		Source:                         expandTemplateVersionSourceInput(d.Get("source").([]interface{})),
+		TemplateName:                   aws.String(templateName),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("major_version"); ok {
+		input.MajorVersion = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Proton Service Template Version: %s", input)
+	output, err := conn.CreateServiceTemplateVersion(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Proton Service Template (%s) version: %w", templateName, err)
+	}
+
+	majorVersion := aws.StringValue(output.ServiceTemplateVersion.MajorVersion)
+	minorVersion := aws.StringValue(output.ServiceTemplateVersion.MinorVersion)
+	d.SetId(TemplateVersionCreateResourceID(templateName, majorVersion, minorVersion))
+
+	if _, err := waitServiceTemplateVersionRegistered(conn, templateName, majorVersion, minorVersion, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Proton Service Template Version (%s) register: %w", d.Id(), err)
+	}
+
+	if v, ok := d.GetOk("status"); ok && v.(string) != proton.TemplateVersionStatusDraft {
+		input := &proton.UpdateServiceTemplateVersionInput{
+			MajorVersion: aws.String(majorVersion),
+			MinorVersion: aws.String(minorVersion),
+			Status:       aws.String(v.(string)),
+			TemplateName: aws.String(templateName),
+		}
+
+		log.Printf("[DEBUG] Updating Proton Service Template Version: %s", input)
+		_, err := conn.UpdateServiceTemplateVersion(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Proton Service Template Version (%s) status: %w", d.Id(), err)
+		}
+	}
+
+	return resourceServiceTemplateVersionRead(d, meta)
+}
+
+func resourceServiceTemplateVersionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ProtonConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	templateName, majorVersion, minorVersion, err := TemplateVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	version, err := FindServiceTemplateVersionByThreePartKey(conn, templateName, majorVersion, minorVersion)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Proton Service Template Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Proton Service Template Version (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(version.Arn)
+	d.Set("arn", arn)
+	if err := d.Set("compatible_environment_template", flattenCompatibleEnvironmentTemplates(version.CompatibleEnvironmentTemplates)); err != nil {
+		return fmt.Errorf("error setting compatible_environment_template: %w", err)
+	}
+	d.Set("description", version.Description)
+	d.Set("major_version", version.MajorVersion)
+	d.Set("minor_version", version.MinorVersion)
+	d.Set("recommended_minor_version", version.RecommendedMinorVersion)
+	d.Set("schema", version.Schema)
+	d.Set("status", version.Status)
+	d.Set("template_name", version.TemplateName)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Proton Service Template Version (%s): %w", arn, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceServiceTemplateVersionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ProtonConn
+
+	if d.HasChanges("compatible_environment_template", "description", "status") {
+		templateName, majorVersion, minorVersion, err := TemplateVersionParseResourceID(d.Id())
+
+		if err != nil {
+			return err
+		}
+
+		input := &proton.UpdateServiceTemplateVersionInput{
+			MajorVersion: aws.String(majorVersion),
+			MinorVersion: aws.String(minorVersion),
+			TemplateName: aws.String(templateName),
+		}
+
+		if d.HasChange("compatible_environment_template") {
+			input.CompatibleEnvironmentTemplates = expandCompatibleEnvironmentTemplateInputs(d.Get("compatible_environment_template").(*schema.Set).List())
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("status") {
+			input.Status = aws.String(d.Get("status").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Proton Service Template Version: %s", input)
+		_, err = conn.UpdateServiceTemplateVersion(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Proton Service Template Version (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Proton Service Template Version (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceServiceTemplateVersionRead(d, meta)
+}
+
+func resourceServiceTemplateVersionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ProtonConn
+
+	templateName, majorVersion, minorVersion, err := TemplateVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Proton Service Template Version: %s", d.Id())
+	_, err = conn.DeleteServiceTemplateVersion(&proton.DeleteServiceTemplateVersionInput{
+		MajorVersion: aws.String(majorVersion),
+		MinorVersion: aws.String(minorVersion),
+		TemplateName: aws.String(templateName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, proton.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Proton Service Template Version (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCompatibleEnvironmentTemplateInputs(tfList []interface{}) []*proton.CompatibleEnvironmentTemplateInput {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*proton.CompatibleEnvironmentTemplateInput
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &proton.CompatibleEnvironmentTemplateInput{}
+
+		if v, ok := tfMap["major_version"].(string); ok && v != "" {
+			apiObject.MajorVersion = aws.String(v)
+		}
+
+		if v, ok := tfMap["template_name"].(string); ok && v != "" {
+			apiObject.TemplateName = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenCompatibleEnvironmentTemplates(apiObjects []*proton.CompatibleEnvironmentTemplate) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.MajorVersion; v != nil {
+			tfMap["major_version"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.TemplateName; v != nil {
+			tfMap["template_name"] = aws.StringValue(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,152 @@
+package proton_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/proton"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfproton "github.com/hashicorp/terraform-provider-aws/internal/service/proton"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccProtonServiceTemplateVersion_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_proton_service_template_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, proton.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckServiceTemplateVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceTemplateVersionConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceTemplateVersionExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "proton", regexp.MustCompile(`service-template/.+:1\.0$`)),
+					resource.TestCheckResourceAttr(resourceName, "compatible_environment_template.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "compatible_environment_template.*.template_name", "aws_proton_environment_template.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "major_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "minor_version", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "schema"),
+					resource.TestCheckResourceAttr(resourceName, "status", proton.TemplateVersionStatusPublished),
+					resource.TestCheckResourceAttrPair(resourceName, "template_name", "aws_proton_service_template.test", "name"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source"},
+			},
+		},
+	})
+}
+
+func TestAccProtonServiceTemplateVersion_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_proton_service_template_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, proton.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckServiceTemplateVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceTemplateVersionConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceTemplateVersionExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfproton.ResourceServiceTemplateVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckServiceTemplateVersionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Proton Service Template Version ID is set")
+		}
+
+		templateName, majorVersion, minorVersion, err := tfproton.TemplateVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ProtonConn
+
+		_, err = tfproton.FindServiceTemplateVersionByThreePartKey(conn, templateName, majorVersion, minorVersion)
+
+		return err
+	}
+}
+
+func testAccCheckServiceTemplateVersionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ProtonConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_proton_service_template_version" {
+			continue
+		}
+
+		templateName, majorVersion, minorVersion, err := tfproton.TemplateVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfproton.FindServiceTemplateVersionByThreePartKey(conn, templateName, majorVersion, minorVersion)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Proton Service Template Version %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccServiceTemplateVersionConfig(rName string) string {
+	return acctest.ConfigCompose(testAccEnvironmentTemplateVersionConfigStatus(rName, "", proton.TemplateVersionStatusPublished), fmt.Sprintf(`
+resource "aws_proton_service_template" "test" {
+  name = %[1]q
+}
+
+resource "aws_proton_service_template_version" "test" {
+  template_name = aws_proton_service_template.test.name
+  status        = "PUBLISHED"
+
+  compatible_environment_template {
+    template_name = aws_proton_environment_template_version.test.template_name
+    major_version = aws_proton_environment_template_version.test.major_version
+  }
+
+  source {
+    s3 {
+      bucket = aws_s3_bucket_object.service_template.bucket
+      key    = aws_s3_bucket_object.service_template.key
+    }
+  }
+}
+`, rName))
+}
@@ -0,0 +1,163 @@
+package proton_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/proton"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfproton "github.com/hashicorp/terraform-provider-aws/internal/service/proton"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccProtonService_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_proton_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, proton.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfig(rName, "description 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "proton", regexp.MustCompile(`service/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", proton.ServiceStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "template_name", "aws_proton_service_template.test", "name"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template_major_version", "template_minor_version"},
+			},
+			{
+				Config: testAccServiceConfig(rName, "description 2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 2"),
+					resource.TestCheckResourceAttr(resourceName, "status", proton.ServiceStatusActive),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProtonService_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_proton_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, proton.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfig(rName, "description 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfproton.ResourceService(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckServiceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Proton Service ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ProtonConn
+
+		_, err := tfproton.FindServiceByName(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckServiceDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ProtonConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_proton_service" {
+			continue
+		}
+
+		_, err := tfproton.FindServiceByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Proton Service %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccServiceConfig(rName, description string) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig(rName, "tf-acc-test"), fmt.Sprintf(`
+resource "aws_proton_service_template" "test" {
+  name = %[1]q
+}
+
+resource "aws_proton_service_template_version" "test" {
+  template_name = aws_proton_service_template.test.name
+  status        = "PUBLISHED"
+
+  compatible_environment_template {
+    template_name = aws_proton_environment_template_version.test.template_name
+    major_version = aws_proton_environment_template_version.test.major_version
+  }
+
+  source {
+    s3 {
+      bucket = aws_s3_bucket_object.service_template.bucket
+      key    = aws_s3_bucket_object.service_template.key
+    }
+  }
+}
+
+resource "aws_proton_service" "test" {
+  name                   = %[1]q
+  description            = %[2]q
+  template_name          = aws_proton_service_template_version.test.template_name
+  template_major_version = aws_proton_service_template_version.test.major_version
+
+  spec = <<EOF
+proton: ServiceSpec
+
+instances:
+  - name: "instance-1"
+    environment: "${aws_proton_environment.test.name}"
+    spec:
+      queue_delay_seconds: 0
+EOF
+}
+`, rName, description))
+}
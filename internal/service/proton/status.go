@@ -0,0 +1,72 @@
+package proton
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/proton"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusEnvironmentDeployment(conn *proton.Proton, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindEnvironmentByName(conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.DeploymentStatus), nil
+	}
+}
+
+func statusEnvironmentTemplateVersion(conn *proton.Proton, templateName, majorVersion, minorVersion string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindEnvironmentTemplateVersionByThreePartKey(conn, templateName, majorVersion, minorVersion)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusService(conn *proton.Proton, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindServiceByName(conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusServiceTemplateVersion(conn *proton.Proton, templateName, majorVersion, minorVersion string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindServiceTemplateVersionByThreePartKey(conn, templateName, majorVersion, minorVersion)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
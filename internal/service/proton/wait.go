@@ -0,0 +1,156 @@
+package proton
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/proton"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	environmentCreatedTimeout = 60 * time.Minute
+	environmentUpdatedTimeout = 60 * time.Minute
+	environmentDeletedTimeout = 60 * time.Minute
+
+	serviceCreatedTimeout = 60 * time.Minute
+	serviceUpdatedTimeout = 60 * time.Minute
+	serviceDeletedTimeout = 60 * time.Minute
+
+	templateVersionRegisteredTimeout = 10 * time.Minute
+)
+
+func waitEnvironmentDeployed(conn *proton.Proton, name string, timeout time.Duration) (*proton.Environment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{proton.DeploymentStatusInProgress},
+		Target:  []string{proton.DeploymentStatusSucceeded},
+		Refresh: statusEnvironmentDeployment(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*proton.Environment); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.DeploymentStatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEnvironmentDeleted(conn *proton.Proton, name string, timeout time.Duration) (*proton.Environment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{proton.DeploymentStatusDeleteInProgress},
+		Target:  []string{},
+		Refresh: statusEnvironmentDeployment(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*proton.Environment); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.DeploymentStatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEnvironmentTemplateVersionRegistered(conn *proton.Proton, templateName, majorVersion, minorVersion string, timeout time.Duration) (*proton.EnvironmentTemplateVersion, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{proton.TemplateVersionStatusRegistrationInProgress},
+		Target:  []string{proton.TemplateVersionStatusDraft, proton.TemplateVersionStatusPublished},
+		Refresh: statusEnvironmentTemplateVersion(conn, templateName, majorVersion, minorVersion),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*proton.EnvironmentTemplateVersion); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitServiceCreated(conn *proton.Proton, name string, timeout time.Duration) (*proton.Service, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{proton.ServiceStatusCreateInProgress},
+		Target:  []string{proton.ServiceStatusActive},
+		Refresh: statusService(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*proton.Service); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitServiceUpdated(conn *proton.Proton, name string, timeout time.Duration) (*proton.Service, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{proton.ServiceStatusUpdateInProgress},
+		Target:  []string{proton.ServiceStatusActive},
+		Refresh: statusService(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*proton.Service); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitServiceDeleted(conn *proton.Proton, name string, timeout time.Duration) (*proton.Service, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{proton.ServiceStatusDeleteInProgress},
+		Target:  []string{},
+		Refresh: statusService(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*proton.Service); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitServiceTemplateVersionRegistered(conn *proton.Proton, templateName, majorVersion, minorVersion string, timeout time.Duration) (*proton.ServiceTemplateVersion, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{proton.TemplateVersionStatusRegistrationInProgress},
+		Target:  []string{proton.TemplateVersionStatusDraft, proton.TemplateVersionStatusPublished},
+		Refresh: statusServiceTemplateVersion(conn, templateName, majorVersion, minorVersion),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*proton.ServiceTemplateVersion); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
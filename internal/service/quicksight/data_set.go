@@ -0,0 +1,1079 @@
+package quicksight
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDataSet() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDataSetCreate,
+		ReadWithoutTimeout:   resourceDataSetRead,
+		UpdateWithoutTimeout: resourceDataSetUpdate,
+		DeleteWithoutTimeout: resourceDataSetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"aws_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+
+			"data_set_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"import_mode": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(quicksight.DataSetImportMode_Values(), false),
+			},
+
+			"logical_table_map": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				MaxItems: 64,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"logical_table_map_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"source": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"data_set_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"join_instruction": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"left_operand": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 64),
+												},
+												"on_clause": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+												"right_operand": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 64),
+												},
+												"type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(quicksight.JoinType_Values(), false),
+												},
+											},
+										},
+									},
+									"physical_table_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 64),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+
+			"permission": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MinItems: 1,
+				MaxItems: 64,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"actions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							MinItems: 1,
+							MaxItems: 16,
+						},
+						"principal": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+
+			"physical_table_map": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MaxItems: 32,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"custom_sql": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"columns": dataSetInputColumnsSchema(),
+									"data_source_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 64),
+									},
+									"sql_query": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 65536),
+									},
+								},
+							},
+						},
+						"physical_table_map_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"relational_table": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"catalog": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(0, 256),
+									},
+									"data_source_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"input_columns": dataSetInputColumnsSchema(),
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 64),
+									},
+									"schema": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"s3_source": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"data_source_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"input_columns": dataSetInputColumnsSchema(),
+									"upload_settings": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"contains_header": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Computed: true,
+												},
+												"delimiter": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.StringLenBetween(1, 1),
+												},
+												"format": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.StringInSlice(quicksight.FileFormat_Values(), false),
+												},
+												"start_from_row": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"text_qualifier": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.StringInSlice(quicksight.TextQualifier_Values(), false),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"row_level_permission_data_set": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"format_version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(quicksight.RowLevelPermissionFormatVersion_Values(), false),
+						},
+						"namespace": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 64),
+						},
+						"permission_policy": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(quicksight.RowLevelPermissionPolicy_Values(), false),
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(quicksight.Status_Values(), false),
+						},
+					},
+				},
+			},
+
+			"row_level_permission_tag_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(quicksight.Status_Values(), false),
+						},
+						"tag_rules": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 50,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"column_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"match_all_value": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+									"tag_key": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+									"tag_multi_value_delimiter": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 10),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"tags": tftags.TagsSchema(),
+
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func dataSetInputColumnsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		MaxItems: 2048,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+				"type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(quicksight.InputColumnDataType_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func resourceDataSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	awsAccountId := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountId = v.(string)
+	}
+	dataSetId := d.Get("data_set_id").(string)
+
+	input := &quicksight.CreateDataSetInput{
+		AwsAccountId:     aws.String(awsAccountId),
+		DataSetId:        aws.String(dataSetId),
+		ImportMode:       aws.String(d.Get("import_mode").(string)),
+		Name:             aws.String(d.Get("name").(string)),
+		PhysicalTableMap: expandDataSetPhysicalTableMap(d.Get("physical_table_map").(*schema.Set).List()),
+	}
+
+	if v, ok := d.GetOk("logical_table_map"); ok && v.(*schema.Set).Len() > 0 {
+		input.LogicalTableMap = expandDataSetLogicalTableMap(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("permission"); ok && v.(*schema.Set).Len() > 0 {
+		input.Permissions = expandQuickSightDataSourcePermissions(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("row_level_permission_data_set"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.RowLevelPermissionDataSet = expandDataSetRowLevelPermissionDataSet(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("row_level_permission_tag_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.RowLevelPermissionTagConfiguration = expandDataSetRowLevelPermissionTagConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating QuickSight Data Set: %s", input)
+	_, err := conn.CreateDataSetWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating QuickSight Data Set (%s): %s", dataSetId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", awsAccountId, dataSetId))
+
+	return resourceDataSetRead(ctx, d, meta)
+}
+
+func resourceDataSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	awsAccountId, dataSetId, err := ParseDataSetID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dataSet, err := FindDataSetByID(ctx, conn, awsAccountId, dataSetId)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] QuickSight Data Set (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading QuickSight Data Set (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", dataSet.Arn)
+	d.Set("aws_account_id", awsAccountId)
+	d.Set("data_set_id", dataSet.DataSetId)
+	d.Set("import_mode", dataSet.ImportMode)
+	d.Set("name", dataSet.Name)
+
+	if err := d.Set("logical_table_map", flattenDataSetLogicalTableMap(dataSet.LogicalTableMap)); err != nil {
+		return diag.Errorf("error setting logical_table_map: %s", err)
+	}
+
+	if err := d.Set("physical_table_map", flattenDataSetPhysicalTableMap(dataSet.PhysicalTableMap)); err != nil {
+		return diag.Errorf("error setting physical_table_map: %s", err)
+	}
+
+	if dataSet.RowLevelPermissionDataSet != nil {
+		if err := d.Set("row_level_permission_data_set", []interface{}{flattenDataSetRowLevelPermissionDataSet(dataSet.RowLevelPermissionDataSet)}); err != nil {
+			return diag.Errorf("error setting row_level_permission_data_set: %s", err)
+		}
+	} else {
+		d.Set("row_level_permission_data_set", nil)
+	}
+
+	if dataSet.RowLevelPermissionTagConfiguration != nil {
+		if err := d.Set("row_level_permission_tag_configuration", []interface{}{flattenDataSetRowLevelPermissionTagConfiguration(dataSet.RowLevelPermissionTagConfiguration)}); err != nil {
+			return diag.Errorf("error setting row_level_permission_tag_configuration: %s", err)
+		}
+	} else {
+		d.Set("row_level_permission_tag_configuration", nil)
+	}
+
+	tags, err := ListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("error listing tags for QuickSight Data Set (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	permsResp, err := conn.DescribeDataSetPermissionsWithContext(ctx, &quicksight.DescribeDataSetPermissionsInput{
+		AwsAccountId: aws.String(awsAccountId),
+		DataSetId:    aws.String(dataSetId),
+	})
+
+	if err != nil {
+		return diag.Errorf("error describing QuickSight Data Set (%s) Permissions: %s", d.Id(), err)
+	}
+
+	if err := d.Set("permission", flattenQuickSightPermissions(permsResp.Permissions)); err != nil {
+		return diag.Errorf("error setting permission: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDataSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+
+	awsAccountId, dataSetId, err := ParseDataSetID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChangesExcept("permission", "tags", "tags_all") {
+		// UpdateDataSet replaces the whole data set definition.
+		input := &quicksight.UpdateDataSetInput{
+			AwsAccountId:     aws.String(awsAccountId),
+			DataSetId:        aws.String(dataSetId),
+			ImportMode:       aws.String(d.Get("import_mode").(string)),
+			Name:             aws.String(d.Get("name").(string)),
+			PhysicalTableMap: expandDataSetPhysicalTableMap(d.Get("physical_table_map").(*schema.Set).List()),
+		}
+
+		if v, ok := d.GetOk("logical_table_map"); ok && v.(*schema.Set).Len() > 0 {
+			input.LogicalTableMap = expandDataSetLogicalTableMap(v.(*schema.Set).List())
+		}
+
+		if v, ok := d.GetOk("row_level_permission_data_set"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.RowLevelPermissionDataSet = expandDataSetRowLevelPermissionDataSet(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("row_level_permission_tag_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.RowLevelPermissionTagConfiguration = expandDataSetRowLevelPermissionTagConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating QuickSight Data Set: %s", input)
+		_, err := conn.UpdateDataSetWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating QuickSight Data Set (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("permission") {
+		oraw, nraw := d.GetChange("permission")
+		o := oraw.(*schema.Set).List()
+		n := nraw.(*schema.Set).List()
+
+		toGrant, toRevoke := DiffPermissions(o, n)
+
+		input := &quicksight.UpdateDataSetPermissionsInput{
+			AwsAccountId: aws.String(awsAccountId),
+			DataSetId:    aws.String(dataSetId),
+		}
+
+		if len(toGrant) > 0 {
+			input.GrantPermissions = toGrant
+		}
+
+		if len(toRevoke) > 0 {
+			input.RevokePermissions = toRevoke
+		}
+
+		_, err = conn.UpdateDataSetPermissionsWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating QuickSight Data Set (%s) permissions: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating QuickSight Data Set (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDataSetRead(ctx, d, meta)
+}
+
+func resourceDataSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+
+	awsAccountId, dataSetId, err := ParseDataSetID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting QuickSight Data Set: %s", d.Id())
+	_, err = conn.DeleteDataSetWithContext(ctx, &quicksight.DeleteDataSetInput{
+		AwsAccountId: aws.String(awsAccountId),
+		DataSetId:    aws.String(dataSetId),
+	})
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting QuickSight Data Set (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandDataSetPhysicalTableMap(tfList []interface{}) map[string]*quicksight.PhysicalTable {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]*quicksight.PhysicalTable)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &quicksight.PhysicalTable{}
+
+		if v, ok := tfMap["custom_sql"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.CustomSql = expandDataSetCustomSql(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["relational_table"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.RelationalTable = expandDataSetRelationalTable(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["s3_source"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.S3Source = expandDataSetS3Source(v[0].(map[string]interface{}))
+		}
+
+		apiObjects[tfMap["physical_table_map_id"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func expandDataSetCustomSql(tfMap map[string]interface{}) *quicksight.CustomSql {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &quicksight.CustomSql{}
+
+	if v, ok := tfMap["columns"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Columns = expandDataSetInputColumns(v)
+	}
+
+	if v, ok := tfMap["data_source_arn"].(string); ok && v != "" {
+		apiObject.DataSourceArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["sql_query"].(string); ok && v != "" {
+		apiObject.SqlQuery = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDataSetRelationalTable(tfMap map[string]interface{}) *quicksight.RelationalTable {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &quicksight.RelationalTable{}
+
+	if v, ok := tfMap["catalog"].(string); ok && v != "" {
+		apiObject.Catalog = aws.String(v)
+	}
+
+	if v, ok := tfMap["data_source_arn"].(string); ok && v != "" {
+		apiObject.DataSourceArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["input_columns"].([]interface{}); ok && len(v) > 0 {
+		apiObject.InputColumns = expandDataSetInputColumns(v)
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["schema"].(string); ok && v != "" {
+		apiObject.Schema = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDataSetS3Source(tfMap map[string]interface{}) *quicksight.S3Source {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &quicksight.S3Source{}
+
+	if v, ok := tfMap["data_source_arn"].(string); ok && v != "" {
+		apiObject.DataSourceArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["input_columns"].([]interface{}); ok && len(v) > 0 {
+		apiObject.InputColumns = expandDataSetInputColumns(v)
+	}
+
+	if v, ok := tfMap["upload_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.UploadSettings = expandDataSetUploadSettings(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandDataSetUploadSettings(tfMap map[string]interface{}) *quicksight.UploadSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &quicksight.UploadSettings{}
+
+	if v, ok := tfMap["contains_header"].(bool); ok {
+		apiObject.ContainsHeader = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["delimiter"].(string); ok && v != "" {
+		apiObject.Delimiter = aws.String(v)
+	}
+
+	if v, ok := tfMap["format"].(string); ok && v != "" {
+		apiObject.Format = aws.String(v)
+	}
+
+	if v, ok := tfMap["start_from_row"].(int); ok && v != 0 {
+		apiObject.StartFromRow = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["text_qualifier"].(string); ok && v != "" {
+		apiObject.TextQualifier = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDataSetInputColumns(tfList []interface{}) []*quicksight.InputColumn {
+	var apiObjects []*quicksight.InputColumn
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &quicksight.InputColumn{
+			Name: aws.String(tfMap["name"].(string)),
+			Type: aws.String(tfMap["type"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandDataSetLogicalTableMap(tfList []interface{}) map[string]*quicksight.LogicalTable {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]*quicksight.LogicalTable)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &quicksight.LogicalTable{
+			Alias: aws.String(tfMap["alias"].(string)),
+		}
+
+		if v, ok := tfMap["source"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Source = expandDataSetLogicalTableSource(v[0].(map[string]interface{}))
+		}
+
+		apiObjects[tfMap["logical_table_map_id"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func expandDataSetLogicalTableSource(tfMap map[string]interface{}) *quicksight.LogicalTableSource {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &quicksight.LogicalTableSource{}
+
+	if v, ok := tfMap["data_set_arn"].(string); ok && v != "" {
+		apiObject.DataSetArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["join_instruction"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.JoinInstruction = &quicksight.JoinInstruction{
+			LeftOperand:  aws.String(tfMap["left_operand"].(string)),
+			OnClause:     aws.String(tfMap["on_clause"].(string)),
+			RightOperand: aws.String(tfMap["right_operand"].(string)),
+			Type:         aws.String(tfMap["type"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["physical_table_id"].(string); ok && v != "" {
+		apiObject.PhysicalTableId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDataSetRowLevelPermissionDataSet(tfMap map[string]interface{}) *quicksight.RowLevelPermissionDataSet {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &quicksight.RowLevelPermissionDataSet{
+		Arn:              aws.String(tfMap["arn"].(string)),
+		PermissionPolicy: aws.String(tfMap["permission_policy"].(string)),
+	}
+
+	if v, ok := tfMap["format_version"].(string); ok && v != "" {
+		apiObject.FormatVersion = aws.String(v)
+	}
+
+	if v, ok := tfMap["namespace"].(string); ok && v != "" {
+		apiObject.Namespace = aws.String(v)
+	}
+
+	if v, ok := tfMap["status"].(string); ok && v != "" {
+		apiObject.Status = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDataSetRowLevelPermissionTagConfiguration(tfMap map[string]interface{}) *quicksight.RowLevelPermissionTagConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &quicksight.RowLevelPermissionTagConfiguration{}
+
+	if v, ok := tfMap["status"].(string); ok && v != "" {
+		apiObject.Status = aws.String(v)
+	}
+
+	if v, ok := tfMap["tag_rules"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			rule := &quicksight.RowLevelPermissionTagRule{
+				ColumnName: aws.String(tfMap["column_name"].(string)),
+				TagKey:     aws.String(tfMap["tag_key"].(string)),
+			}
+
+			if v, ok := tfMap["match_all_value"].(string); ok && v != "" {
+				rule.MatchAllValue = aws.String(v)
+			}
+
+			if v, ok := tfMap["tag_multi_value_delimiter"].(string); ok && v != "" {
+				rule.TagMultiValueDelimiter = aws.String(v)
+			}
+
+			apiObject.TagRules = append(apiObject.TagRules, rule)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenDataSetPhysicalTableMap(apiObjects map[string]*quicksight.PhysicalTable) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for id, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"physical_table_map_id": id,
+		}
+
+		if v := apiObject.CustomSql; v != nil {
+			tfMap["custom_sql"] = []interface{}{map[string]interface{}{
+				"columns":         flattenDataSetInputColumns(v.Columns),
+				"data_source_arn": aws.StringValue(v.DataSourceArn),
+				"name":            aws.StringValue(v.Name),
+				"sql_query":       aws.StringValue(v.SqlQuery),
+			}}
+		}
+
+		if v := apiObject.RelationalTable; v != nil {
+			tfMap["relational_table"] = []interface{}{map[string]interface{}{
+				"catalog":         aws.StringValue(v.Catalog),
+				"data_source_arn": aws.StringValue(v.DataSourceArn),
+				"input_columns":   flattenDataSetInputColumns(v.InputColumns),
+				"name":            aws.StringValue(v.Name),
+				"schema":          aws.StringValue(v.Schema),
+			}}
+		}
+
+		if v := apiObject.S3Source; v != nil {
+			s3Source := map[string]interface{}{
+				"data_source_arn": aws.StringValue(v.DataSourceArn),
+				"input_columns":   flattenDataSetInputColumns(v.InputColumns),
+			}
+
+			if v := v.UploadSettings; v != nil {
+				s3Source["upload_settings"] = []interface{}{map[string]interface{}{
+					"contains_header": aws.BoolValue(v.ContainsHeader),
+					"delimiter":       aws.StringValue(v.Delimiter),
+					"format":          aws.StringValue(v.Format),
+					"start_from_row":  aws.Int64Value(v.StartFromRow),
+					"text_qualifier":  aws.StringValue(v.TextQualifier),
+				}}
+			}
+
+			tfMap["s3_source"] = []interface{}{s3Source}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenDataSetInputColumns(apiObjects []*quicksight.InputColumn) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name": aws.StringValue(apiObject.Name),
+			"type": aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
+
+func flattenDataSetLogicalTableMap(apiObjects map[string]*quicksight.LogicalTable) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for id, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"alias":                aws.StringValue(apiObject.Alias),
+			"logical_table_map_id": id,
+		}
+
+		if v := apiObject.Source; v != nil {
+			source := map[string]interface{}{
+				"data_set_arn":      aws.StringValue(v.DataSetArn),
+				"physical_table_id": aws.StringValue(v.PhysicalTableId),
+			}
+
+			if v := v.JoinInstruction; v != nil {
+				source["join_instruction"] = []interface{}{map[string]interface{}{
+					"left_operand":  aws.StringValue(v.LeftOperand),
+					"on_clause":     aws.StringValue(v.OnClause),
+					"right_operand": aws.StringValue(v.RightOperand),
+					"type":          aws.StringValue(v.Type),
+				}}
+			}
+
+			tfMap["source"] = []interface{}{source}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenDataSetRowLevelPermissionDataSet(apiObject *quicksight.RowLevelPermissionDataSet) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"arn":               aws.StringValue(apiObject.Arn),
+		"format_version":    aws.StringValue(apiObject.FormatVersion),
+		"namespace":         aws.StringValue(apiObject.Namespace),
+		"permission_policy": aws.StringValue(apiObject.PermissionPolicy),
+		"status":            aws.StringValue(apiObject.Status),
+	}
+}
+
+func flattenDataSetRowLevelPermissionTagConfiguration(apiObject *quicksight.RowLevelPermissionTagConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var tagRules []interface{}
+
+	for _, v := range apiObject.TagRules {
+		if v == nil {
+			continue
+		}
+
+		tagRules = append(tagRules, map[string]interface{}{
+			"column_name":               aws.StringValue(v.ColumnName),
+			"match_all_value":           aws.StringValue(v.MatchAllValue),
+			"tag_key":                   aws.StringValue(v.TagKey),
+			"tag_multi_value_delimiter": aws.StringValue(v.TagMultiValueDelimiter),
+		})
+	}
+
+	return map[string]interface{}{
+		"status":    aws.StringValue(apiObject.Status),
+		"tag_rules": tagRules,
+	}
+}
+
+func ParseDataSetID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID/DATA_SET_ID", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,276 @@
+package quicksight_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfquicksight "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccQuickSightDataSet_basic(t *testing.T) {
+	var dataSet quicksight.DataSet
+	resourceName := "aws_quicksight_data_set.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		ErrorCheck:        acctest.ErrorCheck(t, quicksight.EndpointsID),
+		CheckDestroy:      testAccCheckQuickSightDataSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSetConfig(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDataSetExists(resourceName, &dataSet),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "quicksight", fmt.Sprintf("dataset/%s", rId)),
+					resource.TestCheckResourceAttr(resourceName, "data_set_id", rId),
+					resource.TestCheckResourceAttr(resourceName, "import_mode", quicksight.DataSetImportModeSpice),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "physical_table_map.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "physical_table_map.*", map[string]string{
+						"physical_table_map_id":            rId,
+						"s3_source.#":                      "1",
+						"s3_source.0.input_columns.#":      "1",
+						"s3_source.0.input_columns.0.name": "Column1",
+						"s3_source.0.input_columns.0.type": quicksight.InputColumnDataTypeString,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "row_level_permission_tag_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccQuickSightDataSet_disappears(t *testing.T) {
+	var dataSet quicksight.DataSet
+	resourceName := "aws_quicksight_data_set.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		ErrorCheck:        acctest.ErrorCheck(t, quicksight.EndpointsID),
+		CheckDestroy:      testAccCheckQuickSightDataSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSetConfig(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDataSetExists(resourceName, &dataSet),
+					acctest.CheckResourceDisappears(acctest.Provider, tfquicksight.ResourceDataSet(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccQuickSightDataSet_rowLevelPermissionTagConfiguration(t *testing.T) {
+	var dataSet quicksight.DataSet
+	resourceName := "aws_quicksight_data_set.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		ErrorCheck:        acctest.ErrorCheck(t, quicksight.EndpointsID),
+		CheckDestroy:      testAccCheckQuickSightDataSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSetConfigRowLevelPermissionTagConfiguration(rId, rName, quicksight.StatusEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDataSetExists(resourceName, &dataSet),
+					resource.TestCheckResourceAttr(resourceName, "row_level_permission_tag_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "row_level_permission_tag_configuration.0.status", quicksight.StatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "row_level_permission_tag_configuration.0.tag_rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "row_level_permission_tag_configuration.0.tag_rules.0.column_name", "Column1"),
+					resource.TestCheckResourceAttr(resourceName, "row_level_permission_tag_configuration.0.tag_rules.0.tag_key", "tagkey"),
+					resource.TestCheckResourceAttr(resourceName, "row_level_permission_tag_configuration.0.tag_rules.0.match_all_value", "*"),
+					resource.TestCheckResourceAttr(resourceName, "row_level_permission_tag_configuration.0.tag_rules.0.tag_multi_value_delimiter", ","),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDataSetConfigRowLevelPermissionTagConfiguration(rId, rName, quicksight.StatusDisabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDataSetExists(resourceName, &dataSet),
+					resource.TestCheckResourceAttr(resourceName, "row_level_permission_tag_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "row_level_permission_tag_configuration.0.status", quicksight.StatusDisabled),
+				),
+			},
+			{
+				Config: testAccDataSetConfig(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDataSetExists(resourceName, &dataSet),
+					resource.TestCheckResourceAttr(resourceName, "row_level_permission_tag_configuration.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckQuickSightDataSetExists(resourceName string, dataSet *quicksight.DataSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		awsAccountID, dataSetId, err := tfquicksight.ParseDataSetID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn
+
+		output, err := tfquicksight.FindDataSetByID(context.Background(), conn, awsAccountID, dataSetId)
+
+		if err != nil {
+			return err
+		}
+
+		*dataSet = *output
+
+		return nil
+	}
+}
+
+func testAccCheckQuickSightDataSetDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_quicksight_data_set" {
+			continue
+		}
+
+		awsAccountID, dataSetId, err := tfquicksight.ParseDataSetID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tfquicksight.FindDataSetByID(context.Background(), conn, awsAccountID, dataSetId)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("QuickSight Data Set (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccDataSetBaseConfig(rId, rName string) string {
+	return acctest.ConfigCompose(
+		testAccBaseDataSourceConfig(rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name           = %[2]q
+
+  parameters {
+    s3 {
+      manifest_file_location {
+        bucket = aws_s3_bucket.test.bucket
+        key    = aws_s3_bucket_object.test.key
+      }
+    }
+  }
+
+  type = "S3"
+}
+`, rId, rName))
+}
+
+func testAccDataSetConfig(rId, rName string) string {
+	return acctest.ConfigCompose(
+		testAccDataSetBaseConfig(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_data_set" "test" {
+  data_set_id = %[1]q
+  name        = %[2]q
+  import_mode = "SPICE"
+
+  physical_table_map {
+    physical_table_map_id = %[1]q
+
+    s3_source {
+      data_source_arn = aws_quicksight_data_source.test.arn
+
+      input_columns {
+        name = "Column1"
+        type = "STRING"
+      }
+
+      upload_settings {
+        format = "JSON"
+      }
+    }
+  }
+}
+`, rId, rName))
+}
+
+func testAccDataSetConfigRowLevelPermissionTagConfiguration(rId, rName, status string) string {
+	return acctest.ConfigCompose(
+		testAccDataSetBaseConfig(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_data_set" "test" {
+  data_set_id = %[1]q
+  name        = %[2]q
+  import_mode = "SPICE"
+
+  physical_table_map {
+    physical_table_map_id = %[1]q
+
+    s3_source {
+      data_source_arn = aws_quicksight_data_source.test.arn
+
+      input_columns {
+        name = "Column1"
+        type = "STRING"
+      }
+
+      upload_settings {
+        format = "JSON"
+      }
+    }
+  }
+
+  row_level_permission_tag_configuration {
+    status = %[3]q
+
+    tag_rules {
+      column_name               = "Column1"
+      tag_key                   = "tagkey"
+      match_all_value           = "*"
+      tag_multi_value_delimiter = ","
+    }
+  }
+}
+`, rId, rName, status))
+}
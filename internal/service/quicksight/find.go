@@ -1,8 +1,13 @@
 package quicksight
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func FindGroupMembership(conn *quicksight.QuickSight, listInput *quicksight.ListGroupMembershipsInput, userName string) (bool, error) {
@@ -31,3 +36,98 @@ func FindGroupMembership(conn *quicksight.QuickSight, listInput *quicksight.List
 
 	return found, nil
 }
+
+func FindDataSetByID(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, dataSetID string) (*quicksight.DataSet, error) {
+	input := &quicksight.DescribeDataSetInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DataSetId:    aws.String(dataSetID),
+	}
+
+	output, err := conn.DescribeDataSetWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DataSet == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DataSet, nil
+}
+
+func FindFolderByID(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, folderID string) (*quicksight.Folder, error) {
+	input := &quicksight.DescribeFolderInput{
+		AwsAccountId: aws.String(awsAccountID),
+		FolderId:     aws.String(folderID),
+	}
+
+	output, err := conn.DescribeFolderWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Folder == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Folder, nil
+}
+
+func FindFolderMembership(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, folderID, memberID string) (*quicksight.MemberIdArnPair, error) {
+	input := &quicksight.ListFolderMembersInput{
+		AwsAccountId: aws.String(awsAccountID),
+		FolderId:     aws.String(folderID),
+	}
+
+	var member *quicksight.MemberIdArnPair
+
+	for {
+		output, err := conn.ListFolderMembersWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+			return nil, &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range output.FolderMemberList {
+			if aws.StringValue(v.MemberId) == memberID {
+				member = v
+				break
+			}
+		}
+
+		if member != nil || output.NextToken == nil {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	if member == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return member, nil
+}
@@ -0,0 +1,158 @@
+package quicksight
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceFolderMembership() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFolderMembershipCreate,
+		ReadWithoutTimeout:   resourceFolderMembershipRead,
+		DeleteWithoutTimeout: resourceFolderMembershipDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"aws_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+
+			"folder_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"member_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"member_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"member_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(quicksight.MemberType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceFolderMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+
+	awsAccountId := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountId = v.(string)
+	}
+	folderId := d.Get("folder_id").(string)
+	memberType := d.Get("member_type").(string)
+	memberId := d.Get("member_id").(string)
+
+	input := &quicksight.CreateFolderMembershipInput{
+		AwsAccountId: aws.String(awsAccountId),
+		FolderId:     aws.String(folderId),
+		MemberId:     aws.String(memberId),
+		MemberType:   aws.String(memberType),
+	}
+
+	log.Printf("[DEBUG] Creating QuickSight Folder Membership: %s", input)
+	_, err := conn.CreateFolderMembershipWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error adding QuickSight %s (%s) to Folder (%s): %s", memberType, memberId, folderId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", awsAccountId, folderId, memberType, memberId))
+
+	return resourceFolderMembershipRead(ctx, d, meta)
+}
+
+func resourceFolderMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+
+	awsAccountId, folderId, memberType, memberId, err := FolderMembershipParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	member, err := FindFolderMembership(ctx, conn, awsAccountId, folderId, memberId)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] QuickSight Folder Membership (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading QuickSight Folder Membership (%s): %s", d.Id(), err)
+	}
+
+	d.Set("aws_account_id", awsAccountId)
+	d.Set("folder_id", folderId)
+	d.Set("member_arn", member.MemberArn)
+	d.Set("member_id", member.MemberId)
+	d.Set("member_type", memberType)
+
+	return nil
+}
+
+func resourceFolderMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+
+	awsAccountId, folderId, memberType, memberId, err := FolderMembershipParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting QuickSight Folder Membership: %s", d.Id())
+	_, err = conn.DeleteFolderMembershipWithContext(ctx, &quicksight.DeleteFolderMembershipInput{
+		AwsAccountId: aws.String(awsAccountId),
+		FolderId:     aws.String(folderId),
+		MemberId:     aws.String(memberId),
+		MemberType:   aws.String(memberType),
+	})
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting QuickSight Folder Membership (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FolderMembershipParseID(id string) (string, string, string, string, error) {
+	parts := strings.SplitN(id, "/", 4)
+	if len(parts) < 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[3] == "" {
+		return "", "", "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID/FOLDER_ID/MEMBER_TYPE/MEMBER_ID", id)
+	}
+	return parts[0], parts[1], parts[2], parts[3], nil
+}
@@ -0,0 +1,132 @@
+package quicksight_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfquicksight "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccQuickSightFolderMembership_basic(t *testing.T) {
+	resourceName := "aws_quicksight_folder_membership.test"
+	dataSetResourceName := "aws_quicksight_data_set.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		ErrorCheck:        acctest.ErrorCheck(t, quicksight.EndpointsID),
+		CheckDestroy:      testAccCheckQuickSightFolderMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFolderMembershipConfig(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightFolderMembershipExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "folder_id", rId),
+					resource.TestCheckResourceAttrPair(resourceName, "member_arn", dataSetResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "member_id", rId),
+					resource.TestCheckResourceAttr(resourceName, "member_type", quicksight.MemberTypeDataset),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccQuickSightFolderMembership_disappears(t *testing.T) {
+	resourceName := "aws_quicksight_folder_membership.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		ErrorCheck:        acctest.ErrorCheck(t, quicksight.EndpointsID),
+		CheckDestroy:      testAccCheckQuickSightFolderMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFolderMembershipConfig(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightFolderMembershipExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfquicksight.ResourceFolderMembership(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckQuickSightFolderMembershipExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		awsAccountID, folderId, _, memberId, err := tfquicksight.FolderMembershipParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn
+
+		_, err = tfquicksight.FindFolderMembership(context.Background(), conn, awsAccountID, folderId, memberId)
+
+		return err
+	}
+}
+
+func testAccCheckQuickSightFolderMembershipDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_quicksight_folder_membership" {
+			continue
+		}
+
+		awsAccountID, folderId, _, memberId, err := tfquicksight.FolderMembershipParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tfquicksight.FindFolderMembership(context.Background(), conn, awsAccountID, folderId, memberId)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("QuickSight Folder Membership (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccFolderMembershipConfig(rId, rName string) string {
+	return acctest.ConfigCompose(
+		testAccDataSetConfig(rId, rName),
+		testAccFolderConfig(rId, rName),
+		`
+resource "aws_quicksight_folder_membership" "test" {
+  folder_id   = aws_quicksight_folder.test.folder_id
+  member_type = "DATASET"
+  member_id   = aws_quicksight_data_set.test.data_set_id
+}
+`)
+}
@@ -0,0 +1,191 @@
+package quicksight_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfquicksight "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccQuickSightFolder_basic(t *testing.T) {
+	var folder quicksight.Folder
+	resourceName := "aws_quicksight_folder.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		ErrorCheck:        acctest.ErrorCheck(t, quicksight.EndpointsID),
+		CheckDestroy:      testAccCheckQuickSightFolderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFolderConfig(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightFolderExists(resourceName, &folder),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "quicksight", fmt.Sprintf("folder/%s", rId)),
+					resource.TestCheckResourceAttr(resourceName, "folder_id", rId),
+					resource.TestCheckResourceAttr(resourceName, "folder_path.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "folder_type", quicksight.FolderTypeShared),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "parent_folder_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFolderConfig(rId, rName+"-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightFolderExists(resourceName, &folder),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightFolder_disappears(t *testing.T) {
+	var folder quicksight.Folder
+	resourceName := "aws_quicksight_folder.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		ErrorCheck:        acctest.ErrorCheck(t, quicksight.EndpointsID),
+		CheckDestroy:      testAccCheckQuickSightFolderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFolderConfig(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightFolderExists(resourceName, &folder),
+					acctest.CheckResourceDisappears(acctest.Provider, tfquicksight.ResourceFolder(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccQuickSightFolder_parentFolder(t *testing.T) {
+	var folder quicksight.Folder
+	resourceName := "aws_quicksight_folder.test"
+	parentResourceName := "aws_quicksight_folder.parent"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		ErrorCheck:        acctest.ErrorCheck(t, quicksight.EndpointsID),
+		CheckDestroy:      testAccCheckQuickSightFolderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFolderParentFolderConfig(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightFolderExists(resourceName, &folder),
+					resource.TestCheckResourceAttr(resourceName, "folder_path.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "folder_path.0", parentResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "parent_folder_arn", parentResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckQuickSightFolderExists(resourceName string, folder *quicksight.Folder) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		awsAccountID, folderId, err := tfquicksight.ParseFolderID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn
+
+		output, err := tfquicksight.FindFolderByID(context.Background(), conn, awsAccountID, folderId)
+
+		if err != nil {
+			return err
+		}
+
+		*folder = *output
+
+		return nil
+	}
+}
+
+func testAccCheckQuickSightFolderDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_quicksight_folder" {
+			continue
+		}
+
+		awsAccountID, folderId, err := tfquicksight.ParseFolderID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tfquicksight.FindFolderByID(context.Background(), conn, awsAccountID, folderId)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("QuickSight Folder (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccFolderConfig(rId, rName string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_folder" "test" {
+  folder_id = %[1]q
+  name      = %[2]q
+}
+`, rId, rName)
+}
+
+func testAccFolderParentFolderConfig(rId, rName string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_folder" "parent" {
+  folder_id = "%[1]s-parent"
+  name      = "%[2]s-parent"
+}
+
+resource "aws_quicksight_folder" "test" {
+  folder_id         = %[1]q
+  name              = %[2]q
+  parent_folder_arn = aws_quicksight_folder.parent.arn
+}
+`, rId, rName)
+}
@@ -17,9 +17,22 @@ import (
 )
 
 func init() {
+	resource.AddTestSweepers("aws_quicksight_data_set", &resource.Sweeper{
+		Name: "aws_quicksight_data_set",
+		F:    sweepDataSets,
+	})
+
 	resource.AddTestSweepers("aws_quicksight_data_source", &resource.Sweeper{
 		Name: "aws_quicksight_data_source",
 		F:    sweepsDataSource,
+		Dependencies: []string{
+			"aws_quicksight_data_set",
+		},
+	})
+
+	resource.AddTestSweepers("aws_quicksight_folder", &resource.Sweeper{
+		Name: "aws_quicksight_folder",
+		F:    sweepFolders,
 	})
 }
 
@@ -78,3 +91,114 @@ func sweepsDataSource(region string) error {
 
 	return errs.ErrorOrNil()
 }
+
+func sweepDataSets(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).QuickSightConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	ctx := context.Background()
+	awsAccountId := client.(*conns.AWSClient).AccountID
+
+	input := &quicksight.ListDataSetsInput{
+		AwsAccountId: aws.String(awsAccountId),
+	}
+
+	err = conn.ListDataSetsPagesWithContext(ctx, input, func(page *quicksight.ListDataSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, ds := range page.DataSetSummaries {
+			if ds == nil {
+				continue
+			}
+
+			r := ResourceDataSet()
+			d := r.Data(nil)
+			d.SetId(fmt.Sprintf("%s/%s", awsAccountId, aws.StringValue(ds.DataSetId)))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing QuickSight Data Sets: %w", err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping QuickSight Data Sets for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping QuickSight Data Set sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepFolders(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).QuickSightConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	ctx := context.Background()
+	awsAccountId := client.(*conns.AWSClient).AccountID
+
+	input := &quicksight.ListFoldersInput{
+		AwsAccountId: aws.String(awsAccountId),
+	}
+
+	for {
+		output, err := conn.ListFoldersWithContext(ctx, input)
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing QuickSight Folders: %w", err))
+			break
+		}
+
+		for _, folder := range output.FolderSummaryList {
+			if folder == nil {
+				continue
+			}
+
+			r := ResourceFolder()
+			d := r.Data(nil)
+			d.SetId(fmt.Sprintf("%s/%s", awsAccountId, aws.StringValue(folder.FolderId)))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping QuickSight Folders for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping QuickSight Folder sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
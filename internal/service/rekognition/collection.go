@@ -0,0 +1,154 @@
+package rekognition
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCollection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCollectionCreate,
+		Read:   resourceCollectionRead,
+		Update: resourceCollectionUpdate,
+		Delete: resourceCollectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collection_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.\-]+$`), "must only include alphanumeric, underscore, period, or hyphen characters"),
+				),
+			},
+			"face_model_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceCollectionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RekognitionConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	collectionID := d.Get("collection_id").(string)
+	input := &rekognition.CreateCollectionInput{
+		CollectionId: aws.String(collectionID),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Rekognition Collection: %s", input)
+	_, err := conn.CreateCollection(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Rekognition Collection (%s): %w", collectionID, err)
+	}
+
+	d.SetId(collectionID)
+
+	return resourceCollectionRead(d, meta)
+}
+
+func resourceCollectionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RekognitionConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindCollectionByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Rekognition Collection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Rekognition Collection (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.CollectionARN)
+	d.Set("arn", arn)
+	d.Set("collection_id", d.Id())
+	d.Set("face_model_version", output.FaceModelVersion)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Rekognition Collection (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceCollectionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RekognitionConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Rekognition Collection (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceCollectionRead(d, meta)
+}
+
+func resourceCollectionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RekognitionConn
+
+	log.Printf("[DEBUG] Deleting Rekognition Collection: %s", d.Id())
+	_, err := conn.DeleteCollection(&rekognition.DeleteCollectionInput{
+		CollectionId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, rekognition.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Rekognition Collection (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
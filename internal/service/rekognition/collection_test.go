@@ -0,0 +1,186 @@
+package rekognition_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrekognition "github.com/hashicorp/terraform-provider-aws/internal/service/rekognition"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRekognitionCollection_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rekognition_collection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rekognition.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCollectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCollectionConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCollectionExists(resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "rekognition", fmt.Sprintf("collection/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "collection_id", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "face_model_version"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRekognitionCollection_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rekognition_collection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rekognition.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCollectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCollectionConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCollectionExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfrekognition.ResourceCollection(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRekognitionCollection_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rekognition_collection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rekognition.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCollectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCollectionTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCollectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCollectionTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCollectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccCollectionTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCollectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCollectionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Rekognition Collection ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RekognitionConn
+
+		_, err := tfrekognition.FindCollectionByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckCollectionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RekognitionConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_rekognition_collection" {
+			continue
+		}
+
+		_, err := tfrekognition.FindCollectionByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Rekognition Collection %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCollectionConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_rekognition_collection" "test" {
+  collection_id = %[1]q
+}
+`, rName)
+}
+
+func testAccCollectionTags1Config(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_rekognition_collection" "test" {
+  collection_id = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccCollectionTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_rekognition_collection" "test" {
+  collection_id = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
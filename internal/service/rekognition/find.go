@@ -0,0 +1,59 @@
+package rekognition
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindCollectionByID(conn *rekognition.Rekognition, id string) (*rekognition.DescribeCollectionOutput, error) {
+	input := &rekognition.DescribeCollectionInput{
+		CollectionId: aws.String(id),
+	}
+
+	output, err := conn.DescribeCollection(input)
+
+	if tfawserr.ErrCodeEquals(err, rekognition.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindStreamProcessorByName(conn *rekognition.Rekognition, name string) (*rekognition.DescribeStreamProcessorOutput, error) {
+	input := &rekognition.DescribeStreamProcessorInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.DescribeStreamProcessor(input)
+
+	if tfawserr.ErrCodeEquals(err, rekognition.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
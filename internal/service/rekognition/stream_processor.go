@@ -0,0 +1,355 @@
+package rekognition
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceStreamProcessor() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceStreamProcessorCreate,
+		Read:   resourceStreamProcessorRead,
+		Update: resourceStreamProcessorUpdate,
+		Delete: resourceStreamProcessorDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"input": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kinesis_video_stream_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.\-]+$`), "must only include alphanumeric, underscore, period, or hyphen characters"),
+				),
+			},
+			"output": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kinesis_data_stream_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"settings": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"face_search": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"collection_id": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"face_match_threshold": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.FloatBetween(0, 100),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceStreamProcessorCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RekognitionConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &rekognition.CreateStreamProcessorInput{
+		Input:    expandStreamProcessorInput(d.Get("input").([]interface{})),
+		Name:     aws.String(name),
+		Output:   expandStreamProcessorOutput(d.Get("output").([]interface{})),
+		RoleArn:  aws.String(d.Get("role_arn").(string)),
+		Settings: expandStreamProcessorSettings(d.Get("settings").([]interface{})),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Rekognition Stream Processor: %s", input)
+	_, err := conn.CreateStreamProcessor(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Rekognition Stream Processor (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceStreamProcessorRead(d, meta)
+}
+
+func resourceStreamProcessorRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RekognitionConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindStreamProcessorByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Rekognition Stream Processor (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Rekognition Stream Processor (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.StreamProcessorArn)
+	d.Set("arn", arn)
+
+	if err := d.Set("input", flattenStreamProcessorInput(output.Input)); err != nil {
+		return fmt.Errorf("error setting input: %w", err)
+	}
+
+	d.Set("name", output.Name)
+
+	if err := d.Set("output", flattenStreamProcessorOutput(output.Output)); err != nil {
+		return fmt.Errorf("error setting output: %w", err)
+	}
+
+	d.Set("role_arn", output.RoleArn)
+
+	if err := d.Set("settings", flattenStreamProcessorSettings(output.Settings)); err != nil {
+		return fmt.Errorf("error setting settings: %w", err)
+	}
+
+	d.Set("status", output.Status)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Rekognition Stream Processor (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceStreamProcessorUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RekognitionConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Rekognition Stream Processor (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceStreamProcessorRead(d, meta)
+}
+
+func resourceStreamProcessorDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RekognitionConn
+
+	// A running stream processor must be stopped before it can be deleted.
+	if status := d.Get("status").(string); status == rekognition.StreamProcessorStatusRunning || status == rekognition.StreamProcessorStatusStarting {
+		log.Printf("[DEBUG] Stopping Rekognition Stream Processor: %s", d.Id())
+		_, err := conn.StopStreamProcessor(&rekognition.StopStreamProcessorInput{
+			Name: aws.String(d.Id()),
+		})
+
+		if tfawserr.ErrCodeEquals(err, rekognition.ErrCodeResourceNotFoundException) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error stopping Rekognition Stream Processor (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitStreamProcessorStopped(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for Rekognition Stream Processor (%s) stop: %w", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Rekognition Stream Processor: %s", d.Id())
+	_, err := conn.DeleteStreamProcessor(&rekognition.DeleteStreamProcessorInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, rekognition.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Rekognition Stream Processor (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitStreamProcessorDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Rekognition Stream Processor (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandStreamProcessorInput(tfList []interface{}) *rekognition.StreamProcessorInput {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &rekognition.StreamProcessorInput{
+		KinesisVideoStream: &rekognition.KinesisVideoStream{
+			Arn: aws.String(tfMap["kinesis_video_stream_arn"].(string)),
+		},
+	}
+}
+
+func expandStreamProcessorOutput(tfList []interface{}) *rekognition.StreamProcessorOutput {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &rekognition.StreamProcessorOutput{
+		KinesisDataStream: &rekognition.KinesisDataStream{
+			Arn: aws.String(tfMap["kinesis_data_stream_arn"].(string)),
+		},
+	}
+}
+
+func expandStreamProcessorSettings(tfList []interface{}) *rekognition.StreamProcessorSettings {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &rekognition.StreamProcessorSettings{}
+
+	if v, ok := tfMap["face_search"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		faceSearch := v[0].(map[string]interface{})
+		apiObject.FaceSearch = &rekognition.FaceSearchSettings{
+			CollectionId: aws.String(faceSearch["collection_id"].(string)),
+		}
+
+		if v, ok := faceSearch["face_match_threshold"].(float64); ok && v != 0 {
+			apiObject.FaceSearch.FaceMatchThreshold = aws.Float64(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenStreamProcessorInput(apiObject *rekognition.StreamProcessorInput) []interface{} {
+	if apiObject == nil || apiObject.KinesisVideoStream == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"kinesis_video_stream_arn": aws.StringValue(apiObject.KinesisVideoStream.Arn),
+	}}
+}
+
+func flattenStreamProcessorOutput(apiObject *rekognition.StreamProcessorOutput) []interface{} {
+	if apiObject == nil || apiObject.KinesisDataStream == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"kinesis_data_stream_arn": aws.StringValue(apiObject.KinesisDataStream.Arn),
+	}}
+}
+
+func flattenStreamProcessorSettings(apiObject *rekognition.StreamProcessorSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.FaceSearch; v != nil {
+		tfMap["face_search"] = []interface{}{map[string]interface{}{
+			"collection_id":        aws.StringValue(v.CollectionId),
+			"face_match_threshold": aws.Float64Value(v.FaceMatchThreshold),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
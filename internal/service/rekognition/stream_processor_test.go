@@ -0,0 +1,191 @@
+package rekognition_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrekognition "github.com/hashicorp/terraform-provider-aws/internal/service/rekognition"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRekognitionStreamProcessor_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rekognition_stream_processor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rekognition.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckStreamProcessorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStreamProcessorConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStreamProcessorExists(resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "rekognition", fmt.Sprintf("streamprocessor/%s", rName)),
+					resource.TestCheckResourceAttrPair(resourceName, "input.0.kinesis_video_stream_arn", "aws_kinesis_video_stream.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "output.0.kinesis_data_stream_arn", "aws_kinesis_stream.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "settings.0.face_search.0.collection_id", "aws_rekognition_collection.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.face_search.0.face_match_threshold", "85.5"),
+					resource.TestCheckResourceAttr(resourceName, "status", rekognition.StreamProcessorStatusStopped),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRekognitionStreamProcessor_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rekognition_stream_processor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rekognition.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckStreamProcessorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStreamProcessorConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStreamProcessorExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfrekognition.ResourceStreamProcessor(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckStreamProcessorExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Rekognition Stream Processor ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RekognitionConn
+
+		_, err := tfrekognition.FindStreamProcessorByName(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckStreamProcessorDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RekognitionConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_rekognition_stream_processor" {
+			continue
+		}
+
+		_, err := tfrekognition.FindStreamProcessorByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Rekognition Stream Processor %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccStreamProcessorConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_rekognition_collection" "test" {
+  collection_id = %[1]q
+}
+
+resource "aws_kinesis_video_stream" "test" {
+  name = %[1]q
+}
+
+resource "aws_kinesis_stream" "test" {
+  name        = %[1]q
+  shard_count = 1
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"Service": "rekognition.${data.aws_partition.current.dns_suffix}"},
+    "Action": "sts:AssumeRole"
+  }]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  role = aws_iam_role.test.id
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": ["kinesis:PutRecord", "kinesis:PutRecords"],
+      "Resource": "${aws_kinesis_stream.test.arn}"
+    },
+    {
+      "Effect": "Allow",
+      "Action": ["kinesisvideo:GetDataEndpoint", "kinesisvideo:GetMedia"],
+      "Resource": "${aws_kinesis_video_stream.test.arn}"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_rekognition_stream_processor" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  input {
+    kinesis_video_stream_arn = aws_kinesis_video_stream.test.arn
+  }
+
+  output {
+    kinesis_data_stream_arn = aws_kinesis_stream.test.arn
+  }
+
+  settings {
+    face_search {
+      collection_id        = aws_rekognition_collection.test.id
+      face_match_threshold = 85.5
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName)
+}
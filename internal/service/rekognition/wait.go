@@ -0,0 +1,47 @@
+package rekognition
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	streamProcessorDeletedTimeout = 5 * time.Minute
+	streamProcessorStoppedTimeout = 5 * time.Minute
+)
+
+func waitStreamProcessorStopped(conn *rekognition.Rekognition, name string) (*rekognition.DescribeStreamProcessorOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{rekognition.StreamProcessorStatusStopping},
+		Target:  []string{rekognition.StreamProcessorStatusStopped, rekognition.StreamProcessorStatusFailed},
+		Timeout: streamProcessorStoppedTimeout,
+		Refresh: statusStreamProcessor(conn, name),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rekognition.DescribeStreamProcessorOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitStreamProcessorDeleted(conn *rekognition.Rekognition, name string) (*rekognition.DescribeStreamProcessorOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: rekognition.StreamProcessorStatus_Values(),
+		Target:  []string{},
+		Timeout: streamProcessorDeletedTimeout,
+		Refresh: statusStreamProcessor(conn, name),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rekognition.DescribeStreamProcessorOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
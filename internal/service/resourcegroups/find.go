@@ -0,0 +1,36 @@
+package resourcegroups
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroups"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func FindGroupConfigurationByGroupName(conn *resourcegroups.ResourceGroups, groupName string) (*resourcegroups.GroupConfiguration, error) {
+	input := &resourcegroups.GetGroupConfigurationInput{
+		Group: aws.String(groupName),
+	}
+
+	output, err := conn.GetGroupConfiguration(input)
+
+	if tfawserr.ErrCodeEquals(err, resourcegroups.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.GroupConfiguration == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.GroupConfiguration, nil
+}
@@ -10,7 +10,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -37,9 +39,41 @@ func ResourceGroup() *schema.Resource {
 				Optional: true,
 			},
 
+			"configuration": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"parameters": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+
+									"values": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"resource_query": {
 				Type:     schema.TypeList,
-				Required: true,
+				Optional: true,
 				MinItems: 1,
 				MaxItems: 1,
 				Elem: &schema.Resource{
@@ -88,10 +122,19 @@ func resourceGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
 	input := resourcegroups.CreateGroupInput{
-		Description:   aws.String(d.Get("description").(string)),
-		Name:          aws.String(d.Get("name").(string)),
-		ResourceQuery: extractResourceGroupResourceQuery(d.Get("resource_query").([]interface{})),
-		Tags:          Tags(tags.IgnoreAWS()),
+		Description: aws.String(d.Get("description").(string)),
+		Name:        aws.String(d.Get("name").(string)),
+		Tags:        Tags(tags.IgnoreAWS()),
+	}
+
+	waitForConfigurationAttached := false
+	if v, ok := d.GetOk("configuration"); ok && v.(*schema.Set).Len() > 0 {
+		input.Configuration = expandResourceGroupConfigurationItems(v.(*schema.Set).List())
+		waitForConfigurationAttached = true
+	}
+
+	if v, ok := d.GetOk("resource_query"); ok && len(v.([]interface{})) > 0 {
+		input.ResourceQuery = extractResourceGroupResourceQuery(v.([]interface{}))
 	}
 
 	res, err := conn.CreateGroup(&input)
@@ -101,6 +144,12 @@ func resourceGroupCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(aws.StringValue(res.Group.Name))
 
+	if waitForConfigurationAttached {
+		if _, err := waitGroupConfigurationUpdated(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for resource group (%s) configuration update: %w", d.Id(), err)
+		}
+	}
+
 	return resourceGroupRead(d, meta)
 }
 
@@ -132,15 +181,38 @@ func resourceGroupRead(d *schema.ResourceData, meta interface{}) error {
 		GroupName: aws.String(d.Id()),
 	})
 
+	// Configuration-based groups (e.g. capacity reservation pools) have no resource query.
+	isConfigurationGroup := false
 	if err != nil {
-		return fmt.Errorf("error reading resource query for resource group (%s): %s", d.Id(), err)
+		if tfawserr.ErrMessageContains(err, resourcegroups.ErrCodeBadRequestException, "only supported for tag-based") {
+			isConfigurationGroup = true
+		} else {
+			return fmt.Errorf("error reading resource query for resource group (%s): %s", d.Id(), err)
+		}
 	}
 
-	resultQuery := map[string]interface{}{}
-	resultQuery["query"] = aws.StringValue(q.GroupQuery.ResourceQuery.Query)
-	resultQuery["type"] = aws.StringValue(q.GroupQuery.ResourceQuery.Type)
-	if err := d.Set("resource_query", []map[string]interface{}{resultQuery}); err != nil {
-		return fmt.Errorf("error setting resource_query: %s", err)
+	if !isConfigurationGroup {
+		resultQuery := map[string]interface{}{}
+		resultQuery["query"] = aws.StringValue(q.GroupQuery.ResourceQuery.Query)
+		resultQuery["type"] = aws.StringValue(q.GroupQuery.ResourceQuery.Type)
+		if err := d.Set("resource_query", []map[string]interface{}{resultQuery}); err != nil {
+			return fmt.Errorf("error setting resource_query: %s", err)
+		}
+	}
+
+	groupCfg, err := FindGroupConfigurationByGroupName(conn, d.Id())
+
+	if err != nil && !tfresource.NotFound(err) {
+		return fmt.Errorf("error reading configuration for resource group (%s): %w", d.Id(), err)
+	}
+
+	var configuration []*resourcegroups.GroupConfigurationItem
+	if groupCfg != nil {
+		configuration = groupCfg.Configuration
+	}
+
+	if err := d.Set("configuration", flattenResourceGroupConfigurationItems(configuration)); err != nil {
+		return fmt.Errorf("error setting configuration: %w", err)
 	}
 
 	tags, err := ListTags(conn, arn)
@@ -188,6 +260,22 @@ func resourceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("configuration") {
+		input := &resourcegroups.PutGroupConfigurationInput{
+			Configuration: expandResourceGroupConfigurationItems(d.Get("configuration").(*schema.Set).List()),
+			Group:         aws.String(d.Id()),
+		}
+
+		_, err := conn.PutGroupConfiguration(input)
+		if err != nil {
+			return fmt.Errorf("error updating configuration for resource group (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitGroupConfigurationUpdated(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for resource group (%s) configuration update: %w", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
@@ -212,3 +300,82 @@ func resourceGroupDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+func expandResourceGroupConfigurationItems(tfList []interface{}) []*resourcegroups.GroupConfigurationItem {
+	var apiObjects []*resourcegroups.GroupConfigurationItem
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &resourcegroups.GroupConfigurationItem{
+			Type: aws.String(tfMap["type"].(string)),
+		}
+
+		if v, ok := tfMap["parameters"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Parameters = expandResourceGroupConfigurationParameters(v.List())
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandResourceGroupConfigurationParameters(tfList []interface{}) []*resourcegroups.GroupConfigurationParameter {
+	var apiObjects []*resourcegroups.GroupConfigurationParameter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &resourcegroups.GroupConfigurationParameter{
+			Name:   aws.String(tfMap["name"].(string)),
+			Values: flex.ExpandStringList(tfMap["values"].([]interface{})),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenResourceGroupConfigurationItems(apiObjects []*resourcegroups.GroupConfigurationItem) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"type":       aws.StringValue(apiObject.Type),
+			"parameters": flattenResourceGroupConfigurationParameters(apiObject.Parameters),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenResourceGroupConfigurationParameters(apiObjects []*resourcegroups.GroupConfigurationParameter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":   aws.StringValue(apiObject.Name),
+			"values": aws.StringValueSlice(apiObject.Values),
+		})
+	}
+
+	return tfList
+}
@@ -114,6 +114,46 @@ func TestAccResourceGroupsGroup_Resource_tags(t *testing.T) {
 	})
 }
 
+func TestAccResourceGroupsGroup_Resource_configuration(t *testing.T) {
+	var v resourcegroups.Group
+	resourceName := "aws_resourcegroups_group.test"
+	n := fmt.Sprintf("test-group-%d", sdkacctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, resourcegroups.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckResourceGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceGroupConfigurationConfig(n),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", n),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "configuration.*", map[string]string{
+						"type":         "AWS::EC2::CapacityReservationPool",
+						"parameters.#": "0",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "configuration.*", map[string]string{
+						"type":                  "AWS::ResourceGroups::Generic",
+						"parameters.#":          "1",
+						"parameters.*.name":     "allowed-resource-types",
+						"parameters.*.values.#": "1",
+						"parameters.*.values.0": "AWS::EC2::CapacityReservation",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "resource_query.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckResourceGroupExists(n string, v *resourcegroups.Group) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -241,3 +281,26 @@ JSON
 }
 `, rName, desc, query, tag1Key, tag1Value, tag2Key, tag2Value)
 }
+
+func testAccResourceGroupConfigurationConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_resourcegroups_group" "test" {
+  name = %[1]q
+
+  configuration {
+    type = "AWS::EC2::CapacityReservationPool"
+  }
+
+  configuration {
+    type = "AWS::ResourceGroups::Generic"
+
+    parameters {
+      name = "allowed-resource-types"
+      values = [
+        "AWS::EC2::CapacityReservation",
+      ]
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,24 @@
+package resourcegroups
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroups"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusGroupConfiguration(conn *resourcegroups.ResourceGroups, groupName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindGroupConfigurationByGroupName(conn, groupName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -0,0 +1,36 @@
+package resourcegroups
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroups"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	groupConfigurationUpdatedTimeout = 5 * time.Minute
+)
+
+func waitGroupConfigurationUpdated(conn *resourcegroups.ResourceGroups, groupName string) (*resourcegroups.GroupConfiguration, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{resourcegroups.GroupConfigurationStatusUpdating},
+		Target:  []string{resourcegroups.GroupConfigurationStatusUpdateComplete},
+		Refresh: statusGroupConfiguration(conn, groupName),
+		Timeout: groupConfigurationUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*resourcegroups.GroupConfiguration); ok {
+		if status := aws.StringValue(output.Status); status == resourcegroups.GroupConfigurationStatusUpdateFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
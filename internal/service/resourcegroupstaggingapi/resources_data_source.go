@@ -87,6 +87,11 @@ func DataSourceResources() *schema.Resource {
 								},
 							},
 						},
+						"missing_default_tag_keys": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
 						"tags": tftags.TagsSchemaComputed(),
 					},
 				},
@@ -97,6 +102,7 @@ func DataSourceResources() *schema.Resource {
 
 func dataSourceResourcesRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 
 	input := &resourcegroupstaggingapi.GetResourcesInput{}
 
@@ -136,7 +142,7 @@ func dataSourceResourcesRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(meta.(*conns.AWSClient).Partition)
 
-	if err := d.Set("resource_tag_mapping_list", flattenResourcesTagMappingList(taggings)); err != nil {
+	if err := d.Set("resource_tag_mapping_list", flattenResourcesTagMappingList(taggings, defaultTagsConfig)); err != nil {
 		return fmt.Errorf("error setting resource tag mapping list: %w", err)
 	}
 
@@ -161,13 +167,20 @@ func expandTagFilters(filters []interface{}) []*resourcegroupstaggingapi.TagFilt
 	return result
 }
 
-func flattenResourcesTagMappingList(list []*resourcegroupstaggingapi.ResourceTagMapping) []map[string]interface{} {
+func flattenResourcesTagMappingList(list []*resourcegroupstaggingapi.ResourceTagMapping, defaultTagsConfig *tftags.DefaultConfig) []map[string]interface{} {
 	result := make([]map[string]interface{}, 0, len(list))
 
 	for _, i := range list {
+		tags := KeyValueTags(i.Tags)
 		l := map[string]interface{}{
-			"resource_arn": aws.StringValue(i.ResourceARN),
-			"tags":         KeyValueTags(i.Tags).Map(),
+			"resource_arn":             aws.StringValue(i.ResourceARN),
+			"tags":                     tags.Map(),
+			"missing_default_tag_keys": []string{},
+		}
+
+		// Report the provider default_tags keys that are absent from the resource.
+		if defaultTagsConfig != nil && len(defaultTagsConfig.Tags) > 0 {
+			l["missing_default_tag_keys"] = defaultTagsConfig.Tags.Removed(tags).Keys()
 		}
 
 		if i.ComplianceDetails != nil {
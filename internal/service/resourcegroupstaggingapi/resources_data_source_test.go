@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
 
@@ -90,9 +91,47 @@ func TestAccResourceGroupsTaggingAPIResourcesDataSource_resourceARNList(t *testi
 				Config: testAccResourcesResourceARNListDataSourceConfig(rName),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "resource_tag_mapping_list.*", map[string]string{
-						"tags.Key": rName,
+						"missing_default_tag_keys.#": "0",
+						"tags.Key":                   rName,
+					}),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "resource_tag_mapping_list.*.resource_arn", resourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupsTaggingAPIResourcesDataSource_missingDefaultTagKeys(t *testing.T) {
+	var providers []*schema.Provider
+	dataSourceName := "data.aws_resourcegroupstaggingapi_resources.test"
+	resourceName := "aws_vpc.test"
+	untaggedResourceName := "aws_vpc.untagged"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, resourcegroupstaggingapi.EndpointsID),
+		ProviderFactories: acctest.FactoriesInternal(&providers),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					testAccResourcesMissingDefaultTagKeysDataSourceConfig(rName),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resource_tag_mapping_list.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "resource_tag_mapping_list.*", map[string]string{
+						"missing_default_tag_keys.#": "0",
+						"tags.Name":                  rName,
+						"tags.providerkey1":          "providervalue1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "resource_tag_mapping_list.*", map[string]string{
+						"missing_default_tag_keys.#": "1",
+						"missing_default_tag_keys.0": "providerkey1",
+						"tags.Name":                  rName,
 					}),
 					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "resource_tag_mapping_list.*.resource_arn", resourceName, "arn"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "resource_tag_mapping_list.*.resource_arn", untaggedResourceName, "arn"),
 				),
 			},
 		},
@@ -169,3 +208,34 @@ data "aws_resourcegroupstaggingapi_resources" "test" {
 }
 `, rName)
 }
+
+func testAccResourcesMissingDefaultTagKeysDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+# Resources created through this provider configuration do not receive the default tags.
+provider "aws" {
+  alias = "untagged"
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "untagged" {
+  provider = aws.untagged
+
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_resourcegroupstaggingapi_resources" "test" {
+  resource_arn_list = [aws_vpc.test.arn, aws_vpc.untagged.arn]
+}
+`, rName)
+}
@@ -1,6 +1,7 @@
 package signer
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -9,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/signer"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
@@ -17,11 +19,17 @@ func ResourceSigningJob() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSigningJobCreate,
 		Read:   resourceSigningJobRead,
+		Update: resourceSigningJobUpdate,
 		Delete: schema.Noop,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		// A revoked signature cannot be reinstated, so removing or changing the reason requires a new signing job.
+		CustomizeDiff: customdiff.ForceNewIfChange("revocation_reason", func(_ context.Context, old, new, meta interface{}) bool {
+			return old.(string) != ""
+		}),
+
 		Schema: map[string]*schema.Schema{
 			"profile_name": {
 				Type:     schema.TypeString,
@@ -99,6 +107,10 @@ func ResourceSigningJob() *schema.Resource {
 				ForceNew: true,
 				Default:  false,
 			},
+			"revocation_reason": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"completed_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -228,9 +240,41 @@ func resourceSigningJobCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(jobId)
 
+	if v, ok := d.GetOk("revocation_reason"); ok {
+		if err := revokeSignerSigningJobSignature(conn, d.Id(), v.(string)); err != nil {
+			return err
+		}
+	}
+
 	return resourceSigningJobRead(d, meta)
 }
 
+func resourceSigningJobUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SignerConn
+
+	if d.HasChange("revocation_reason") {
+		if err := revokeSignerSigningJobSignature(conn, d.Id(), d.Get("revocation_reason").(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceSigningJobRead(d, meta)
+}
+
+func revokeSignerSigningJobSignature(conn *signer.Signer, jobID, reason string) error {
+	log.Printf("[DEBUG] Revoking Signer Signing Job (%s) signature", jobID)
+	_, err := conn.RevokeSignature(&signer.RevokeSignatureInput{
+		JobId:  aws.String(jobID),
+		Reason: aws.String(reason),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error revoking Signer Signing Job (%s) signature: %w", jobID, err)
+	}
+
+	return nil
+}
+
 func resourceSigningJobRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).SignerConn
 	jobId := d.Id()
@@ -293,6 +337,10 @@ func resourceSigningJobRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting signer signing job revocation record: %s", err)
 	}
 
+	if v := describeSigningJobOutput.RevocationRecord; v != nil {
+		d.Set("revocation_reason", v.Reason)
+	}
+
 	signatureExpiresAt := ""
 	if describeSigningJobOutput.SignatureExpiresAt != nil {
 		signatureExpiresAt = aws.TimeValue(describeSigningJobOutput.SignatureExpiresAt).Format(time.RFC3339)
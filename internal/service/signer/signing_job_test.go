@@ -42,7 +42,41 @@ func TestAccSignerSigningJob_basic(t *testing.T) {
 
 }
 
-func testAccSigningJobConfig(rName string) string {
+func TestAccSignerSigningJob_revocationReason(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_signer_signing_job.test"
+
+	var job signer.DescribeSigningJobOutput
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckSingerSigningProfile(t, "AWSLambda-SHA384-ECDSA") },
+		ErrorCheck:   acctest.ErrorCheck(t, signer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSigningJobConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSigningJobExists(resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "revocation_reason", ""),
+					resource.TestCheckResourceAttr(resourceName, "revocation_record.#", "0"),
+				),
+			},
+			{
+				Config: testAccSigningJobRevocationReasonConfig(rName, "compromised"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSigningJobExists(resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "revocation_reason", "compromised"),
+					resource.TestCheckResourceAttr(resourceName, "revocation_record.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "revocation_record.0.reason", "compromised"),
+					resource.TestCheckResourceAttrSet(resourceName, "revocation_record.0.revoked_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSigningJobBaseConfig(rName string) string {
 	return fmt.Sprintf(`
 data "aws_caller_identity" "current" {}
 
@@ -70,7 +104,11 @@ resource "aws_s3_bucket_object" "source" {
   key    = "lambdatest.zip"
   source = "test-fixtures/lambdatest.zip"
 }
+`, rName)
+}
 
+func testAccSigningJobConfig(rName string) string {
+	return acctest.ConfigCompose(testAccSigningJobBaseConfig(rName), `
 resource "aws_signer_signing_job" "test" {
   profile_name = aws_signer_signing_profile.test.name
 
@@ -88,7 +126,30 @@ resource "aws_signer_signing_job" "test" {
     }
   }
 }
-`, rName)
+`)
+}
+
+func testAccSigningJobRevocationReasonConfig(rName, reason string) string {
+	return acctest.ConfigCompose(testAccSigningJobBaseConfig(rName), fmt.Sprintf(`
+resource "aws_signer_signing_job" "test" {
+  profile_name      = aws_signer_signing_profile.test.name
+  revocation_reason = %[1]q
+
+  source {
+    s3 {
+      bucket  = aws_s3_bucket_object.source.bucket
+      key     = aws_s3_bucket_object.source.key
+      version = aws_s3_bucket_object.source.version_id
+    }
+  }
+
+  destination {
+    s3 {
+      bucket = aws_s3_bucket.destination.bucket
+    }
+  }
+}
+`, reason))
 }
 
 func testAccCheckSigningJobExists(res string, job *signer.DescribeSigningJobOutput) resource.TestCheckFunc {
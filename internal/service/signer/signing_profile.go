@@ -1,6 +1,7 @@
 package signer
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -10,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/signer"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -75,6 +77,41 @@ func ResourceSigningProfile() *schema.Resource {
 					},
 				},
 			},
+			"signing_platform_overrides": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"signing_configuration": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"encryption_algorithm": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"hash_algorithm": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"signing_image_format": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 			"arn": {
@@ -119,7 +156,10 @@ func ResourceSigningProfile() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceSigningProfileCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -146,6 +186,10 @@ func resourceSigningProfileCreate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	if v, ok := d.GetOk("signing_platform_overrides"); ok && len(v.([]interface{})) > 0 {
+		signingProfileInput.Overrides = expandSignerSigningPlatformOverrides(v.([]interface{}))
+	}
+
 	if len(tags) > 0 {
 		signingProfileInput.Tags = Tags(tags.IgnoreAWS())
 	}
@@ -192,6 +236,10 @@ func resourceSigningProfileRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error setting signer signing profile signature validity period: %s", err)
 	}
 
+	if err := d.Set("signing_platform_overrides", flattenSignerSigningPlatformOverrides(signingProfileOutput.Overrides)); err != nil {
+		return fmt.Errorf("error setting signer signing profile signing platform overrides: %s", err)
+	}
+
 	if err := d.Set("platform_display_name", signingProfileOutput.PlatformDisplayName); err != nil {
 		return fmt.Errorf("error setting signer signing profile platform display name: %s", err)
 	}
@@ -288,3 +336,115 @@ func flattenSignerSigningProfileRevocationRecord(apiObject *signer.SigningProfil
 
 	return []interface{}{tfMap}
 }
+
+// resourceSigningProfileCustomizeDiff checks any signing platform overrides against the options the platform supports,
+// so that e.g. a hash algorithm Lambda code signing does not accept is reported at plan time.
+func resourceSigningProfileCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	platformID := diff.Get("platform_id").(string)
+	overrides := expandSignerSigningPlatformOverrides(diff.Get("signing_platform_overrides").([]interface{}))
+
+	if platformID == "" || overrides == nil || (!diff.HasChange("signing_platform_overrides") && !diff.HasChange("platform_id")) {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).SignerConn
+
+	platform, err := conn.GetSigningPlatform(&signer.GetSigningPlatformInput{
+		PlatformId: aws.String(platformID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Signer signing platform (%s): %w", platformID, err)
+	}
+
+	if v := overrides.SigningConfiguration; v != nil && platform.SigningConfiguration != nil {
+		if v := aws.StringValue(v.EncryptionAlgorithm); v != "" && platform.SigningConfiguration.EncryptionAlgorithmOptions != nil {
+			if allowed := aws.StringValueSlice(platform.SigningConfiguration.EncryptionAlgorithmOptions.AllowedValues); !stringInSlice(v, allowed) {
+				return fmt.Errorf("encryption_algorithm %q is not supported by signing platform %s, allowed values: %s", v, platformID, strings.Join(allowed, ", "))
+			}
+		}
+
+		if v := aws.StringValue(v.HashAlgorithm); v != "" && platform.SigningConfiguration.HashAlgorithmOptions != nil {
+			if allowed := aws.StringValueSlice(platform.SigningConfiguration.HashAlgorithmOptions.AllowedValues); !stringInSlice(v, allowed) {
+				return fmt.Errorf("hash_algorithm %q is not supported by signing platform %s, allowed values: %s", v, platformID, strings.Join(allowed, ", "))
+			}
+		}
+	}
+
+	if v := aws.StringValue(overrides.SigningImageFormat); v != "" && platform.SigningImageFormat != nil {
+		if allowed := aws.StringValueSlice(platform.SigningImageFormat.SupportedFormats); !stringInSlice(v, allowed) {
+			return fmt.Errorf("signing_image_format %q is not supported by signing platform %s, allowed values: %s", v, platformID, strings.Join(allowed, ", "))
+		}
+	}
+
+	return nil
+}
+
+func stringInSlice(v string, l []string) bool {
+	for _, e := range l {
+		if e == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func expandSignerSigningPlatformOverrides(tfList []interface{}) *signer.SigningPlatformOverrides {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	apiObject := &signer.SigningPlatformOverrides{}
+
+	if v, ok := tfMap["signing_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		signingConfiguration := &signer.SigningConfigurationOverrides{}
+
+		if v, ok := tfMap["encryption_algorithm"].(string); ok && v != "" {
+			signingConfiguration.EncryptionAlgorithm = aws.String(v)
+		}
+
+		if v, ok := tfMap["hash_algorithm"].(string); ok && v != "" {
+			signingConfiguration.HashAlgorithm = aws.String(v)
+		}
+
+		apiObject.SigningConfiguration = signingConfiguration
+	}
+
+	if v, ok := tfMap["signing_image_format"].(string); ok && v != "" {
+		apiObject.SigningImageFormat = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSignerSigningPlatformOverrides(apiObject *signer.SigningPlatformOverrides) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.SigningConfiguration; v != nil && (v.EncryptionAlgorithm != nil || v.HashAlgorithm != nil) {
+		tfMap["signing_configuration"] = []interface{}{map[string]interface{}{
+			"encryption_algorithm": aws.StringValue(v.EncryptionAlgorithm),
+			"hash_algorithm":       aws.StringValue(v.HashAlgorithm),
+		}}
+	}
+
+	if v := apiObject.SigningImageFormat; v != nil {
+		tfMap["signing_image_format"] = aws.StringValue(v)
+	}
+
+	if len(tfMap) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{tfMap}
+}
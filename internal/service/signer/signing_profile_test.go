@@ -155,6 +155,36 @@ func TestAccSignerSigningProfile_signatureValidityPeriod(t *testing.T) {
 	})
 }
 
+func TestAccSignerSigningProfile_signingPlatformOverrides(t *testing.T) {
+	resourceName := "aws_signer_signing_profile.test_sp"
+	namePrefix := "tf_acc_sp_basic_"
+
+	var conf signer.GetSigningProfileOutput
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckSingerSigningProfile(t, "AWSLambda-SHA384-ECDSA") },
+		ErrorCheck:   acctest.ErrorCheck(t, signer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSigningProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSigningProfileSigningPlatformOverridesConfig(namePrefix, "SHA1"),
+				ExpectError: regexp.MustCompile(`hash_algorithm "SHA1" is not supported by signing platform`),
+			},
+			{
+				Config: testAccSigningProfileSigningPlatformOverridesConfig(namePrefix, "SHA384"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSigningProfileExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "signing_platform_overrides.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "signing_platform_overrides.0.signing_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "signing_platform_overrides.0.signing_configuration.0.encryption_algorithm", "ECDSA"),
+					resource.TestCheckResourceAttr(resourceName, "signing_platform_overrides.0.signing_configuration.0.hash_algorithm", "SHA384"),
+				),
+			},
+		},
+	})
+}
+
 func testAccPreCheckSingerSigningProfile(t *testing.T, platformID string) {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).SignerConn
 
@@ -232,6 +262,22 @@ resource "aws_signer_signing_profile" "test_sp" {
 `, namePrefix)
 }
 
+func testAccSigningProfileSigningPlatformOverridesConfig(namePrefix, hashAlgorithm string) string {
+	return fmt.Sprintf(`
+resource "aws_signer_signing_profile" "test_sp" {
+  platform_id = "AWSLambda-SHA384-ECDSA"
+  name_prefix = %[1]q
+
+  signing_platform_overrides {
+    signing_configuration {
+      encryption_algorithm = "ECDSA"
+      hash_algorithm       = %[2]q
+    }
+  }
+}
+`, namePrefix, hashAlgorithm)
+}
+
 func testAccSigningProfileUpdateSVP() string {
 	return `
 resource "aws_signer_signing_profile" "test_sp" {
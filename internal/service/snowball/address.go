@@ -0,0 +1,186 @@
+package snowball
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/snowball"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceAddress() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAddressCreate,
+		Read:   resourceAddressRead,
+		Delete: resourceAddressDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"city": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"company": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"country": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"landmark": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"phone_number": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"postal_code": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"prefecture_or_district": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"state_or_province": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"street1": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"street2": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"street3": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+		},
+	}
+}
+
+func resourceAddressCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SnowballConn
+
+	address := &snowball.Address{
+		City:            aws.String(d.Get("city").(string)),
+		Country:         aws.String(d.Get("country").(string)),
+		Name:            aws.String(d.Get("name").(string)),
+		PhoneNumber:     aws.String(d.Get("phone_number").(string)),
+		PostalCode:      aws.String(d.Get("postal_code").(string)),
+		StateOrProvince: aws.String(d.Get("state_or_province").(string)),
+		Street1:         aws.String(d.Get("street1").(string)),
+	}
+
+	if v, ok := d.GetOk("company"); ok {
+		address.Company = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("landmark"); ok {
+		address.Landmark = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("prefecture_or_district"); ok {
+		address.PrefectureOrDistrict = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("street2"); ok {
+		address.Street2 = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("street3"); ok {
+		address.Street3 = aws.String(v.(string))
+	}
+
+	input := &snowball.CreateAddressInput{
+		Address: address,
+	}
+
+	log.Printf("[DEBUG] Creating Snowball Address: %s", input)
+	output, err := conn.CreateAddress(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Snowball Address: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.AddressId))
+
+	return resourceAddressRead(d, meta)
+}
+
+func resourceAddressRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SnowballConn
+
+	address, err := FindAddressByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Snowball Address (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Snowball Address (%s): %w", d.Id(), err)
+	}
+
+	d.Set("city", address.City)
+	d.Set("company", address.Company)
+	d.Set("country", address.Country)
+	d.Set("landmark", address.Landmark)
+	d.Set("name", address.Name)
+	d.Set("phone_number", address.PhoneNumber)
+	d.Set("postal_code", address.PostalCode)
+	d.Set("prefecture_or_district", address.PrefectureOrDistrict)
+	d.Set("state_or_province", address.StateOrProvince)
+	d.Set("street1", address.Street1)
+	d.Set("street2", address.Street2)
+	d.Set("street3", address.Street3)
+
+	return nil
+}
+
+func resourceAddressDelete(d *schema.ResourceData, meta interface{}) error {
+	// There is no API to delete a Snowball address.
+	log.Printf("[WARN] Snowball Address (%s) cannot be deleted, removing from state", d.Id())
+
+	return nil
+}
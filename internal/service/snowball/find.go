@@ -0,0 +1,67 @@
+package snowball
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/snowball"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindAddressByID(conn *snowball.Snowball, id string) (*snowball.Address, error) {
+	input := &snowball.DescribeAddressInput{
+		AddressId: aws.String(id),
+	}
+
+	output, err := conn.DescribeAddress(input)
+
+	if tfawserr.ErrCodeEquals(err, snowball.ErrCodeInvalidResourceException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Address == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Address, nil
+}
+
+func FindJobByID(conn *snowball.Snowball, id string) (*snowball.JobMetadata, error) {
+	input := &snowball.DescribeJobInput{
+		JobId: aws.String(id),
+	}
+
+	output, err := conn.DescribeJob(input)
+
+	if tfawserr.ErrCodeEquals(err, snowball.ErrCodeInvalidResourceException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.JobMetadata == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	// Cancelled jobs remain visible for some time.
+	if state := aws.StringValue(output.JobMetadata.JobState); state == snowball.JobStateCancelled {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return output.JobMetadata, nil
+}
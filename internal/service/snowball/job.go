@@ -0,0 +1,544 @@
+package snowball
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/snowball"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceJob() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceJobCreate,
+		Read:   resourceJobRead,
+		Update: resourceJobUpdate,
+		Delete: resourceJobDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"address_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"forwarding_address_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"job_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(snowball.JobType_Values(), false),
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"notification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"job_states_to_notify": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(snowball.JobState_Values(), false),
+							},
+						},
+						"notify_all": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"sns_topic_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ec2_ami_resource": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ami_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"snowball_ami_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"lambda_resource": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"event_resource_arns": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidARN,
+										},
+									},
+									"lambda_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"s3_resource": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"key_range": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"begin_marker": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"end_marker": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"shipping_option": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(snowball.ShippingOption_Values(), false),
+			},
+			"snowball_capacity_preference": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(snowball.Capacity_Values(), false),
+			},
+			"snowball_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(snowball.Type_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceJobCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SnowballConn
+
+	input := &snowball.CreateJobInput{
+		AddressId:      aws.String(d.Get("address_id").(string)),
+		JobType:        aws.String(d.Get("job_type").(string)),
+		ShippingOption: aws.String(d.Get("shipping_option").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("forwarding_address_id"); ok {
+		input.ForwardingAddressId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.KmsKeyARN = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("notification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Notification = expandNotification(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("resources"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Resources = expandJobResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleARN = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("snowball_capacity_preference"); ok {
+		input.SnowballCapacityPreference = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("snowball_type"); ok {
+		input.SnowballType = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Snowball Job: %s", input)
+	output, err := conn.CreateJob(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Snowball Job: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.JobId))
+
+	return resourceJobRead(d, meta)
+}
+
+func resourceJobRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SnowballConn
+
+	job, err := FindJobByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Snowball Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Snowball Job (%s): %w", d.Id(), err)
+	}
+
+	d.Set("address_id", job.AddressId)
+	if job.CreationDate != nil {
+		d.Set("creation_date", aws.TimeValue(job.CreationDate).Format(time.RFC3339))
+	} else {
+		d.Set("creation_date", nil)
+	}
+	d.Set("description", job.Description)
+	d.Set("forwarding_address_id", job.ForwardingAddressId)
+	d.Set("job_state", job.JobState)
+	d.Set("job_type", job.JobType)
+	d.Set("kms_key_arn", job.KmsKeyARN)
+
+	if job.Notification != nil {
+		if err := d.Set("notification", []interface{}{flattenNotification(job.Notification)}); err != nil {
+			return fmt.Errorf("error setting notification: %w", err)
+		}
+	} else {
+		d.Set("notification", nil)
+	}
+
+	if job.Resources != nil {
+		if err := d.Set("resources", []interface{}{flattenJobResource(job.Resources)}); err != nil {
+			return fmt.Errorf("error setting resources: %w", err)
+		}
+	} else {
+		d.Set("resources", nil)
+	}
+
+	d.Set("role_arn", job.RoleARN)
+
+	if job.ShippingDetails != nil {
+		d.Set("shipping_option", job.ShippingDetails.ShippingOption)
+	} else {
+		d.Set("shipping_option", nil)
+	}
+
+	d.Set("snowball_capacity_preference", job.SnowballCapacityPreference)
+	d.Set("snowball_type", job.SnowballType)
+
+	return nil
+}
+
+func resourceJobUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SnowballConn
+
+	input := &snowball.UpdateJobInput{
+		JobId: aws.String(d.Id()),
+	}
+
+	if d.HasChange("address_id") {
+		input.AddressId = aws.String(d.Get("address_id").(string))
+	}
+
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("forwarding_address_id") {
+		input.ForwardingAddressId = aws.String(d.Get("forwarding_address_id").(string))
+	}
+
+	if d.HasChange("notification") {
+		input.Notification = &snowball.Notification{}
+
+		if v, ok := d.GetOk("notification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Notification = expandNotification(v.([]interface{})[0].(map[string]interface{}))
+		}
+	}
+
+	if d.HasChange("resources") {
+		input.Resources = &snowball.JobResource{}
+
+		if v, ok := d.GetOk("resources"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Resources = expandJobResource(v.([]interface{})[0].(map[string]interface{}))
+		}
+	}
+
+	if d.HasChange("role_arn") {
+		input.RoleARN = aws.String(d.Get("role_arn").(string))
+	}
+
+	if d.HasChange("shipping_option") {
+		input.ShippingOption = aws.String(d.Get("shipping_option").(string))
+	}
+
+	if d.HasChange("snowball_capacity_preference") {
+		input.SnowballCapacityPreference = aws.String(d.Get("snowball_capacity_preference").(string))
+	}
+
+	log.Printf("[DEBUG] Updating Snowball Job: %s", input)
+	if _, err := conn.UpdateJob(input); err != nil {
+		return fmt.Errorf("error updating Snowball Job (%s): %w", d.Id(), err)
+	}
+
+	return resourceJobRead(d, meta)
+}
+
+func resourceJobDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SnowballConn
+
+	// Completed jobs can't be cancelled and expire on their own.
+	if d.Get("job_state").(string) == snowball.JobStateComplete {
+		log.Printf("[WARN] Snowball Job (%s) is complete, removing from state", d.Id())
+		return nil
+	}
+
+	log.Printf("[DEBUG] Cancelling Snowball Job: %s", d.Id())
+	_, err := conn.CancelJob(&snowball.CancelJobInput{
+		JobId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, snowball.ErrCodeInvalidResourceException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error cancelling Snowball Job (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandNotification(tfMap map[string]interface{}) *snowball.Notification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &snowball.Notification{}
+
+	if v, ok := tfMap["job_states_to_notify"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.JobStatesToNotify = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["notify_all"].(bool); ok {
+		apiObject.NotifyAll = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["sns_topic_arn"].(string); ok && v != "" {
+		apiObject.SnsTopicARN = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandJobResource(tfMap map[string]interface{}) *snowball.JobResource {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &snowball.JobResource{}
+
+	if v, ok := tfMap["ec2_ami_resource"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.Ec2AmiResources = append(apiObject.Ec2AmiResources, &snowball.Ec2AmiResource{
+				AmiId: aws.String(tfMap["ami_id"].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["lambda_resource"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			lambdaResource := &snowball.LambdaResource{
+				LambdaArn: aws.String(tfMap["lambda_arn"].(string)),
+			}
+
+			if v, ok := tfMap["event_resource_arns"].(*schema.Set); ok {
+				for _, arn := range v.List() {
+					lambdaResource.EventTriggers = append(lambdaResource.EventTriggers, &snowball.EventTriggerDefinition{
+						EventResourceARN: aws.String(arn.(string)),
+					})
+				}
+			}
+
+			apiObject.LambdaResources = append(apiObject.LambdaResources, lambdaResource)
+		}
+	}
+
+	if v, ok := tfMap["s3_resource"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			s3Resource := &snowball.S3Resource{
+				BucketArn: aws.String(tfMap["bucket_arn"].(string)),
+			}
+
+			if v, ok := tfMap["key_range"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+				s3Resource.KeyRange = &snowball.KeyRange{}
+
+				if v, ok := tfMap["begin_marker"].(string); ok && v != "" {
+					s3Resource.KeyRange.BeginMarker = aws.String(v)
+				}
+
+				if v, ok := tfMap["end_marker"].(string); ok && v != "" {
+					s3Resource.KeyRange.EndMarker = aws.String(v)
+				}
+			}
+
+			apiObject.S3Resources = append(apiObject.S3Resources, s3Resource)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenNotification(apiObject *snowball.Notification) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"job_states_to_notify": aws.StringValueSlice(apiObject.JobStatesToNotify),
+		"notify_all":           aws.BoolValue(apiObject.NotifyAll),
+		"sns_topic_arn":        aws.StringValue(apiObject.SnsTopicARN),
+	}
+}
+
+func flattenJobResource(apiObject *snowball.JobResource) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var ec2AmiResources []interface{}
+
+	for _, v := range apiObject.Ec2AmiResources {
+		ec2AmiResources = append(ec2AmiResources, map[string]interface{}{
+			"ami_id":          aws.StringValue(v.AmiId),
+			"snowball_ami_id": aws.StringValue(v.SnowballAmiId),
+		})
+	}
+
+	var lambdaResources []interface{}
+
+	for _, v := range apiObject.LambdaResources {
+		var eventResourceARNs []string
+
+		for _, eventTrigger := range v.EventTriggers {
+			eventResourceARNs = append(eventResourceARNs, aws.StringValue(eventTrigger.EventResourceARN))
+		}
+
+		lambdaResources = append(lambdaResources, map[string]interface{}{
+			"event_resource_arns": eventResourceARNs,
+			"lambda_arn":          aws.StringValue(v.LambdaArn),
+		})
+	}
+
+	var s3Resources []interface{}
+
+	for _, v := range apiObject.S3Resources {
+		s3Resource := map[string]interface{}{
+			"bucket_arn": aws.StringValue(v.BucketArn),
+		}
+
+		if v.KeyRange != nil {
+			s3Resource["key_range"] = []interface{}{map[string]interface{}{
+				"begin_marker": aws.StringValue(v.KeyRange.BeginMarker),
+				"end_marker":   aws.StringValue(v.KeyRange.EndMarker),
+			}}
+		}
+
+		s3Resources = append(s3Resources, s3Resource)
+	}
+
+	return map[string]interface{}{
+		"ec2_ami_resource": ec2AmiResources,
+		"lambda_resource":  lambdaResources,
+		"s3_resource":      s3Resources,
+	}
+}
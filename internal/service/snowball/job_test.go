@@ -0,0 +1,181 @@
+package snowball_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/snowball"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsnowball "github.com/hashicorp/terraform-provider-aws/internal/service/snowball"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// testAccPreCheckJob skips job tests unless explicitly enabled.
+// Creating a job orders a Snow Family device. Jobs are cancelled on destroy, before the device is prepared.
+func testAccPreCheckJob(t *testing.T) {
+	key := "TF_TEST_SNOWBALL_JOB"
+	if os.Getenv(key) == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+}
+
+func TestAccSnowballJob_basic(t *testing.T) {
+	var v snowball.JobMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_snowball_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckJob(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, snowball.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "address_id", "aws_snowball_address.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date"),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttr(resourceName, "job_state", snowball.JobStateNew),
+					resource.TestCheckResourceAttr(resourceName, "job_type", snowball.JobTypeImport),
+					resource.TestCheckResourceAttr(resourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resources.0.s3_resource.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "resources.0.s3_resource.0.bucket_arn", "aws_s3_bucket.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "shipping_option", snowball.ShippingOptionSecondDay),
+					resource.TestCheckResourceAttr(resourceName, "snowball_type", snowball.TypeEdge),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccJobConfig(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSnowballJob_disappears(t *testing.T) {
+	var v snowball.JobMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_snowball_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckJob(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, snowball.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfsnowball.ResourceJob(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckJobExists(n string, v *snowball.JobMetadata) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Snowball Job ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SnowballConn
+
+		output, err := tfsnowball.FindJobByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckJobDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SnowballConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_snowball_job" {
+			continue
+		}
+
+		_, err := tfsnowball.FindJobByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Snowball Job %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccJobConfig(rName, description string) string {
+	return acctest.ConfigCompose(testAccAddressConfig(rName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "importexport.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_snowball_job" "test" {
+  address_id      = aws_snowball_address.test.id
+  description     = %[2]q
+  job_type        = "IMPORT"
+  role_arn        = aws_iam_role.test.arn
+  shipping_option = "SECOND_DAY"
+  snowball_type   = "EDGE"
+
+  resources {
+    s3_resource {
+      bucket_arn = aws_s3_bucket.test.arn
+    }
+  }
+}
+`, rName, description))
+}
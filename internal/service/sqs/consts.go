@@ -4,6 +4,12 @@ const (
 	ErrCodeInvalidAction = "InvalidAction"
 )
 
+const (
+	// These queue attributes are not yet modeled in the AWS SDK for Go.
+	QueueAttributeNameRedriveAllowPolicy   = "RedriveAllowPolicy"
+	QueueAttributeNameSqsManagedSseEnabled = "SqsManagedSseEnabled"
+)
+
 const (
 	FIFOQueueNameSuffix = ".fifo"
 )
@@ -36,9 +36,9 @@ func FindQueueAttributesByURL(conn *sqs.SQS, url string) (map[string]string, err
 	return aws.StringValueMap(output.Attributes), nil
 }
 
-func FindQueuePolicyByURL(conn *sqs.SQS, url string) (string, error) {
+func FindQueueAttributeByURL(conn *sqs.SQS, url string, attributeName string) (string, error) {
 	input := &sqs.GetQueueAttributesInput{
-		AttributeNames: aws.StringSlice([]string{sqs.QueueAttributeNamePolicy}),
+		AttributeNames: aws.StringSlice([]string{attributeName}),
 		QueueUrl:       aws.String(url),
 	}
 
@@ -62,7 +62,7 @@ func FindQueuePolicyByURL(conn *sqs.SQS, url string) (string, error) {
 		}
 	}
 
-	v, ok := output.Attributes[sqs.QueueAttributeNamePolicy]
+	v, ok := output.Attributes[attributeName]
 
 	if !ok || aws.StringValue(v) == "" {
 		return "", &resource.NotFoundError{
@@ -73,3 +73,15 @@ func FindQueuePolicyByURL(conn *sqs.SQS, url string) (string, error) {
 
 	return aws.StringValue(v), nil
 }
+
+func FindQueuePolicyByURL(conn *sqs.SQS, url string) (string, error) {
+	return FindQueueAttributeByURL(conn, url, sqs.QueueAttributeNamePolicy)
+}
+
+func FindQueueRedrivePolicyByURL(conn *sqs.SQS, url string) (string, error) {
+	return FindQueueAttributeByURL(conn, url, sqs.QueueAttributeNameRedrivePolicy)
+}
+
+func FindQueueRedriveAllowPolicyByURL(conn *sqs.SQS, url string) (string, error) {
+	return FindQueueAttributeByURL(conn, url, QueueAttributeNameRedriveAllowPolicy)
+}
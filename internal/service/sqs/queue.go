@@ -71,8 +71,9 @@ var (
 		},
 
 		"kms_master_key_id": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"sqs_managed_sse_enabled"},
 		},
 
 		"max_message_size": {
@@ -119,9 +120,21 @@ var (
 			Default:  DefaultQueueReceiveMessageWaitTimeSeconds,
 		},
 
+		"redrive_allow_policy": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringIsJSON,
+			StateFunc: func(v interface{}) string {
+				json, _ := structure.NormalizeJsonString(v)
+				return json
+			},
+		},
+
 		"redrive_policy": {
 			Type:         schema.TypeString,
 			Optional:     true,
+			Computed:     true,
 			ValidateFunc: validation.StringIsJSON,
 			StateFunc: func(v interface{}) string {
 				json, _ := structure.NormalizeJsonString(v)
@@ -129,6 +142,13 @@ var (
 			},
 		},
 
+		"sqs_managed_sse_enabled": {
+			Type:          schema.TypeBool,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"kms_master_key_id"},
+		},
+
 		"url": {
 			Type:     schema.TypeString,
 			Computed: true,
@@ -153,6 +173,7 @@ var (
 		"visibility_timeout_seconds":        sqs.QueueAttributeNameVisibilityTimeout,
 		"policy":                            sqs.QueueAttributeNamePolicy,
 		"redrive_policy":                    sqs.QueueAttributeNameRedrivePolicy,
+		"redrive_allow_policy":              QueueAttributeNameRedriveAllowPolicy,
 		"arn":                               sqs.QueueAttributeNameQueueArn,
 		"fifo_queue":                        sqs.QueueAttributeNameFifoQueue,
 		"content_based_deduplication":       sqs.QueueAttributeNameContentBasedDeduplication,
@@ -160,6 +181,7 @@ var (
 		"kms_data_key_reuse_period_seconds": sqs.QueueAttributeNameKmsDataKeyReusePeriodSeconds,
 		"deduplication_scope":               sqs.QueueAttributeNameDeduplicationScope,
 		"fifo_throughput_limit":             sqs.QueueAttributeNameFifoThroughputLimit,
+		"sqs_managed_sse_enabled":           QueueAttributeNameSqsManagedSseEnabled,
 	}, sqsQueueSchema)
 )
 
@@ -180,6 +202,11 @@ func ResourceQueue() *schema.Resource {
 			verify.SetTagsDiff,
 		),
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(queueAttributePropagationTimeout),
+			Update: schema.DefaultTimeout(queueAttributePropagationTimeout),
+		},
+
 		Schema: sqsQueueSchema,
 	}
 }
@@ -243,7 +270,7 @@ func resourceQueueCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(aws.StringValue(output.QueueUrl))
 
-	err = waitQueueAttributesPropagated(conn, d.Id(), attributes)
+	err = waitQueueAttributesPropagated(conn, d.Id(), attributes, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		return fmt.Errorf("error waiting for SQS Queue (%s) attributes to create: %w", d.Id(), err)
@@ -348,7 +375,7 @@ func resourceQueueUpdate(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("error updating SQS Queue (%s) attributes: %w", d.Id(), err)
 		}
 
-		err = waitQueueAttributesPropagated(conn, d.Id(), attributes)
+		err = waitQueueAttributesPropagated(conn, d.Id(), attributes, d.Timeout(schema.TimeoutUpdate))
 
 		if err != nil {
 			return fmt.Errorf("error waiting for SQS Queue (%s) attributes to update: %w", d.Id(), err)
@@ -423,5 +450,12 @@ func resourceQueueCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, me
 		return fmt.Errorf("content-based deduplication can only be set for FIFO queue")
 	}
 
+	// Configuring a KMS key switches the queue from SSE-SQS to SSE-KMS.
+	if diff.Get("kms_master_key_id").(string) != "" && diff.Get("sqs_managed_sse_enabled").(bool) {
+		if err := diff.SetNew("sqs_managed_sse_enabled", false); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
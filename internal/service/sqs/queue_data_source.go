@@ -7,27 +7,111 @@ import (
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
+var (
+	sqsQueueDataSourceSchema = map[string]*schema.Schema{
+		"arn": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"content_based_deduplication": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"deduplication_scope": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"delay_seconds": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"fifo_queue": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"fifo_throughput_limit": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"kms_data_key_reuse_period_seconds": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"kms_master_key_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"max_message_size": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"message_retention_seconds": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"policy": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"receive_wait_time_seconds": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"redrive_allow_policy": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"redrive_policy": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"sqs_managed_sse_enabled": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"tags": tftags.TagsSchemaComputed(),
+		"url": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"visibility_timeout_seconds": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+	}
+
+	sqsQueueDataSourceAttributeMap = create.AttrMap(map[string]string{
+		"arn":                               sqs.QueueAttributeNameQueueArn,
+		"content_based_deduplication":       sqs.QueueAttributeNameContentBasedDeduplication,
+		"deduplication_scope":               sqs.QueueAttributeNameDeduplicationScope,
+		"delay_seconds":                     sqs.QueueAttributeNameDelaySeconds,
+		"fifo_queue":                        sqs.QueueAttributeNameFifoQueue,
+		"fifo_throughput_limit":             sqs.QueueAttributeNameFifoThroughputLimit,
+		"kms_data_key_reuse_period_seconds": sqs.QueueAttributeNameKmsDataKeyReusePeriodSeconds,
+		"kms_master_key_id":                 sqs.QueueAttributeNameKmsMasterKeyId,
+		"max_message_size":                  sqs.QueueAttributeNameMaximumMessageSize,
+		"message_retention_seconds":         sqs.QueueAttributeNameMessageRetentionPeriod,
+		"policy":                            sqs.QueueAttributeNamePolicy,
+		"receive_wait_time_seconds":         sqs.QueueAttributeNameReceiveMessageWaitTimeSeconds,
+		"redrive_allow_policy":              QueueAttributeNameRedriveAllowPolicy,
+		"redrive_policy":                    sqs.QueueAttributeNameRedrivePolicy,
+		"sqs_managed_sse_enabled":           QueueAttributeNameSqsManagedSseEnabled,
+		"visibility_timeout_seconds":        sqs.QueueAttributeNameVisibilityTimeout,
+	}, sqsQueueDataSourceSchema)
+)
+
 func DataSourceQueue() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceQueueRead,
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"arn": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"url": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"tags": tftags.TagsSchemaComputed(),
-		},
+		Read:   dataSourceQueueRead,
+		Schema: sqsQueueDataSourceSchema,
 	}
 }
 
@@ -46,15 +130,16 @@ func dataSourceQueueRead(d *schema.ResourceData, meta interface{}) error {
 
 	queueURL := aws.StringValue(urlOutput.QueueUrl)
 
-	attributesOutput, err := conn.GetQueueAttributes(&sqs.GetQueueAttributesInput{
-		QueueUrl:       aws.String(queueURL),
-		AttributeNames: []*string{aws.String(sqs.QueueAttributeNameQueueArn)},
-	})
+	attributes, err := FindQueueAttributesByURL(conn, queueURL)
+
 	if err != nil {
 		return fmt.Errorf("Error getting queue attributes: %w", err)
 	}
 
-	d.Set("arn", attributesOutput.Attributes[sqs.QueueAttributeNameQueueArn])
+	if err := sqsQueueDataSourceAttributeMap.ApiAttributesToResourceData(attributes, d); err != nil {
+		return err
+	}
+
 	d.Set("url", queueURL)
 	d.SetId(queueURL)
 
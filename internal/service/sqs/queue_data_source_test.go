@@ -56,6 +56,32 @@ func TestAccSQSQueueDataSource_tags(t *testing.T) {
 	})
 }
 
+func TestAccSQSQueueDataSource_attributes(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test_")
+	resourceName := "aws_sqs_queue.test"
+	datasourceName := "data.aws_sqs_queue.by_name"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, sqs.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueAttributesDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccQueueCheckDataSource(datasourceName, resourceName),
+					resource.TestCheckResourceAttrPair(datasourceName, "kms_data_key_reuse_period_seconds", resourceName, "kms_data_key_reuse_period_seconds"),
+					resource.TestCheckResourceAttrPair(datasourceName, "kms_master_key_id", resourceName, "kms_master_key_id"),
+					resource.TestCheckResourceAttrSet(datasourceName, "policy"),
+					resource.TestCheckResourceAttrPair(datasourceName, "redrive_policy", resourceName, "redrive_policy"),
+					resource.TestCheckResourceAttr(datasourceName, "sqs_managed_sse_enabled", "false"),
+					resource.TestCheckResourceAttr(datasourceName, "visibility_timeout_seconds", "60"),
+				),
+			},
+		},
+	})
+}
+
 func testAccQueueCheckDataSource(datasourceName, resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[datasourceName]
@@ -70,7 +96,13 @@ func testAccQueueCheckDataSource(datasourceName, resourceName string) resource.T
 
 		attrNames := []string{
 			"arn",
+			"delay_seconds",
+			"fifo_queue",
+			"max_message_size",
+			"message_retention_seconds",
 			"name",
+			"receive_wait_time_seconds",
+			"visibility_timeout_seconds",
 		}
 
 		for _, attrName := range attrNames {
@@ -121,3 +153,37 @@ data "aws_sqs_queue" "by_name" {
 }
 `, rName)
 }
+
+func testAccQueueAttributesDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "dlq" {
+  name = "%[1]s_dlq"
+}
+
+resource "aws_sqs_queue" "test" {
+  name                              = %[1]q
+  visibility_timeout_seconds        = 60
+  kms_master_key_id                 = "alias/aws/sqs"
+  kms_data_key_reuse_period_seconds = 600
+
+  redrive_policy = jsonencode({
+    deadLetterTargetArn = aws_sqs_queue.dlq.arn
+    maxReceiveCount     = 4
+  })
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = "*"
+      Action    = "sqs:SendMessage"
+      Resource  = "*"
+    }]
+  })
+}
+
+data "aws_sqs_queue" "by_name" {
+  name = aws_sqs_queue.test.name
+}
+`, rName)
+}
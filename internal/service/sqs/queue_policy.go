@@ -33,6 +33,12 @@ func ResourceQueuePolicy() *schema.Resource {
 		MigrateState:  QueuePolicyMigrateState,
 		SchemaVersion: 1,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(queueAttributePropagationTimeout),
+			Update: schema.DefaultTimeout(queueAttributePropagationTimeout),
+			Delete: schema.DefaultTimeout(queueAttributePropagationTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"policy": {
 				Type:             schema.TypeString,
@@ -69,9 +75,14 @@ func resourceQueuePolicyUpsert(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting SQS Queue Policy (%s): %w", url, err)
 	}
 
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
 	d.SetId(url)
 
-	err = waitQueueAttributesPropagated(conn, d.Id(), policyAttributes)
+	err = waitQueueAttributesPropagated(conn, d.Id(), policyAttributes, timeout)
 
 	if err != nil {
 		return fmt.Errorf("error waiting for SQS Queue Policy (%s) to be set: %w", d.Id(), err)
@@ -118,7 +129,7 @@ func resourceQueuePolicyDelete(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error deleting SQS Queue Policy (%s): %w", d.Id(), err)
 	}
 
-	err = waitQueueAttributesPropagated(conn, d.Id(), sqsQueueEmptyPolicyAttributes)
+	err = waitQueueAttributesPropagated(conn, d.Id(), sqsQueueEmptyPolicyAttributes, d.Timeout(schema.TimeoutDelete))
 
 	if err != nil {
 		return fmt.Errorf("error waiting for SQS Queue Policy (%s) to delete: %w", d.Id(), err)
@@ -0,0 +1,139 @@
+package sqs
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+var (
+	sqsQueueEmptyRedriveAllowPolicyAttributes = map[string]string{
+		QueueAttributeNameRedriveAllowPolicy: "",
+	}
+)
+
+func ResourceQueueRedriveAllowPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceQueueRedriveAllowPolicyUpsert,
+		Read:   resourceQueueRedriveAllowPolicyRead,
+		Update: resourceQueueRedriveAllowPolicyUpsert,
+		Delete: resourceQueueRedriveAllowPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(queueAttributePropagationTimeout),
+			Update: schema.DefaultTimeout(queueAttributePropagationTimeout),
+			Delete: schema.DefaultTimeout(queueAttributePropagationTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"queue_url": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"redrive_allow_policy": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+		},
+	}
+}
+
+func resourceQueueRedriveAllowPolicyUpsert(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SQSConn
+
+	redriveAllowPolicyAttributes := map[string]string{
+		QueueAttributeNameRedriveAllowPolicy: d.Get("redrive_allow_policy").(string),
+	}
+	url := d.Get("queue_url").(string)
+	input := &sqs.SetQueueAttributesInput{
+		Attributes: aws.StringMap(redriveAllowPolicyAttributes),
+		QueueUrl:   aws.String(url),
+	}
+
+	log.Printf("[DEBUG] Setting SQS Queue Redrive Allow Policy: %s", input)
+	_, err := conn.SetQueueAttributes(input)
+
+	if err != nil {
+		return fmt.Errorf("error setting SQS Queue Redrive Allow Policy (%s): %w", url, err)
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	d.SetId(url)
+
+	err = waitQueueAttributesPropagated(conn, d.Id(), redriveAllowPolicyAttributes, timeout)
+
+	if err != nil {
+		return fmt.Errorf("error waiting for SQS Queue Redrive Allow Policy (%s) to be set: %w", d.Id(), err)
+	}
+
+	return resourceQueueRedriveAllowPolicyRead(d, meta)
+}
+
+func resourceQueueRedriveAllowPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SQSConn
+
+	redriveAllowPolicy, err := FindQueueRedriveAllowPolicyByURL(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SQS Queue Redrive Allow Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SQS Queue Redrive Allow Policy (%s): %w", d.Id(), err)
+	}
+
+	d.Set("queue_url", d.Id())
+	d.Set("redrive_allow_policy", redriveAllowPolicy)
+
+	return nil
+}
+
+func resourceQueueRedriveAllowPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SQSConn
+
+	log.Printf("[DEBUG] Deleting SQS Queue Redrive Allow Policy: %s", d.Id())
+	_, err := conn.SetQueueAttributes(&sqs.SetQueueAttributesInput{
+		Attributes: aws.StringMap(sqsQueueEmptyRedriveAllowPolicyAttributes),
+		QueueUrl:   aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sqs.ErrCodeQueueDoesNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SQS Queue Redrive Allow Policy (%s): %w", d.Id(), err)
+	}
+
+	err = waitQueueAttributesPropagated(conn, d.Id(), sqsQueueEmptyRedriveAllowPolicyAttributes, d.Timeout(schema.TimeoutDelete))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for SQS Queue Redrive Allow Policy (%s) to delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
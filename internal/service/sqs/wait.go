@@ -12,10 +12,10 @@ import (
 )
 
 const (
-	// Maximum amount of time to wait for SQS queue attribute changes to propagate
-	// This timeout should not be increased without strong consideration
+	// Default amount of time to wait for SQS queue attribute changes to propagate.
+	// This default should not be increased without strong consideration
 	// as this will negatively impact user experience when configurations
-	// have incorrect references or permissions.
+	// have incorrect references or permissions; use the resource Timeouts instead.
 	// Reference: https://docs.aws.amazon.com/AWSSimpleQueueService/latest/APIReference/API_SetQueueAttributes.html
 	queueAttributePropagationTimeout = 1 * time.Minute
 
@@ -28,7 +28,7 @@ const (
 	queueStateExists = "exists"
 )
 
-func waitQueueAttributesPropagated(conn *sqs.SQS, url string, expected map[string]string) error {
+func waitQueueAttributesPropagated(conn *sqs.SQS, url string, expected map[string]string, timeout time.Duration) error {
 	attributesMatch := func(got map[string]string) error {
 		for k, e := range expected {
 			g, ok := got[k]
@@ -44,6 +44,11 @@ func waitQueueAttributesPropagated(conn *sqs.SQS, url string, expected map[strin
 					continue
 				}
 
+				// Partitions without SSE-SQS support omit the attribute entirely.
+				if k == QueueAttributeNameSqsManagedSseEnabled && e == strconv.FormatBool(false) {
+					continue
+				}
+
 				return fmt.Errorf("SQS Queue attribute (%s) not available", k)
 			}
 
@@ -62,6 +67,10 @@ func waitQueueAttributesPropagated(conn *sqs.SQS, url string, expected map[strin
 				if !StringsEquivalent(g, e) {
 					return fmt.Errorf("SQS Queue redrive policies are not equivalent")
 				}
+			case QueueAttributeNameRedriveAllowPolicy:
+				if !StringsEquivalent(g, e) {
+					return fmt.Errorf("SQS Queue redrive allow policies are not equivalent")
+				}
 			default:
 				if g != e {
 					return fmt.Errorf("SQS Queue attribute (%s) got: %s, expected: %s", k, g, e)
@@ -73,7 +82,7 @@ func waitQueueAttributesPropagated(conn *sqs.SQS, url string, expected map[strin
 	}
 
 	var got map[string]string
-	err := resource.Retry(queueAttributePropagationTimeout, func() *resource.RetryError {
+	err := resource.Retry(timeout, func() *resource.RetryError {
 		var err error
 
 		got, err = FindQueueAttributesByURL(conn, url)
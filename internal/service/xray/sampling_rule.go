@@ -1,11 +1,14 @@
 package xray
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/xray"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -25,7 +28,10 @@ func ResourceSamplingRule() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceSamplingRuleCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"rule_name": {
@@ -254,6 +260,71 @@ func resourceSamplingRuleDelete(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+// resourceSamplingRuleCustomizeDiff warns when the planned priority is already used by another sampling rule.
+// X-Ray accepts duplicate priorities and breaks ties by rule name, which makes the effective
+// evaluation order depend on naming rather than configuration.
+// The conflict is not an error. The other rule may be managed in the same configuration and be about
+// to move to another priority, e.g. when two rules swap priorities, or be destroyed, e.g. when a rule
+// is replaced with create_before_destroy. Neither is visible to this resource's plan.
+func resourceSamplingRuleCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && !diff.HasChange("priority") {
+		return nil
+	}
+
+	if !diff.NewValueKnown("priority") || !diff.NewValueKnown("rule_name") {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).XRayConn
+	priority := int64(diff.Get("priority").(int))
+	ruleName := diff.Get("rule_name").(string)
+
+	if diff.Id() != "" {
+		ruleName = diff.Id()
+	}
+
+	conflicts, err := findSamplingRuleNamesByPriority(conn, priority)
+
+	if err != nil {
+		return fmt.Errorf("error reading XRay Sampling Rules: %w", err)
+	}
+
+	var others []string
+	for _, v := range conflicts {
+		if v != ruleName {
+			others = append(others, v)
+		}
+	}
+
+	if len(others) > 0 {
+		log.Printf("[WARN] XRay Sampling Rule (%s) priority %d is already used by XRay Sampling Rule(s): %s", ruleName, priority, strings.Join(others, ", "))
+	}
+
+	return nil
+}
+
+func findSamplingRuleNamesByPriority(conn *xray.XRay, priority int64) ([]string, error) {
+	params := &xray.GetSamplingRulesInput{}
+	var names []string
+	for {
+		out, err := conn.GetSamplingRules(params)
+		if err != nil {
+			return nil, err
+		}
+		for _, samplingRuleRecord := range out.SamplingRuleRecords {
+			samplingRule := samplingRuleRecord.SamplingRule
+			if aws.Int64Value(samplingRule.Priority) == priority {
+				names = append(names, aws.StringValue(samplingRule.RuleName))
+			}
+		}
+		if aws.StringValue(out.NextToken) == "" {
+			break
+		}
+		params.NextToken = out.NextToken
+	}
+	return names, nil
+}
+
 func GetSamplingRule(conn *xray.XRay, ruleName string) (*xray.SamplingRule, error) {
 	params := &xray.GetSamplingRulesInput{}
 	for {
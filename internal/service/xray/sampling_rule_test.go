@@ -2,6 +2,7 @@ package xray_test
 
 import (
 	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/xray"
@@ -17,6 +18,7 @@ func TestAccXRaySamplingRule_basic(t *testing.T) {
 	var samplingRule xray.SamplingRule
 	resourceName := "aws_xray_sampling_rule.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	priority := 5001
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
@@ -25,11 +27,11 @@ func TestAccXRaySamplingRule_basic(t *testing.T) {
 		CheckDestroy: testAccCheckSamplingRuleDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSamplingRuleConfig_basic(rName),
+				Config: testAccSamplingRuleConfig_basic(rName, priority),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckXraySamplingRuleExists(resourceName, &samplingRule),
 					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "xray", fmt.Sprintf("sampling-rule/%s", rName)),
-					resource.TestCheckResourceAttr(resourceName, "priority", "5"),
+					resource.TestCheckResourceAttr(resourceName, "priority", fmt.Sprintf("%d", priority)),
 					resource.TestCheckResourceAttr(resourceName, "version", "1"),
 					resource.TestCheckResourceAttr(resourceName, "reservoir_size", "10"),
 					resource.TestCheckResourceAttr(resourceName, "url_path", "*"),
@@ -56,7 +58,7 @@ func TestAccXRaySamplingRule_update(t *testing.T) {
 	var samplingRule xray.SamplingRule
 	resourceName := "aws_xray_sampling_rule.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	updatedPriority := sdkacctest.RandIntRange(0, 9999)
+	updatedPriority := 5003
 	updatedReservoirSize := sdkacctest.RandIntRange(0, 2147483647)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -66,7 +68,7 @@ func TestAccXRaySamplingRule_update(t *testing.T) {
 		CheckDestroy: testAccCheckSamplingRuleDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSamplingRuleConfig_update(rName, sdkacctest.RandIntRange(0, 9999), sdkacctest.RandIntRange(0, 2147483647)),
+				Config: testAccSamplingRuleConfig_update(rName, 5002, sdkacctest.RandIntRange(0, 2147483647)),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckXraySamplingRuleExists(resourceName, &samplingRule),
 					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "xray", fmt.Sprintf("sampling-rule/%s", rName)),
@@ -114,6 +116,7 @@ func TestAccXRaySamplingRule_tags(t *testing.T) {
 	var samplingRule xray.SamplingRule
 	resourceName := "aws_xray_sampling_rule.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	priority := 5004
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
@@ -122,7 +125,7 @@ func TestAccXRaySamplingRule_tags(t *testing.T) {
 		CheckDestroy: testAccCheckSamplingRuleDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSamplingRuleTags1Config(rName, "key1", "value1"),
+				Config: testAccSamplingRuleTags1Config(rName, priority, "key1", "value1"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckXraySamplingRuleExists(resourceName, &samplingRule),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
@@ -135,7 +138,7 @@ func TestAccXRaySamplingRule_tags(t *testing.T) {
 				ImportStateVerify: true,
 			},
 			{
-				Config: testAccSamplingRuleTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Config: testAccSamplingRuleTags2Config(rName, priority, "key1", "value1updated", "key2", "value2"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckXraySamplingRuleExists(resourceName, &samplingRule),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
@@ -144,7 +147,7 @@ func TestAccXRaySamplingRule_tags(t *testing.T) {
 				),
 			},
 			{
-				Config: testAccSamplingRuleTags1Config(rName, "key2", "value2"),
+				Config: testAccSamplingRuleTags1Config(rName, priority, "key2", "value2"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckXraySamplingRuleExists(resourceName, &samplingRule),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
@@ -159,6 +162,7 @@ func TestAccXRaySamplingRule_disappears(t *testing.T) {
 	var samplingRule xray.SamplingRule
 	resourceName := "aws_xray_sampling_rule.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	priority := 5005
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
@@ -167,7 +171,7 @@ func TestAccXRaySamplingRule_disappears(t *testing.T) {
 		CheckDestroy: testAccCheckSamplingRuleDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSamplingRuleConfig_basic(rName),
+				Config: testAccSamplingRuleConfig_basic(rName, priority),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckXraySamplingRuleExists(resourceName, &samplingRule),
 					acctest.CheckResourceDisappears(acctest.Provider, tfxray.ResourceSamplingRule(), resourceName),
@@ -178,6 +182,101 @@ func TestAccXRaySamplingRule_disappears(t *testing.T) {
 	})
 }
 
+func TestAccXRaySamplingRule_duplicatePriority(t *testing.T) {
+	var samplingRule xray.SamplingRule
+	resourceName := "aws_xray_sampling_rule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	priority := 5006
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, xray.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSamplingRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSamplingRuleConfig_basic(rName, priority),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckXraySamplingRuleExists(resourceName, &samplingRule),
+				),
+			},
+			{
+				// X-Ray accepts the duplicate priority; the conflict is only logged as a warning during plan.
+				Config: testAccSamplingRuleConfig_duplicatePriority(rName, priority),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckXraySamplingRuleExists(resourceName, &samplingRule),
+					resource.TestCheckResourceAttr("aws_xray_sampling_rule.test2", "priority", strconv.Itoa(priority)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccXRaySamplingRule_swapPriorities(t *testing.T) {
+	var samplingRule1, samplingRule2 xray.SamplingRule
+	resourceName1 := "aws_xray_sampling_rule.test1"
+	resourceName2 := "aws_xray_sampling_rule.test2"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, xray.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSamplingRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSamplingRuleConfig_twoRules(rName, 5007, 5008),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckXraySamplingRuleExists(resourceName1, &samplingRule1),
+					testAccCheckXraySamplingRuleExists(resourceName2, &samplingRule2),
+					resource.TestCheckResourceAttr(resourceName1, "priority", "5007"),
+					resource.TestCheckResourceAttr(resourceName2, "priority", "5008"),
+				),
+			},
+			{
+				Config: testAccSamplingRuleConfig_twoRules(rName, 5008, 5007),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckXraySamplingRuleExists(resourceName1, &samplingRule1),
+					testAccCheckXraySamplingRuleExists(resourceName2, &samplingRule2),
+					resource.TestCheckResourceAttr(resourceName1, "priority", "5008"),
+					resource.TestCheckResourceAttr(resourceName2, "priority", "5007"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccXRaySamplingRule_createBeforeDestroy(t *testing.T) {
+	var samplingRule xray.SamplingRule
+	resourceName := "aws_xray_sampling_rule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	priority := 5009
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, xray.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSamplingRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSamplingRuleConfig_createBeforeDestroy(rName+"-1", priority),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckXraySamplingRuleExists(resourceName, &samplingRule),
+					resource.TestCheckResourceAttr(resourceName, "rule_name", rName+"-1"),
+				),
+			},
+			{
+				Config: testAccSamplingRuleConfig_createBeforeDestroy(rName+"-2", priority),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckXraySamplingRuleExists(resourceName, &samplingRule),
+					resource.TestCheckResourceAttr(resourceName, "rule_name", rName+"-2"),
+					resource.TestCheckResourceAttr(resourceName, "priority", strconv.Itoa(priority)),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckXraySamplingRuleExists(n string, samplingRule *xray.SamplingRule) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -240,11 +339,11 @@ func testAccPreCheck(t *testing.T) {
 	}
 }
 
-func testAccSamplingRuleConfig_basic(ruleName string) string {
+func testAccSamplingRuleConfig_basic(ruleName string, priority int) string {
 	return fmt.Sprintf(`
 resource "aws_xray_sampling_rule" "test" {
-  rule_name      = "%s"
-  priority       = 5
+  rule_name      = %[1]q
+  priority       = %[2]d
   reservoir_size = 10
   url_path       = "*"
   host           = "*"
@@ -259,7 +358,81 @@ resource "aws_xray_sampling_rule" "test" {
     Hello = "World"
   }
 }
-`, ruleName)
+`, ruleName, priority)
+}
+
+func testAccSamplingRuleConfig_duplicatePriority(ruleName string, priority int) string {
+	return acctest.ConfigCompose(testAccSamplingRuleConfig_basic(ruleName, priority), fmt.Sprintf(`
+resource "aws_xray_sampling_rule" "test2" {
+  rule_name      = "%[1]s-2"
+  priority       = %[2]d
+  reservoir_size = 10
+  url_path       = "*"
+  host           = "*"
+  http_method    = "GET"
+  service_type   = "*"
+  service_name   = "*"
+  fixed_rate     = 0.3
+  resource_arn   = "*"
+  version        = 1
+
+  depends_on = [aws_xray_sampling_rule.test]
+}
+`, ruleName, priority))
+}
+
+func testAccSamplingRuleConfig_twoRules(ruleName string, priority1, priority2 int) string {
+	return fmt.Sprintf(`
+resource "aws_xray_sampling_rule" "test1" {
+  rule_name      = "%[1]s-1"
+  priority       = %[2]d
+  reservoir_size = 10
+  url_path       = "*"
+  host           = "*"
+  http_method    = "GET"
+  service_type   = "*"
+  service_name   = "*"
+  fixed_rate     = 0.3
+  resource_arn   = "*"
+  version        = 1
+}
+
+resource "aws_xray_sampling_rule" "test2" {
+  rule_name      = "%[1]s-2"
+  priority       = %[3]d
+  reservoir_size = 10
+  url_path       = "*"
+  host           = "*"
+  http_method    = "GET"
+  service_type   = "*"
+  service_name   = "*"
+  fixed_rate     = 0.3
+  resource_arn   = "*"
+  version        = 1
+}
+`, ruleName, priority1, priority2)
+}
+
+func testAccSamplingRuleConfig_createBeforeDestroy(ruleName string, priority int) string {
+	return fmt.Sprintf(`
+resource "aws_xray_sampling_rule" "test" {
+  rule_name      = %[1]q
+  priority       = %[2]d
+  reservoir_size = 10
+  url_path       = "*"
+  host           = "*"
+  http_method    = "GET"
+  service_type   = "*"
+  service_name   = "*"
+  fixed_rate     = 0.3
+  resource_arn   = "*"
+  version        = 1
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, ruleName, priority)
 }
 
 func testAccSamplingRuleConfig_update(ruleName string, priority int, reservoirSize int) string {
@@ -280,11 +453,11 @@ resource "aws_xray_sampling_rule" "test" {
 `, ruleName, priority, reservoirSize)
 }
 
-func testAccSamplingRuleTags1Config(ruleName, tagKey1, tagValue1 string) string {
+func testAccSamplingRuleTags1Config(ruleName string, priority int, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_xray_sampling_rule" "test" {
   rule_name      = %[1]q
-  priority       = 5
+  priority       = %[4]d
   reservoir_size = 10
   url_path       = "*"
   host           = "*"
@@ -303,14 +476,14 @@ resource "aws_xray_sampling_rule" "test" {
     %[2]q = %[3]q
   }
 }
-`, ruleName, tagKey1, tagValue1)
+`, ruleName, tagKey1, tagValue1, priority)
 }
 
-func testAccSamplingRuleTags2Config(ruleName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+func testAccSamplingRuleTags2Config(ruleName string, priority int, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
 	return fmt.Sprintf(`
 resource "aws_xray_sampling_rule" "test" {
   rule_name      = %[1]q
-  priority       = 5
+  priority       = %[6]d
   reservoir_size = 10
   url_path       = "*"
   host           = "*"
@@ -330,5 +503,5 @@ resource "aws_xray_sampling_rule" "test" {
     %[4]q = %[5]q
   }
 }
-`, ruleName, tagKey1, tagValue1, tagKey2, tagValue2)
+`, ruleName, tagKey1, tagValue1, tagKey2, tagValue2, priority)
 }
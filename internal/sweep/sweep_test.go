@@ -41,6 +41,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/dax"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/directconnect"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/dms"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/drs"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ds"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
@@ -62,6 +63,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/glacier"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/globalaccelerator"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/glue"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/grafana"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/guardduty"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/imagebuilder"
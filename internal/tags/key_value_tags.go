@@ -28,6 +28,7 @@ type DefaultConfig struct {
 type IgnoreConfig struct {
 	Keys        KeyValueTags
 	KeyPrefixes KeyValueTags
+	KeyRegexes  []*regexp.Regexp
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -95,6 +96,7 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 	}
 
 	result := tags.IgnorePrefixes(config.KeyPrefixes)
+	result = result.IgnoreRegexes(config.KeyRegexes)
 	result = result.Ignore(config.Keys)
 
 	return result
@@ -147,6 +149,30 @@ func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValue
 	return result
 }
 
+// IgnoreRegexes returns tag keys not matching any of the regular expressions.
+func (tags KeyValueTags) IgnoreRegexes(ignoreTagRegexes []*regexp.Regexp) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		for _, ignoreTagRegex := range ignoreTagRegexes {
+			if ignoreTagRegex.MatchString(k) {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 // IgnoreRDS returns non-AWS and non-RDS tag keys.
 func (tags KeyValueTags) IgnoreRds() KeyValueTags {
 	result := make(KeyValueTags)
@@ -1,6 +1,7 @@
 package tags
 
 import (
+	"regexp"
 	"testing"
 )
 
@@ -533,6 +534,47 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 				"key3": "value3",
 			},
 		},
+		{
+			name: "key regexes",
+			tags: New(map[string]string{
+				"kubernetes.io/cluster/test": "owned",
+				"key2":                       "value2",
+				"map-migrated":               "d-server-123",
+				"map-migrated-app":           "app",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyRegexes: []*regexp.Regexp{
+					regexp.MustCompile(`^kubernetes\.io/`),
+					regexp.MustCompile(`^map-migrated(-.+)?$`),
+				},
+			},
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name: "keys, key prefixes and key regexes",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+				"key4": "value4",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Keys: New([]string{
+					"key1",
+				}),
+				KeyPrefixes: New([]string{
+					"key2",
+				}),
+				KeyRegexes: []*regexp.Regexp{
+					regexp.MustCompile(`[3]$`),
+				},
+			},
+			want: map[string]string{
+				"key4": "value4",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -689,6 +731,74 @@ func TestKeyValueTagsIgnorePrefixes(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsIgnoreRegexes(t *testing.T) {
+	testCases := []struct {
+		name             string
+		tags             KeyValueTags
+		ignoreTagRegexes []*regexp.Regexp
+		want             map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			ignoreTagRegexes: []*regexp.Regexp{
+				regexp.MustCompile(`^key`),
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "all",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			ignoreTagRegexes: []*regexp.Regexp{
+				regexp.MustCompile(`^key[0-9]$`),
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "mixed",
+			tags: New(map[string]string{
+				"key1":    "value1",
+				"prefix2": "value2",
+				"key3":    "value3",
+			}),
+			ignoreTagRegexes: []*regexp.Regexp{
+				regexp.MustCompile(`^key`),
+			},
+			want: map[string]string{
+				"prefix2": "value2",
+			},
+		},
+		{
+			name: "none",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			ignoreTagRegexes: []*regexp.Regexp{
+				regexp.MustCompile(`^other`),
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IgnoreRegexes(testCase.ignoreTagRegexes)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreRds(t *testing.T) {
 	testCases := []struct {
 		name string